@@ -0,0 +1,48 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+resource "irmc-redfish_email_alerting" "email" {
+  for_each = var.rack1
+  server {
+    username     = each.value.username
+    password     = each.value.password
+    endpoint     = each.value.endpoint
+    ssl_insecure = each.value.ssl_insecure
+  }
+
+  enabled               = true
+  smtp_server           = "smtp.example.com"
+  smtp_port             = 587
+  connection_security   = "StartTLS"
+  smtp_username         = "irmc-alerts"
+  smtp_password         = var.smtp_password
+  smtp_password_version = 1
+  sender                = "irmc-${each.key}@example.com"
+
+  recipients = [
+    {
+      address = "operations@example.com"
+    },
+    {
+      address          = "oncall@example.com"
+      minimum_severity = "Critical"
+    },
+  ]
+
+  // Send test e-mail every time settings have been applied
+  send_test_mail = true
+}
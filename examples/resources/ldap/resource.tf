@@ -0,0 +1,52 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+resource "irmc-redfish_ldap" "ldap" {
+  for_each = var.rack1
+  server {
+    username     = each.value.username
+    password     = each.value.password
+    endpoint     = each.value.endpoint
+    ssl_insecure = each.value.ssl_insecure
+  }
+
+  service_type             = "ActiveDirectory"
+  enabled                  = true
+  service_addresses        = ["ldaps://dc1.example.com:636", "ldaps://dc2.example.com:636"]
+  base_distinguished_names = ["DC=example,DC=com"]
+  username_attribute       = "sAMAccountName"
+  groups_attribute         = "memberOf"
+  bind_username            = "CN=irmc-bind,OU=Service Accounts,DC=example,DC=com"
+  bind_password            = var.ldap_bind_password
+  bind_password_version    = 1
+  verify_certificate       = true
+
+  role_mapping {
+    remote_group = "iRMC-Admins"
+    local_role   = "Administrator"
+  }
+
+  role_mapping {
+    remote_group = "iRMC-Operators"
+    local_role   = "Operator"
+  }
+
+  role_mapping {
+    remote_group = "Domain Users"
+    local_role   = "ReadOnly"
+  }
+}
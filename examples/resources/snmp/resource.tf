@@ -0,0 +1,60 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+resource "irmc-redfish_snmp" "snmp" {
+  for_each = var.rack1
+  server {
+    username     = each.value.username
+    password     = each.value.password
+    endpoint     = each.value.endpoint
+    ssl_insecure = each.value.ssl_insecure
+  }
+
+  protocol_v1_enabled  = false
+  protocol_v2c_enabled = true
+  protocol_v3_enabled  = true
+
+  community_strings = [{
+    name              = "monitoring"
+    community         = var.snmp_community
+    community_version = 1
+  }]
+
+  v3_users = [{
+    user_name        = "monitor"
+    auth_protocol    = "SHA256"
+    auth_key         = var.snmp_auth_key
+    privacy_protocol = "AES"
+    privacy_key      = var.snmp_privacy_key
+    keys_version     = 1
+  }]
+
+  trap_destinations = [
+    {
+      address           = "192.0.2.30"
+      version           = "v2c"
+      community         = var.snmp_community
+      community_version = 1
+    },
+    {
+      address   = "trap-receiver.example.com"
+      port      = 10162
+      version   = "v3"
+      user_name = "monitor"
+    },
+  ]
+}
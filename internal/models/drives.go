@@ -0,0 +1,49 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// DrivesDataSourceModel describes the data source data model.
+type DrivesDataSourceModel struct {
+	Id                  types.String    `tfsdk:"id"`
+	RedfishServer       []RedfishServer `tfsdk:"server"`
+	StorageControllerSN types.String    `tfsdk:"storage_controller_serial_number"`
+	Drives              []Drive         `tfsdk:"drives"`
+}
+
+// Drive describes single physical drive attached to storage controller.
+type Drive struct {
+	Id              types.String   `tfsdk:"id"`
+	Name            types.String   `tfsdk:"name"`
+	Slot            types.String   `tfsdk:"slot"`
+	Location        types.String   `tfsdk:"location"`
+	MediaType       types.String   `tfsdk:"media_type"`
+	Protocol        types.String   `tfsdk:"protocol"`
+	CapacityBytes   types.Int64    `tfsdk:"capacity_bytes"`
+	Manufacturer    types.String   `tfsdk:"manufacturer"`
+	Model           types.String   `tfsdk:"model"`
+	SerialNumber    types.String   `tfsdk:"serial_number"`
+	FirmwareVersion types.String   `tfsdk:"firmware_version"`
+	HotspareType    types.String   `tfsdk:"hotspare_type"`
+	Volumes         []types.String `tfsdk:"volumes"`
+	State           types.String   `tfsdk:"state"`
+	Health          types.String   `tfsdk:"health"`
+}
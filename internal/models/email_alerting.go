@@ -0,0 +1,45 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// EmailAlertingResourceModel describes the resource data model. SMTP password is write-only,
+// so it's set only in model read from configuration.
+type EmailAlertingResourceModel struct {
+	Id                  types.String     `tfsdk:"id"`
+	RedfishServer       []RedfishServer  `tfsdk:"server"`
+	Enabled             types.Bool       `tfsdk:"enabled"`
+	SmtpServer          types.String     `tfsdk:"smtp_server"`
+	SmtpPort            types.Int64      `tfsdk:"smtp_port"`
+	ConnectionSecurity  types.String     `tfsdk:"connection_security"`
+	SmtpUsername        types.String     `tfsdk:"smtp_username"`
+	SmtpPassword        types.String     `tfsdk:"smtp_password"`
+	SmtpPasswordVersion types.Int64      `tfsdk:"smtp_password_version"`
+	Sender              types.String     `tfsdk:"sender"`
+	Recipients          []EmailRecipient `tfsdk:"recipients"`
+	SendTestMail        types.Bool       `tfsdk:"send_test_mail"`
+}
+
+// EmailRecipient describes recipient of alert e-mails.
+type EmailRecipient struct {
+	Address         types.String `tfsdk:"address"`
+	MinimumSeverity types.String `tfsdk:"minimum_severity"`
+}
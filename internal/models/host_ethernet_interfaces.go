@@ -0,0 +1,59 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// HostEthernetInterfacesDataSourceModel describes the data source data model.
+type HostEthernetInterfacesDataSourceModel struct {
+	Id                     types.String                `tfsdk:"id"`
+	RedfishServer          []RedfishServer             `tfsdk:"server"`
+	Interfaces             []HostEthernetInterface     `tfsdk:"interfaces"`
+	NetworkDeviceFunctions []HostNetworkDeviceFunction `tfsdk:"network_device_functions"`
+}
+
+// HostEthernetInterface describes ethernet interface of the host system.
+type HostEthernetInterface struct {
+	Id                  types.String `tfsdk:"id"`
+	Name                types.String `tfsdk:"name"`
+	MacAddress          types.String `tfsdk:"mac_address"`
+	PermanentMacAddress types.String `tfsdk:"permanent_mac_address"`
+	LinkStatus          types.String `tfsdk:"link_status"`
+	SpeedMbps           types.Int64  `tfsdk:"speed_mbps"`
+	InterfaceEnabled    types.Bool   `tfsdk:"interface_enabled"`
+	Health              types.String `tfsdk:"health"`
+}
+
+// HostNetworkDeviceFunction describes network device function of network adapter together with physical port
+// it's assigned to.
+type HostNetworkDeviceFunction struct {
+	Id                  types.String `tfsdk:"id"`
+	AdapterId           types.String `tfsdk:"adapter_id"`
+	AdapterManufacturer types.String `tfsdk:"adapter_manufacturer"`
+	AdapterModel        types.String `tfsdk:"adapter_model"`
+	FunctionType        types.String `tfsdk:"function_type"`
+	PciSlot             types.Int64  `tfsdk:"pci_slot"`
+	PortNumber          types.String `tfsdk:"port_number"`
+	MacAddress          types.String `tfsdk:"mac_address"`
+	PermanentMacAddress types.String `tfsdk:"permanent_mac_address"`
+	LinkStatus          types.String `tfsdk:"link_status"`
+	SpeedMbps           types.Int64  `tfsdk:"speed_mbps"`
+	Health              types.String `tfsdk:"health"`
+}
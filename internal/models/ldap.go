@@ -0,0 +1,46 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// LdapResourceModel describes the resource data model. Bind password is write-only,
+// so it's set only in model read from configuration.
+type LdapResourceModel struct {
+	Id                     types.String      `tfsdk:"id"`
+	RedfishServer          []RedfishServer   `tfsdk:"server"`
+	ServiceType            types.String      `tfsdk:"service_type"`
+	Enabled                types.Bool        `tfsdk:"enabled"`
+	ServiceAddresses       types.List        `tfsdk:"service_addresses"`
+	BaseDistinguishedNames types.List        `tfsdk:"base_distinguished_names"`
+	UsernameAttribute      types.String      `tfsdk:"username_attribute"`
+	GroupsAttribute        types.String      `tfsdk:"groups_attribute"`
+	BindUsername           types.String      `tfsdk:"bind_username"`
+	BindPassword           types.String      `tfsdk:"bind_password"`
+	BindPasswordVersion    types.Int64       `tfsdk:"bind_password_version"`
+	VerifyCertificate      types.Bool        `tfsdk:"verify_certificate"`
+	RoleMappings           []LdapRoleMapping `tfsdk:"role_mapping"`
+}
+
+// LdapRoleMapping describes mapping of directory group to iRMC role.
+type LdapRoleMapping struct {
+	RemoteGroup types.String `tfsdk:"remote_group"`
+	LocalRole   types.String `tfsdk:"local_role"`
+}
@@ -0,0 +1,42 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ManagerNetworkResourceModel describes the resource data model.
+type ManagerNetworkResourceModel struct {
+	Id              types.String    `tfsdk:"id"`
+	RedfishServer   []RedfishServer `tfsdk:"server"`
+	InterfaceId     types.String    `tfsdk:"interface_id"`
+	HostName        types.String    `tfsdk:"hostname"`
+	DhcpEnabled     types.Bool      `tfsdk:"dhcp_enabled"`
+	Ipv4Address     types.String    `tfsdk:"ipv4_address"`
+	Ipv4SubnetMask  types.String    `tfsdk:"ipv4_subnet_mask"`
+	Ipv4Gateway     types.String    `tfsdk:"ipv4_gateway"`
+	Ipv6DhcpEnabled types.Bool      `tfsdk:"ipv6_dhcp_enabled"`
+	Ipv6Address     types.String    `tfsdk:"ipv6_address"`
+	Ipv6Prefix      types.Int64     `tfsdk:"ipv6_prefix_length"`
+	Ipv6Gateway     types.String    `tfsdk:"ipv6_gateway"`
+	VlanEnabled     types.Bool      `tfsdk:"vlan_enabled"`
+	VlanId          types.Int64     `tfsdk:"vlan_id"`
+	DnsServers      types.List      `tfsdk:"dns_servers"`
+	CurrentEndpoint types.String    `tfsdk:"current_endpoint"`
+}
@@ -0,0 +1,45 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// MemoryDataSourceModel describes the data source data model.
+type MemoryDataSourceModel struct {
+	Id            types.String    `tfsdk:"id"`
+	RedfishServer []RedfishServer `tfsdk:"server"`
+	PopulatedOnly types.Bool      `tfsdk:"populated_only"`
+	UnhealthyOnly types.Bool      `tfsdk:"unhealthy_only"`
+	Memory        []Memory        `tfsdk:"memory"`
+}
+
+// Memory describes single memory slot (DIMM) of the system.
+type Memory struct {
+	Id                types.String `tfsdk:"id"`
+	DeviceLocator     types.String `tfsdk:"device_locator"`
+	CapacityMiB       types.Int64  `tfsdk:"capacity_mib"`
+	OperatingSpeedMhz types.Int64  `tfsdk:"operating_speed_mhz"`
+	MemoryDeviceType  types.String `tfsdk:"memory_device_type"`
+	Manufacturer      types.String `tfsdk:"manufacturer"`
+	PartNumber        types.String `tfsdk:"part_number"`
+	SerialNumber      types.String `tfsdk:"serial_number"`
+	State             types.String `tfsdk:"state"`
+	Health            types.String `tfsdk:"health"`
+}
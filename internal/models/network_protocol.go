@@ -0,0 +1,42 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// NetworkProtocolResourceModel describes the resource data model.
+type NetworkProtocolResourceModel struct {
+	Id              types.String          `tfsdk:"id"`
+	RedfishServer   []RedfishServer       `tfsdk:"server"`
+	Http            *NetworkProtocolModel `tfsdk:"http"`
+	Https           *NetworkProtocolModel `tfsdk:"https"`
+	Ssh             *NetworkProtocolModel `tfsdk:"ssh"`
+	Telnet          *NetworkProtocolModel `tfsdk:"telnet"`
+	Ipmi            *NetworkProtocolModel `tfsdk:"ipmi"`
+	Snmp            *NetworkProtocolModel `tfsdk:"snmp"`
+	Kvmip           *NetworkProtocolModel `tfsdk:"kvmip"`
+	CurrentEndpoint types.String          `tfsdk:"current_endpoint"`
+}
+
+// NetworkProtocolModel describes settings of single protocol.
+type NetworkProtocolModel struct {
+	Enabled types.Bool  `tfsdk:"enabled"`
+	Port    types.Int64 `tfsdk:"port"`
+}
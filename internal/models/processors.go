@@ -0,0 +1,47 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ProcessorsDataSourceModel describes the data source data model.
+type ProcessorsDataSourceModel struct {
+	Id            types.String    `tfsdk:"id"`
+	RedfishServer []RedfishServer `tfsdk:"server"`
+	PopulatedOnly types.Bool      `tfsdk:"populated_only"`
+	UnhealthyOnly types.Bool      `tfsdk:"unhealthy_only"`
+	Processors    []Processor     `tfsdk:"processors"`
+}
+
+// Processor describes single processor socket of the system.
+type Processor struct {
+	Id                    types.String `tfsdk:"id"`
+	Socket                types.String `tfsdk:"socket"`
+	Manufacturer          types.String `tfsdk:"manufacturer"`
+	Model                 types.String `tfsdk:"model"`
+	ProcessorType         types.String `tfsdk:"processor_type"`
+	ProcessorArchitecture types.String `tfsdk:"processor_architecture"`
+	TotalCores            types.Int64  `tfsdk:"total_cores"`
+	TotalThreads          types.Int64  `tfsdk:"total_threads"`
+	MaxSpeedMHz           types.Int64  `tfsdk:"max_speed_mhz"`
+	OperatingSpeedMHz     types.Int64  `tfsdk:"operating_speed_mhz"`
+	State                 types.String `tfsdk:"state"`
+	Health                types.String `tfsdk:"health"`
+}
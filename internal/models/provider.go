@@ -22,8 +22,14 @@ import (
 )
 
 type RedfishServer struct {
-	User        types.String `tfsdk:"username"`
-	Password    types.String `tfsdk:"password"`
-	Endpoint    types.String `tfsdk:"endpoint"`
-	SslInsecure types.Bool   `tfsdk:"ssl_insecure"`
+	User                   types.String `tfsdk:"username"`
+	Password               types.String `tfsdk:"password"`
+	Endpoint               types.String `tfsdk:"endpoint"`
+	SslInsecure            types.Bool   `tfsdk:"ssl_insecure"`
+	CaCertificate          types.String `tfsdk:"ca_certificate"`
+	TlsServerName          types.String `tfsdk:"tls_server_name"`
+	CertificateFingerprint types.String `tfsdk:"certificate_fingerprint"`
+	AuthMethod             types.String `tfsdk:"auth_method"`
+	SystemId               types.String `tfsdk:"system_id"`
+	ManagerId              types.String `tfsdk:"manager_id"`
 }
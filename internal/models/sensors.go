@@ -0,0 +1,77 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// SensorsDataSourceModel describes the data source data model.
+type SensorsDataSourceModel struct {
+	Id                 types.String        `tfsdk:"id"`
+	RedfishServer      []RedfishServer     `tfsdk:"server"`
+	PowerConsumedWatts types.Float64       `tfsdk:"power_consumed_watts"`
+	Temperatures       []TemperatureSensor `tfsdk:"temperatures"`
+	Fans               []FanSensor         `tfsdk:"fans"`
+	PowerSupplies      []PowerSupplySensor `tfsdk:"power_supplies"`
+	Redundancy         []SensorRedundancy  `tfsdk:"redundancy"`
+}
+
+// TemperatureSensor describes single temperature sensor of the chassis.
+type TemperatureSensor struct {
+	Name                      types.String  `tfsdk:"name"`
+	PhysicalContext           types.String  `tfsdk:"physical_context"`
+	ReadingCelsius            types.Float64 `tfsdk:"reading_celsius"`
+	UpperThresholdNonCritical types.Float64 `tfsdk:"upper_threshold_non_critical"`
+	UpperThresholdCritical    types.Float64 `tfsdk:"upper_threshold_critical"`
+	UpperThresholdFatal       types.Float64 `tfsdk:"upper_threshold_fatal"`
+	State                     types.String  `tfsdk:"state"`
+	Health                    types.String  `tfsdk:"health"`
+}
+
+// FanSensor describes single fan of the chassis.
+type FanSensor struct {
+	Name                      types.String  `tfsdk:"name"`
+	Reading                   types.Float64 `tfsdk:"reading"`
+	ReadingUnits              types.String  `tfsdk:"reading_units"`
+	LowerThresholdNonCritical types.Float64 `tfsdk:"lower_threshold_non_critical"`
+	LowerThresholdCritical    types.Float64 `tfsdk:"lower_threshold_critical"`
+	State                     types.String  `tfsdk:"state"`
+	Health                    types.String  `tfsdk:"health"`
+}
+
+// PowerSupplySensor describes single power supply unit of the chassis.
+type PowerSupplySensor struct {
+	Name               types.String  `tfsdk:"name"`
+	Model              types.String  `tfsdk:"model"`
+	SerialNumber       types.String  `tfsdk:"serial_number"`
+	PowerCapacityWatts types.Float64 `tfsdk:"power_capacity_watts"`
+	InputPowerWatts    types.Float64 `tfsdk:"input_power_watts"`
+	OutputPowerWatts   types.Float64 `tfsdk:"output_power_watts"`
+	LineInputVoltage   types.Float64 `tfsdk:"line_input_voltage"`
+	State              types.String  `tfsdk:"state"`
+	Health             types.String  `tfsdk:"health"`
+}
+
+// SensorRedundancy describes redundancy group of power supplies or fans.
+type SensorRedundancy struct {
+	Name   types.String `tfsdk:"name"`
+	Mode   types.String `tfsdk:"mode"`
+	State  types.String `tfsdk:"state"`
+	Health types.String `tfsdk:"health"`
+}
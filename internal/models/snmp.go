@@ -0,0 +1,63 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// SnmpResourceModel describes the resource data model.
+type SnmpResourceModel struct {
+	Id                 types.String          `tfsdk:"id"`
+	RedfishServer      []RedfishServer       `tfsdk:"server"`
+	ProtocolV1Enabled  types.Bool            `tfsdk:"protocol_v1_enabled"`
+	ProtocolV2cEnabled types.Bool            `tfsdk:"protocol_v2c_enabled"`
+	ProtocolV3Enabled  types.Bool            `tfsdk:"protocol_v3_enabled"`
+	CommunityStrings   []SnmpCommunity       `tfsdk:"community_strings"`
+	V3Users            []SnmpV3User          `tfsdk:"v3_users"`
+	TrapDestinations   []SnmpTrapDestination `tfsdk:"trap_destinations"`
+}
+
+// SnmpCommunity describes SNMPv1/v2c community. Community string is write-only.
+type SnmpCommunity struct {
+	Name             types.String `tfsdk:"name"`
+	Community        types.String `tfsdk:"community"`
+	CommunityVersion types.Int64  `tfsdk:"community_version"`
+	AccessMode       types.String `tfsdk:"access_mode"`
+}
+
+// SnmpV3User describes SNMPv3 user with its authentication and privacy settings. Keys are write-only.
+type SnmpV3User struct {
+	UserName        types.String `tfsdk:"user_name"`
+	AccessMode      types.String `tfsdk:"access_mode"`
+	AuthProtocol    types.String `tfsdk:"auth_protocol"`
+	AuthKey         types.String `tfsdk:"auth_key"`
+	PrivacyProtocol types.String `tfsdk:"privacy_protocol"`
+	PrivacyKey      types.String `tfsdk:"privacy_key"`
+	KeysVersion     types.Int64  `tfsdk:"keys_version"`
+}
+
+// SnmpTrapDestination describes receiver of SNMP traps sent by iRMC. Community string is write-only.
+type SnmpTrapDestination struct {
+	Address          types.String `tfsdk:"address"`
+	Port             types.Int64  `tfsdk:"port"`
+	Version          types.String `tfsdk:"version"`
+	Community        types.String `tfsdk:"community"`
+	CommunityVersion types.Int64  `tfsdk:"community_version"`
+	UserName         types.String `tfsdk:"user_name"`
+}
@@ -0,0 +1,43 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// StorageControllersDataSourceModel describes the data source data model.
+type StorageControllersDataSourceModel struct {
+	Id            types.String        `tfsdk:"id"`
+	RedfishServer []RedfishServer     `tfsdk:"server"`
+	Controllers   []StorageController `tfsdk:"controllers"`
+}
+
+// StorageController describes single storage controller of the system.
+type StorageController struct {
+	Id                 types.String   `tfsdk:"id"`
+	Name               types.String   `tfsdk:"name"`
+	Model              types.String   `tfsdk:"model"`
+	SerialNumber       types.String   `tfsdk:"serial_number"`
+	FirmwareVersion    types.String   `tfsdk:"firmware_version"`
+	SupportedRaidTypes []types.String `tfsdk:"supported_raid_types"`
+	DrivesCount        types.Int64    `tfsdk:"drives_count"`
+	UnusedDrivesCount  types.Int64    `tfsdk:"unused_drives_count"`
+	State              types.String   `tfsdk:"state"`
+	Health             types.String   `tfsdk:"health"`
+}
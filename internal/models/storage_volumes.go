@@ -0,0 +1,46 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// StorageVolumesDataSourceModel describes the data source data model.
+type StorageVolumesDataSourceModel struct {
+	Id                  types.String    `tfsdk:"id"`
+	RedfishServer       []RedfishServer `tfsdk:"server"`
+	StorageControllerSN types.String    `tfsdk:"storage_controller_serial_number"`
+	Volumes             []StorageVolume `tfsdk:"volumes"`
+}
+
+// StorageVolume describes single volume (logical drive) of storage controller.
+type StorageVolume struct {
+	Id                  types.String   `tfsdk:"id"`
+	StorageControllerSN types.String   `tfsdk:"storage_controller_serial_number"`
+	VolumeName          types.String   `tfsdk:"name"`
+	RaidType            types.String   `tfsdk:"raid_type"`
+	CapacityBytes       types.Int64    `tfsdk:"capacity_bytes"`
+	OptimumIOSizeBytes  types.Int64    `tfsdk:"optimum_io_size_bytes"`
+	ReadMode            types.String   `tfsdk:"read_mode"`
+	WriteMode           types.String   `tfsdk:"write_mode"`
+	DriveCacheMode      types.String   `tfsdk:"drive_cache_mode"`
+	PhysicalDrives      []types.String `tfsdk:"physical_drives"`
+	State               types.String   `tfsdk:"state"`
+	Health              types.String   `tfsdk:"health"`
+}
@@ -0,0 +1,59 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// SystemDataSourceModel describes the data source data model.
+type SystemDataSourceModel struct {
+	Id               types.String            `tfsdk:"id"`
+	RedfishServer    []RedfishServer         `tfsdk:"server"`
+	SystemId         types.String            `tfsdk:"system_id"`
+	Name             types.String            `tfsdk:"name"`
+	Manufacturer     types.String            `tfsdk:"manufacturer"`
+	Model            types.String            `tfsdk:"model"`
+	Sku              types.String            `tfsdk:"sku"`
+	PartNumber       types.String            `tfsdk:"part_number"`
+	SerialNumber     types.String            `tfsdk:"serial_number"`
+	Uuid             types.String            `tfsdk:"uuid"`
+	AssetTag         types.String            `tfsdk:"asset_tag"`
+	HostName         types.String            `tfsdk:"host_name"`
+	BiosVersion      types.String            `tfsdk:"bios_version"`
+	PowerState       types.String            `tfsdk:"power_state"`
+	State            types.String            `tfsdk:"state"`
+	Health           types.String            `tfsdk:"health"`
+	HealthRollup     types.String            `tfsdk:"health_rollup"`
+	ProcessorSummary *SystemProcessorSummary `tfsdk:"processor_summary"`
+	MemorySummary    *SystemMemorySummary    `tfsdk:"memory_summary"`
+}
+
+// SystemProcessorSummary describes summary of processors installed in the system.
+type SystemProcessorSummary struct {
+	Count                 types.Int64  `tfsdk:"count"`
+	LogicalProcessorCount types.Int64  `tfsdk:"logical_processor_count"`
+	Model                 types.String `tfsdk:"model"`
+	HealthRollup          types.String `tfsdk:"health_rollup"`
+}
+
+// SystemMemorySummary describes summary of memory installed in the system.
+type SystemMemorySummary struct {
+	TotalSystemMemoryGiB types.Float64 `tfsdk:"total_system_memory_gib"`
+	HealthRollup         types.String  `tfsdk:"health_rollup"`
+}
@@ -0,0 +1,228 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"net/http"
+	"runtime"
+	"sync"
+	"weak"
+
+	"github.com/stmcginnis/gofish"
+	"github.com/stmcginnis/gofish/common"
+)
+
+// cachedClient keeps connection to the system together with selection of System and Manager
+// resources and information which does not change as long as the system is not reset or updated.
+type cachedClient struct {
+	lock            sync.Mutex
+	endpoint        string
+	api             *gofish.APIClient
+	systemSelector  string
+	managerSelector string
+	eventStream     bool
+	oemVendor       *OemVendor
+	systemODataID   string
+	managerODataID  string
+}
+
+// Connections are cached per endpoint (and settings used to reach it), so every
+// resource managing the same system reuses the same client during provider run.
+type ClientCache struct {
+	lock    sync.Mutex
+	clients map[ServerConfig]*cachedClient
+}
+
+// cachedClientIndex allows to find cache entry of API client, so helpers receiving
+// only client or service can reuse information already retrieved from the system.
+// Clients are referenced weakly, so entries of clients which are not cached are
+// removed once the client is not used anymore.
+var cachedClientIndex sync.Map
+
+func InitClientCacheInstance() *ClientCache {
+	return &ClientCache{
+		clients: make(map[ServerConfig]*cachedClient),
+	}
+}
+
+// get returns client cached for config or nil if there is no such client.
+func (cc *ClientCache) get(config ServerConfig) *gofish.APIClient {
+	cc.lock.Lock()
+	defer cc.lock.Unlock()
+
+	if entry, ok := cc.clients[config]; ok {
+		return entry.api
+	}
+	return nil
+}
+
+// put stores client in cache. If other client has been already stored for the same
+// config in the meantime, that one is returned, so all callers share single client.
+func (cc *ClientCache) put(config ServerConfig, api *gofish.APIClient) *gofish.APIClient {
+	cc.lock.Lock()
+	defer cc.lock.Unlock()
+
+	if entry, ok := cc.clients[config]; ok {
+		return entry.api
+	}
+
+	entry := registerClient(config, api)
+	entry.api = api
+	cc.clients[config] = entry
+	return api
+}
+
+// registerClient makes information kept for client available to helpers receiving only client or service.
+// It's done for every client, even if it's not cached, so System and Manager selection is always respected.
+// Entry of client which is not cached is removed from index when the client is garbage collected.
+func registerClient(config ServerConfig, api *gofish.APIClient) *cachedClient {
+	entry := &cachedClient{
+		endpoint:        config.Endpoint,
+		systemSelector:  config.SystemId,
+		managerSelector: config.ManagerId,
+		eventStream:     config.EventStream,
+	}
+
+	key := weak.Make(api)
+	cachedClientIndex.Store(key, entry)
+	runtime.AddCleanup(api, func(key weak.Pointer[gofish.APIClient]) {
+		cachedClientIndex.Delete(key)
+	}, key)
+	return entry
+}
+
+// invalidate removes all clients connected to endpoint, so next connection
+// will detect the system again (e.g.: after iRMC reset or firmware update).
+// Index entries are kept, since callers might still use these clients and
+// their System and Manager selection must be respected.
+func (cc *ClientCache) invalidate(endpoint string) {
+	cc.lock.Lock()
+	defer cc.lock.Unlock()
+
+	for config, entry := range cc.clients {
+		if entry.endpoint == endpoint {
+			entry.api.HTTPClient.CloseIdleConnections()
+			delete(cc.clients, config)
+		}
+	}
+}
+
+// clientCache returns cache of provider or nil if clients should not be cached.
+func (p *IrmcProvider) clientCache() *ClientCache {
+	if p == nil {
+		return nil
+	}
+	return p.clients
+}
+
+// InvalidateClients drops cached connections to endpoint. It must be called whenever
+// operation on the system may change information memoized for it.
+func (p *IrmcProvider) InvalidateClients(endpoint string) {
+	if cache := p.clientCache(); cache != nil {
+		cache.invalidate(endpoint)
+	}
+}
+
+// lookupCachedClient returns cache entry of client or nil if client is not cached.
+func lookupCachedClient(client common.Client) *cachedClient {
+	if bound, ok := client.(*contextClient); ok {
+		client = bound.Client
+	}
+
+	api, ok := client.(*gofish.APIClient)
+	if !ok || api == nil {
+		return nil
+	}
+
+	if entry, ok := cachedClientIndex.Load(weak.Make(api)); ok {
+		if cached, ok := entry.(*cachedClient); ok {
+			return cached
+		}
+	}
+	return nil
+}
+
+// getOemVendor returns memoized dialect of OEM extensions or nil if it has not been detected yet.
+func (c *cachedClient) getOemVendor() *OemVendor {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return c.oemVendor
+}
+
+func (c *cachedClient) setOemVendor(vendor *OemVendor) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.oemVendor = vendor
+}
+
+func (c *cachedClient) getSystemODataID() string {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return c.systemODataID
+}
+
+func (c *cachedClient) setSystemODataID(id string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.systemODataID = id
+}
+
+func (c *cachedClient) getManagerODataID() string {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return c.managerODataID
+}
+
+func (c *cachedClient) setManagerODataID(id string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.managerODataID = id
+}
+
+// keepAliveTransport allows to reuse connections of cached clients. Gofish marks requests
+// to close connection after every request if custom HTTP client is used.
+type keepAliveTransport struct {
+	base http.RoundTripper
+}
+
+func (t *keepAliveTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Close {
+		req = req.Clone(req.Context())
+		req.Close = false
+	}
+	return t.base.RoundTrip(req)
+}
+
+// basicAuthTransport adds credentials of basic authentication to every request.
+type basicAuthTransport struct {
+	base     http.RoundTripper
+	username string
+	password string
+}
+
+func (t *basicAuthTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	out := req.Clone(req.Context())
+	out.SetBasicAuth(t.username, t.password)
+	return t.base.RoundTrip(out)
+}
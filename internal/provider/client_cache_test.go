@@ -0,0 +1,220 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"runtime"
+	"testing"
+	"time"
+	"weak"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stmcginnis/gofish"
+)
+
+func TestClientCache(t *testing.T) {
+	forEachMockFlavor(t, func(t *testing.T, m *mockRedfishServer) {
+		p := &IrmcProvider{clients: InitClientCacheInstance()}
+
+		rserver := m.redfishServer()
+		first, err := ConnectTargetSystem(p, &rserver)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+
+		for i := 0; i < 3; i++ {
+			api, err := ConnectTargetSystem(p, &rserver)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err.Error())
+			}
+
+			if api != first {
+				t.Fatalf("Expected cached client to be returned")
+			}
+
+			vendor, err := GetOemVendor(context.Background(), api)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err.Error())
+			}
+
+			if vendor != m.vendor() {
+				t.Errorf("Got vendor %s for %s", vendor.Key, m.oemKey)
+			}
+
+			if _, err := GetSystemResource(api.Service); err != nil {
+				t.Fatalf("Unexpected error while reading system: %s", err.Error())
+			}
+
+			if _, err := GetManagerResource(api.Service); err != nil {
+				t.Fatalf("Unexpected error while reading manager: %s", err.Error())
+			}
+
+			// Logout of single resource must not break shared client
+			api.Logout()
+		}
+
+		if count := len(m.requestsTo(http.MethodGet, "/redfish/v1/")); count != 2 {
+			t.Errorf("Got %d service root requests, expected 2", count)
+		}
+
+		if count := len(m.requestsTo(http.MethodGet, "/redfish/v1/Systems")); count != 1 {
+			t.Errorf("Got %d system collection requests, expected 1", count)
+		}
+
+		if count := len(m.requestsTo(http.MethodGet, "/redfish/v1/Managers")); count != 1 {
+			t.Errorf("Got %d manager collection requests, expected 1", count)
+		}
+
+		for _, req := range m.requestsTo(http.MethodGet, mockSystemEndpoint) {
+			if req.Header.Get("Authorization") == "" {
+				t.Errorf("Request has not been authenticated with basic auth")
+			}
+		}
+	})
+}
+
+func TestClientCacheCredentials(t *testing.T) {
+	m := newMockRedfishServer(t, FSAS)
+	p := &IrmcProvider{clients: InitClientCacheInstance()}
+
+	rserver := m.redfishServer()
+	valid, err := ConnectTargetSystem(p, &rserver)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+
+	// Client cached for valid credentials must not be used for different ones
+	rserver[0].Password = types.StringValue("wrong")
+	api, err := ConnectTargetSystem(p, &rserver)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+
+	if api == valid {
+		t.Fatalf("Expected separate client for different credentials")
+	}
+
+	if _, err := GetSystemResource(api.Service); err == nil {
+		t.Errorf("Expected error for wrong credentials")
+	}
+}
+
+func TestClientCacheInvalidation(t *testing.T) {
+	defaultDelay := irmcStartupDelay
+	irmcStartupDelay = 0
+	defer func() { irmcStartupDelay = defaultDelay }()
+
+	forEachMockFlavor(t, func(t *testing.T, m *mockRedfishServer) {
+		p := &IrmcProvider{clients: InitClientCacheInstance()}
+
+		rserver := m.redfishServer()
+		api, err := ConnectTargetSystem(p, &rserver)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+
+		if _, err := GetOemVendor(context.Background(), api); err != nil {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+
+		if err := restartIrmc(context.Background(), api, rserver, p); err != nil {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+
+		if count := len(p.clients.clients); count != 0 {
+			t.Errorf("Got %d clients cached during iRMC reset, expected none", count)
+		}
+
+		reconnected, err := ConnectTargetSystem(p, &rserver)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+
+		if reconnected == api {
+			t.Errorf("Expected new client after iRMC reset")
+		}
+
+		requests := len(m.requestsTo(http.MethodGet, "/redfish/v1/"))
+		if _, err := GetOemVendor(context.Background(), reconnected); err != nil {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+
+		if count := len(m.requestsTo(http.MethodGet, "/redfish/v1/")); count != requests+1 {
+			t.Errorf("Vendor has not been detected again after iRMC reset")
+		}
+	})
+}
+
+func TestClientCacheInvalidationSelection(t *testing.T) {
+	m := newMockRedfishServer(t, FSAS)
+	m.put("/redfish/v1/Systems/1", map[string]interface{}{"Id": "1", "Name": "Node 1"})
+	m.update("/redfish/v1/Systems", map[string]interface{}{
+		"Members": []interface{}{
+			map[string]interface{}{"@odata.id": mockSystemEndpoint},
+			map[string]interface{}{"@odata.id": "/redfish/v1/Systems/1"},
+		},
+	})
+	p := &IrmcProvider{SystemId: "1", clients: InitClientCacheInstance()}
+
+	rserver := m.redfishServer()
+	api, err := ConnectTargetSystem(p, &rserver)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+
+	// Client still used after invalidation must keep selection of the system
+	p.InvalidateClients(GetServerEndpoint(p, rserver))
+	system, err := GetSystemResource(api.Service)
+	if err != nil {
+		t.Fatalf("Unexpected error while reading system: %s", err.Error())
+	}
+	if system.ID != "1" {
+		t.Errorf("Got system with Id '%s', expected '1'", system.ID)
+	}
+}
+
+func TestClientIndexRelease(t *testing.T) {
+	m := newMockRedfishServer(t, FSAS)
+
+	key := func() weak.Pointer[gofish.APIClient] {
+		rserver := m.redfishServer()
+		api, err := ConnectTargetSystem(&IrmcProvider{}, &rserver)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+
+		if lookupCachedClient(api) == nil {
+			t.Fatalf("Client which is not cached has not been indexed")
+		}
+
+		api.Logout()
+		return weak.Make(api)
+	}()
+
+	// Index entry is removed by cleanup of the client, which runs after garbage collection
+	for i := 0; i < 50; i++ {
+		runtime.GC()
+		if _, ok := cachedClientIndex.Load(key); !ok {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Errorf("Index entry of released client has not been removed")
+}
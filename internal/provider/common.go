@@ -20,24 +20,28 @@ package provider
 import (
 	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"io"
 	"log"
+	"net/http"
+	"strings"
 	"terraform-provider-irmc-redfish/internal/models"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	datasourceSchema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	resourceSchema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/stmcginnis/gofish"
+	"github.com/stmcginnis/gofish/common"
 	"github.com/stmcginnis/gofish/redfish"
 )
 
 const (
-	redfishServerMD        string = "List of server BMCs and their respective user credentials"
+	redfishServerMD        string = "List of server BMCs and their respective user credentials. Overrides settings defined on provider level"
 	vmediaName             string = "virtual_media"
 	storageVolumeName      string = "storage_volume"
 	irmcRestart            string = "irmc_reset"
@@ -54,6 +58,23 @@ const (
 	certificateCaUpdDeploy string = "certificate_ca_upd_deploy"
 	certificateWebServer   string = "certificate_web_server"
 	certificateCaCasSmtp   string = "certificate_ca_cas_smtp"
+	ntpName                string = "ntp"
+	networkProtocolName    string = "network_protocol"
+	managerNetworkName     string = "manager_network"
+	hostEthernetInterfaces string = "host_ethernet_interfaces"
+	systemName             string = "system"
+	processorsName         string = "processors"
+	memoryName             string = "memory"
+	drivesName             string = "drives"
+	storageVolumesName     string = "storage_volumes"
+	storageControllersName string = "storage_controllers"
+	sensorsName            string = "sensors"
+	eventSubscriptionName  string = "event_subscription"
+	snmpName               string = "snmp"
+	syslogName             string = "syslog"
+	emailAlertingName      string = "email_alerting"
+	ldapName               string = "ldap"
+	accountPolicyName      string = "account_policy"
 )
 
 const (
@@ -63,13 +84,25 @@ const (
 	FSAS                 = "Fsas"
 	TS_FUJITSU           = "ts_fujitsu"
 	FTS                  = "FTS"
+
+	SYSTEMS_COLLECTION_ENDPOINT  = "/redfish/v1/Systems"
+	MANAGERS_COLLECTION_ENDPOINT = "/redfish/v1/Managers"
+	CHASSIS_COLLECTION_ENDPOINT  = "/redfish/v1/Chassis"
 )
 
 type ServerConfig struct {
-	Username    string `json:"username"`
-	Password    string `json:"password"`
-	Endpoint    string `json:"endpoint"`
-	SslInsecure bool   `json:"ssl_insecure"`
+	Username               string `json:"username"`
+	Password               string `json:"password"`
+	Endpoint               string `json:"endpoint"`
+	SslInsecure            bool   `json:"ssl_insecure"`
+	CaCertificate          string `json:"ca_certificate,omitempty"`
+	TlsServerName          string `json:"tls_server_name,omitempty"`
+	CertificateFingerprint string `json:"certificate_fingerprint,omitempty"`
+	AuthMethod             string `json:"auth_method,omitempty"`
+	SystemId               string `json:"system_id,omitempty"`
+	ManagerId              string `json:"manager_id,omitempty"`
+	// EventStream is configured only on provider level, so it's not part of import identifier.
+	EventStream bool `json:"-"`
 }
 
 type CommonImportConfig struct {
@@ -77,6 +110,54 @@ type CommonImportConfig struct {
 	ID string `json:"id"`
 }
 
+// importServerBlock converts server settings passed in import ID into server block.
+// If endpoint has not been passed, settings from provider level will be used,
+// so server block is left empty.
+func importServerBlock(config ServerConfig) []models.RedfishServer {
+	if len(config.Endpoint) == 0 {
+		return []models.RedfishServer{}
+	}
+
+	server := models.RedfishServer{
+		User:                   types.StringValue(config.Username),
+		Password:               types.StringValue(config.Password),
+		Endpoint:               types.StringValue(config.Endpoint),
+		SslInsecure:            types.BoolValue(config.SslInsecure),
+		CaCertificate:          types.StringNull(),
+		TlsServerName:          types.StringNull(),
+		CertificateFingerprint: types.StringNull(),
+		AuthMethod:             types.StringNull(),
+		SystemId:               types.StringNull(),
+		ManagerId:              types.StringNull(),
+	}
+
+	if len(config.CaCertificate) > 0 {
+		server.CaCertificate = types.StringValue(config.CaCertificate)
+	}
+
+	if len(config.TlsServerName) > 0 {
+		server.TlsServerName = types.StringValue(config.TlsServerName)
+	}
+
+	if len(config.CertificateFingerprint) > 0 {
+		server.CertificateFingerprint = types.StringValue(config.CertificateFingerprint)
+	}
+
+	if len(config.AuthMethod) > 0 {
+		server.AuthMethod = types.StringValue(config.AuthMethod)
+	}
+
+	if len(config.SystemId) > 0 {
+		server.SystemId = types.StringValue(config.SystemId)
+	}
+
+	if len(config.ManagerId) > 0 {
+		server.ManagerId = types.StringValue(config.ManagerId)
+	}
+
+	return []models.RedfishServer{server}
+}
+
 const (
 	IRMC_RESET_TIMEOUT             = 600
 	IRMC_RESET_CHECK_INTERVAL_TIME = 10
@@ -95,13 +176,43 @@ func RedfishServerDatasourceSchema() map[string]datasourceSchema.Attribute {
 			Sensitive:   true,
 		},
 		"endpoint": datasourceSchema.StringAttribute{
-			Required:    true,
-			Description: "Server BMC IP address or hostname",
+			Optional:    true,
+			Description: "Server BMC IP address or hostname. If not set, endpoint from provider configuration is used",
 		},
 		"ssl_insecure": datasourceSchema.BoolAttribute{
 			Optional:    true,
 			Description: "This field indicates whether the SSL/TLS certificate must be verified or not",
 		},
+		"ca_certificate": datasourceSchema.StringAttribute{
+			Optional:    true,
+			Description: "PEM encoded CA certificate (or path to file containing it) used to verify certificate presented by BMC",
+		},
+		"tls_server_name": datasourceSchema.StringAttribute{
+			Optional:    true,
+			Description: "Server name expected in certificate presented by BMC, if it differs from endpoint address",
+		},
+		"certificate_fingerprint": datasourceSchema.StringAttribute{
+			Optional:    true,
+			Description: "SHA-256 fingerprint of certificate presented by BMC (hex encoded, colons are allowed). If set, connection is trusted only if certificate matches the fingerprint",
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(certificateFingerprintRegex, "must be SHA-256 fingerprint in hex format"),
+			},
+		},
+		"auth_method": datasourceSchema.StringAttribute{
+			Optional:    true,
+			Description: "Authentication method used to access Redfish API: 'basic' (default) or 'session'. Session is created once per endpoint and shared between resources",
+			Validators: []validator.String{
+				stringvalidator.OneOf(AUTH_METHOD_BASIC, AUTH_METHOD_SESSION),
+			},
+		},
+		"system_id": datasourceSchema.StringAttribute{
+			Optional:    true,
+			Description: "Id of managed member of Systems collection (e.g. on multi-node platforms). If not set, first system reported by BMC is used",
+		},
+		"manager_id": datasourceSchema.StringAttribute{
+			Optional:    true,
+			Description: "Id of managed member of Managers collection. If not set, first manager reported by BMC is used",
+		},
 	}
 }
 
@@ -117,13 +228,43 @@ func RedfishServerSchema() map[string]resourceSchema.Attribute {
 			Sensitive:   true,
 		},
 		"endpoint": resourceSchema.StringAttribute{
-			Required:    true,
-			Description: "Server BMC IP address or hostname",
+			Optional:    true,
+			Description: "Server BMC IP address or hostname. If not set, endpoint from provider configuration is used",
 		},
 		"ssl_insecure": resourceSchema.BoolAttribute{
 			Optional:    true,
 			Description: "This field indicates whether the SSL/TLS certificate must be verified or not",
 		},
+		"ca_certificate": resourceSchema.StringAttribute{
+			Optional:    true,
+			Description: "PEM encoded CA certificate (or path to file containing it) used to verify certificate presented by BMC",
+		},
+		"tls_server_name": resourceSchema.StringAttribute{
+			Optional:    true,
+			Description: "Server name expected in certificate presented by BMC, if it differs from endpoint address",
+		},
+		"certificate_fingerprint": resourceSchema.StringAttribute{
+			Optional:    true,
+			Description: "SHA-256 fingerprint of certificate presented by BMC (hex encoded, colons are allowed). If set, connection is trusted only if certificate matches the fingerprint",
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(certificateFingerprintRegex, "must be SHA-256 fingerprint in hex format"),
+			},
+		},
+		"auth_method": resourceSchema.StringAttribute{
+			Optional:    true,
+			Description: "Authentication method used to access Redfish API: 'basic' (default) or 'session'. Session is created once per endpoint and shared between resources",
+			Validators: []validator.String{
+				stringvalidator.OneOf(AUTH_METHOD_BASIC, AUTH_METHOD_SESSION),
+			},
+		},
+		"system_id": resourceSchema.StringAttribute{
+			Optional:    true,
+			Description: "Id of managed member of Systems collection (e.g. on multi-node platforms). If not set, first system reported by BMC is used",
+		},
+		"manager_id": resourceSchema.StringAttribute{
+			Optional:    true,
+			Description: "Id of managed member of Managers collection. If not set, first manager reported by BMC is used",
+		},
 	}
 }
 
@@ -135,7 +276,6 @@ func RedfishServerDatasourceBlockMap() map[string]datasourceSchema.Block {
 			Description:         redfishServerMD,
 			Validators: []validator.List{
 				listvalidator.SizeAtMost(1),
-				listvalidator.IsRequired(),
 			},
 			NestedObject: datasourceSchema.NestedBlockObject{
 				Attributes: RedfishServerDatasourceSchema(),
@@ -151,7 +291,6 @@ func RedfishServerResourceBlockMap() map[string]resourceSchema.Block {
 			Description:         redfishServerMD,
 			Validators: []validator.List{
 				listvalidator.SizeAtMost(1),
-				listvalidator.IsRequired(),
 			},
 			NestedObject: resourceSchema.NestedBlockObject{
 				Attributes: RedfishServerSchema(),
@@ -160,67 +299,282 @@ func RedfishServerResourceBlockMap() map[string]resourceSchema.Block {
 	}
 }
 
-func ConnectTargetSystem(pconfig *IrmcProvider, rserver *[]models.RedfishServer) (*gofish.APIClient, error) {
-	if len(*rserver) == 0 {
-		return nil, fmt.Errorf("no provider block was found")
+// resolveServerConfig merges settings from resource server block with defaults
+// configured on provider level. Values defined in server block take precedence.
+func resolveServerConfig(pconfig *IrmcProvider, rserver []models.RedfishServer) (ServerConfig, error) {
+	var config ServerConfig
+	if pconfig != nil {
+		config = ServerConfig{
+			Username:      pconfig.Username,
+			Password:      pconfig.Password,
+			Endpoint:      pconfig.Endpoint,
+			SslInsecure:   pconfig.SslInsecure,
+			CaCertificate: pconfig.CaCertificate,
+			AuthMethod:    pconfig.AuthMethod,
+			SystemId:      pconfig.SystemId,
+			ManagerId:     pconfig.ManagerId,
+			EventStream:   pconfig.EventStream,
+		}
 	}
 
-	// first redfish server block
-	if len(*rserver) == 0 {
-		return nil, errors.New("redfish server config not present")
+	if len(rserver) > 0 {
+		rserver1 := rserver[0]
+		if len(rserver1.User.ValueString()) > 0 {
+			config.Username = rserver1.User.ValueString()
+		}
+
+		if len(rserver1.Password.ValueString()) > 0 {
+			config.Password = rserver1.Password.ValueString()
+		}
+
+		if len(rserver1.Endpoint.ValueString()) > 0 {
+			config.Endpoint = rserver1.Endpoint.ValueString()
+		}
+
+		if !rserver1.SslInsecure.IsNull() && !rserver1.SslInsecure.IsUnknown() {
+			config.SslInsecure = rserver1.SslInsecure.ValueBool()
+		}
+
+		if len(rserver1.CaCertificate.ValueString()) > 0 {
+			config.CaCertificate = rserver1.CaCertificate.ValueString()
+		}
+
+		config.TlsServerName = rserver1.TlsServerName.ValueString()
+		config.CertificateFingerprint = rserver1.CertificateFingerprint.ValueString()
+
+		if len(rserver1.AuthMethod.ValueString()) > 0 {
+			config.AuthMethod = rserver1.AuthMethod.ValueString()
+		}
+
+		if len(rserver1.SystemId.ValueString()) > 0 {
+			config.SystemId = rserver1.SystemId.ValueString()
+		}
+
+		if len(rserver1.ManagerId.ValueString()) > 0 {
+			config.ManagerId = rserver1.ManagerId.ValueString()
+		}
 	}
-	rserver1 := (*rserver)[0]
-	var redfishClientUser, redfishClientPass string
 
-	if len(rserver1.User.ValueString()) > 0 {
-		redfishClientUser = rserver1.User.ValueString()
-	} else if len(pconfig.Username) > 0 {
-		redfishClientUser = pconfig.Username
-	} else {
-		return nil, fmt.Errorf("error. Either provide username at provider level or resource level. Please check your configuration")
+	if len(config.Endpoint) == 0 {
+		return config, fmt.Errorf("error. Either provide endpoint at provider level or resource level. Please check your configuration")
 	}
 
-	if len(rserver1.Password.ValueString()) > 0 {
-		redfishClientPass = rserver1.Password.ValueString()
-	} else if len(pconfig.Password) > 0 {
-		redfishClientPass = pconfig.Password
-	} else {
-		return nil, fmt.Errorf("error. Either provide password at provider level or resource level. Please check your configuration")
+	if len(config.Username) == 0 {
+		return config, fmt.Errorf("error. Either provide username at provider level or resource level. Please check your configuration")
+	}
+
+	if len(config.Password) == 0 {
+		return config, fmt.Errorf("error. Either provide password at provider level or resource level. Please check your configuration")
+	}
+
+	return config, nil
+}
+
+// GetServerEndpoint returns endpoint of the system managed by resource, which is
+// taken from server block or from provider configuration if server block does not define it.
+func GetServerEndpoint(pconfig *IrmcProvider, rserver []models.RedfishServer) string {
+	if len(rserver) > 0 && len(rserver[0].Endpoint.ValueString()) > 0 {
+		return rserver[0].Endpoint.ValueString()
 	}
 
-	if len(redfishClientUser) == 0 || len(redfishClientPass) == 0 {
-		return nil, fmt.Errorf("error. Either Redfish client username or password has not been set. Please check your configuration")
+	if pconfig != nil {
+		return pconfig.Endpoint
+	}
+
+	return ""
+}
+
+// serverWithEndpoint returns copy of server block with endpoint replaced, so the system can be reached
+// under different address while remaining settings are still taken from server block or provider.
+func serverWithEndpoint(rserver []models.RedfishServer, endpoint string) []models.RedfishServer {
+	server := models.RedfishServer{}
+	if len(rserver) > 0 {
+		server = rserver[0]
+	}
+	server.Endpoint = types.StringValue(endpoint)
+	return []models.RedfishServer{server}
+}
+
+func ConnectTargetSystem(pconfig *IrmcProvider, rserver *[]models.RedfishServer) (*gofish.APIClient, error) {
+	config, err := resolveServerConfig(pconfig, *rserver)
+	if err != nil {
+		return nil, err
+	}
+
+	cache := pconfig.clientCache()
+	if cache != nil {
+		if api := cache.get(config); api != nil {
+			return api, nil
+		}
+	}
+
+	httpClient, err := newHTTPClient(pconfig, config)
+	if err != nil {
+		return nil, fmt.Errorf("error preparing HTTP client: %w", err)
 	}
 
 	clientConfig := gofish.ClientConfig{
-		Endpoint:  rserver1.Endpoint.ValueString(),
-		Username:  redfishClientUser,
-		Password:  redfishClientPass,
-		BasicAuth: true,
-		Insecure:  rserver1.SslInsecure.ValueBool(),
+		Endpoint:   config.Endpoint,
+		Username:   config.Username,
+		Password:   config.Password,
+		BasicAuth:  true,
+		Insecure:   config.SslInsecure,
+		HTTPClient: httpClient,
+	}
+
+	// Authentication with shared session is done by transport, so client does not
+	// keep any credentials and its Logout does not remove the session
+	if config.AuthMethod == AUTH_METHOD_SESSION {
+		session := sessionPool.getSession(config, &http.Client{Transport: httpClient.Transport})
+		httpClient.Transport = &sessionTransport{base: httpClient.Transport, session: session}
+		clientConfig.Username = ""
+		clientConfig.Password = ""
+		clientConfig.BasicAuth = false
+	} else if cache != nil {
+		// Cached client is shared, so Logout called by any resource must not affect it
+		httpClient.Transport = &basicAuthTransport{base: httpClient.Transport, username: config.Username, password: config.Password}
+		clientConfig.Username = ""
+		clientConfig.Password = ""
+		clientConfig.BasicAuth = false
 	}
+
+	if cache != nil {
+		httpClient.Transport = &keepAliveTransport{base: httpClient.Transport}
+	}
+
 	api, err := gofish.Connect(clientConfig)
 	if err != nil {
 		return nil, fmt.Errorf("error connecting to redfish API: %w", err)
 	}
 
+	if cache != nil {
+		api = cache.put(config, api)
+	} else {
+		registerClient(config, api)
+	}
+
 	return api, nil
 }
 
-// GetSystemResource returns ComputerSystem resource from target defined by service.
+// GetSystemResource returns ComputerSystem resource managed with client of service. It's member of Systems
+// collection selected with system_id or the first one if selection has not been configured.
+// If client of the service is cached, location of the system is looked up only once.
 func GetSystemResource(service *gofish.Service) (*redfish.ComputerSystem, error) {
-	systems, err := service.Systems()
+	var selector string
+	cached := lookupCachedClient(service.GetClient())
+	if cached != nil {
+		selector = cached.systemSelector
+		if id := cached.getSystemODataID(); len(id) > 0 {
+			return redfish.GetComputerSystem(service.GetClient(), id)
+		}
+	}
+
+	system, err := findCollectionMember(service.GetClient(), SYSTEMS_COLLECTION_ENDPOINT, selector, redfish.GetComputerSystem,
+		func(system *redfish.ComputerSystem) string { return system.ID })
 	if err != nil {
 		return nil, err
 	}
 
-	for _, system := range systems {
-		if system.ID == "0" { // at the moment only one specific 0 is supported
-			return system, nil
+	if system == nil {
+		if len(selector) > 0 {
+			return nil, fmt.Errorf("system with Id '%s' has not been found in Systems collection", selector)
 		}
+		return nil, fmt.Errorf("requested System resource has not been found on list")
 	}
 
-	return nil, fmt.Errorf("requested System resource has not been found on list")
+	if cached != nil {
+		cached.setSystemODataID(system.ODataID)
+	}
+	return system, nil
+}
+
+// GetManagerResource returns Manager resource (iRMC) managed with client of service. It's member of Managers
+// collection selected with manager_id or the first one if selection has not been configured.
+// If client of the service is cached, location of the manager is looked up only once.
+func GetManagerResource(service *gofish.Service) (*redfish.Manager, error) {
+	var selector string
+	cached := lookupCachedClient(service.GetClient())
+	if cached != nil {
+		selector = cached.managerSelector
+		if id := cached.getManagerODataID(); len(id) > 0 {
+			return redfish.GetManager(service.GetClient(), id)
+		}
+	}
+
+	manager, err := findCollectionMember(service.GetClient(), MANAGERS_COLLECTION_ENDPOINT, selector, redfish.GetManager,
+		func(manager *redfish.Manager) string { return manager.ID })
+	if err != nil {
+		return nil, err
+	}
+
+	if manager == nil {
+		if len(selector) > 0 {
+			return nil, fmt.Errorf("manager with Id '%s' has not been found in Managers collection", selector)
+		}
+		return nil, fmt.Errorf("no Manager resource has been found on list")
+	}
+
+	if cached != nil {
+		cached.setManagerODataID(manager.ODataID)
+	}
+	return manager, nil
+}
+
+// findCollectionMember reads members of collection in order reported by the system and returns
+// the one with Id equal to selector or the first one if selector is empty. Members are read
+// one by one (not concurrently like in gofish), so the first member is always the same one.
+// Nil is returned if there is no matching member.
+func findCollectionMember[T any](client common.Client, endpoint string, selector string,
+	get func(common.Client, string) (*T, error), id func(*T) string) (*T, error) {
+	collection, err := common.GetCollection(client, endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, link := range collection.ItemLinks {
+		member, err := get(client, link)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(selector) == 0 || id(member) == selector {
+			return member, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// GetSystemEndpoint returns location of System resource selected for client of service
+// (e.g.: /redfish/v1/Systems/0), which is used to build endpoints of its subresources.
+func GetSystemEndpoint(service *gofish.Service) (string, error) {
+	if cached := lookupCachedClient(service.GetClient()); cached != nil {
+		if id := cached.getSystemODataID(); len(id) > 0 {
+			return strings.TrimSuffix(id, "/"), nil
+		}
+	}
+
+	system, err := GetSystemResource(service)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(system.ODataID, "/"), nil
+}
+
+// GetManagerEndpoint returns location of Manager resource selected for client of service
+// (e.g.: /redfish/v1/Managers/iRMC), which is used to build endpoints of its subresources.
+func GetManagerEndpoint(service *gofish.Service) (string, error) {
+	if cached := lookupCachedClient(service.GetClient()); cached != nil {
+		if id := cached.getManagerODataID(); len(id) > 0 {
+			return strings.TrimSuffix(id, "/"), nil
+		}
+	}
+
+	manager, err := GetManagerResource(service)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(manager.ODataID, "/"), nil
 }
 
 func difference(a, b []string) []string {
@@ -237,33 +591,97 @@ func difference(a, b []string) []string {
 	return diff
 }
 
+// retryConnectWithTimeout connects to the system which is expected to be unavailable for a while
+// (e.g.: after iRMC reset). Time between attempts is taken from retry policy of provider.
 func retryConnectWithTimeout(ctx context.Context, pconfig *IrmcProvider, rserver *[]models.RedfishServer) (*gofish.APIClient, error) {
 	startTime := time.Now()
 	var apiClient *gofish.APIClient
 	var err error
-	timeout := 10 * time.Minute
+	policy := pconfig.retryPolicy()
+
+	// Cached connection might have been established before system went down
+	pconfig.InvalidateClients(GetServerEndpoint(pconfig, *rserver))
 
-	for time.Since(startTime) < timeout {
+	for attempt := 1; time.Since(startTime) < RECONNECT_TIMEOUT; attempt++ {
 		apiClient, err = ConnectTargetSystem(pconfig, rserver)
 		if err == nil {
 			tflog.Info(ctx, "Successfully connected to the IRMC system.")
 			return apiClient, nil
 		}
 
-		tflog.Warn(ctx, fmt.Sprintf("failed to connect to the IRMC system: %s. Retrying in 30 seconds...", err.Error()))
-		time.Sleep(30 * time.Second)
+		wait := policy.backoff(attempt)
+		tflog.Warn(ctx, fmt.Sprintf("failed to connect to the IRMC system: %s. Retrying in %s...", err.Error(), wait))
+		if cancelErr := sleepWithContext(ctx, wait); cancelErr != nil {
+			return nil, fmt.Errorf("reconnecting to the IRMC system has been cancelled: %w", cancelErr)
+		}
+	}
+
+	return nil, fmt.Errorf("connection timed out after %s: %w", RECONNECT_TIMEOUT, err)
+}
+
+// nonEmptyStrings returns values without empty entries, which iRMC reports for unused slots of fixed size lists.
+func nonEmptyStrings(values []string) []string {
+	result := []string{}
+	for _, value := range values {
+		if len(value) > 0 {
+			result = append(result, value)
+		}
+	}
+	return result
+}
+
+// padStrings extends values with empty entries up to number of slots of fixed size list reported by iRMC.
+// iRMC keeps slots which are not present in PATCH request, so unused slots must be cleared explicitly.
+func padStrings(values []string, slots int) []string {
+	for len(values) < slots {
+		values = append(values, "")
 	}
+	return values
+}
 
-	return nil, fmt.Errorf("connection timed out after 10 minutes: %w", err)
+// compareODataID orders collection members by their OData ID, so members with numeric IDs
+// are ordered numerically instead of lexically. Collections are fetched concurrently by gofish
+// and their order would change between reads otherwise.
+func compareODataID(a string, b string) int {
+	if len(a) != len(b) {
+		return len(a) - len(b)
+	}
+	return strings.Compare(a, b)
+}
+
+// matchesInventoryFilter reports whether inventory item with given status should be reported by data source.
+// Empty slots are reported as absent, unhealthy items are these which report health other than OK.
+func matchesInventoryFilter(status common.Status, populatedOnly bool, unhealthyOnly bool) bool {
+	if populatedOnly && status.State == common.AbsentState {
+		return false
+	}
+	if unhealthyOnly && (len(status.Health) == 0 || status.Health == common.OKHealth) {
+		return false
+	}
+	return true
+}
+
+// reconnectWithEndpoint waits for delay, until change of iRMC network settings takes effect, and connects
+// to the system again using endpoint, which might differ from the configured one after the change.
+func reconnectWithEndpoint(ctx context.Context, pconfig *IrmcProvider, rserver []models.RedfishServer, endpoint string, delay time.Duration) (*gofish.APIClient, error) {
+	if err := sleepWithContext(ctx, delay); err != nil {
+		return nil, fmt.Errorf("waiting for change of iRMC network settings has been cancelled: %w", err)
+	}
+
+	// Connections cached for previous endpoint have been closed by the change
+	pconfig.InvalidateClients(GetServerEndpoint(pconfig, rserver))
+
+	servers := serverWithEndpoint(rserver, endpoint)
+	return retryConnectWithTimeout(ctx, pconfig, &servers)
 }
 
 func restartIrmc(ctx context.Context, api *gofish.APIClient, RedfishServer []models.RedfishServer, provider *IrmcProvider) error {
-	managers, err := api.Service.Managers()
+	manager, err := GetManagerResource(api.Service)
 	if err != nil {
 		return fmt.Errorf("error retrieving Managers resource: %w", err)
 	}
 
-	err = managers[0].Reset(redfish.GracefulRestartResetType)
+	err = manager.Reset(redfish.GracefulRestartResetType)
 	if err != nil {
 		return fmt.Errorf("error resetting iRMC: %w", err)
 	}
@@ -278,36 +696,75 @@ func restartIrmc(ctx context.Context, api *gofish.APIClient, RedfishServer []mod
 		return fmt.Errorf("failed to check irmc status after reboot request : %w", err)
 	}
 
+	// First connection might have reached iRMC before it went down and got cached
+	provider.InvalidateClients(GetServerEndpoint(provider, RedfishServer))
 	return nil
 }
 
-// CloseResource is a generic function that closes an io.Closer
-// and handles the error, satisfying linters like errcheck.
-// T must be constrainted by io.Closer, meaning it must have a Close() error method implemented.
-func CloseResource[T io.Closer](resource T) {
-	if err := resource.Close(); err != nil {
-		log.Printf("Error closing resource: %v", err)
-	}
-}
-
-func IsFsasCheck(ctx context.Context, api *gofish.APIClient) (bool, error) {
-	res, err := api.Get("/redfish/v1/")
+// getRedfishResource reads resource pointed by endpoint into out and returns its ETag,
+// which is expected by iRMC in If-Match header of following PATCH request.
+func getRedfishResource(api *gofish.APIClient, endpoint string, out interface{}) (string, error) {
+	res, err := api.Get(endpoint)
 	if err != nil {
-		return false, fmt.Errorf("failed to retrieve /redfish/v1/ endpoint: %w", err)
+		return "", fmt.Errorf("GET on %s finished with error '%w'", endpoint, err)
 	}
 
 	defer CloseResource(res.Body)
 
-	var serviceRoot map[string]interface{}
-	if err := json.NewDecoder(res.Body).Decode(&serviceRoot); err != nil {
-		return false, fmt.Errorf("failed to parse response from /redfish/v1/: %w", err)
+	bodyBytes, err := io.ReadAll(res.Body)
+	if err != nil {
+		return "", fmt.Errorf("error during read of %s GET response body '%w'", endpoint, err)
 	}
 
-	if oem, ok := serviceRoot["Oem"].(map[string]interface{}); ok {
-		if _, ok := oem["Fsas"]; ok {
-			return true, nil
+	if err = json.Unmarshal(bodyBytes, out); err != nil {
+		return "", fmt.Errorf("error during unmarshal of %s GET response '%w'", endpoint, err)
+	}
+
+	etag := res.Header.Get(HTTP_HEADER_ETAG)
+	if len(etag) == 0 {
+		var meta struct {
+			Etag string `json:"@odata.etag"`
+		}
+		if err = json.Unmarshal(bodyBytes, &meta); err == nil {
+			etag = meta.Etag
 		}
 	}
 
-	return false, nil
+	return etag, nil
+}
+
+// getCollectionMembers returns paths of members of Redfish collection pointed by endpoint.
+func getCollectionMembers(api *gofish.APIClient, endpoint string) ([]string, error) {
+	var collection common.LinksCollection
+	if _, err := getRedfishResource(api, endpoint, &collection); err != nil {
+		return nil, err
+	}
+
+	return collection.ToStrings(), nil
+}
+
+// patchRedfishResource sends payload to resource pointed by endpoint. If etag is known,
+// request is rejected by iRMC when resource has been modified after it has been read.
+func patchRedfishResource(api *gofish.APIClient, endpoint string, etag string, payload interface{}) error {
+	headers := map[string]string{}
+	if len(etag) > 0 {
+		headers[HTTP_HEADER_IF_MATCH] = etag
+	}
+
+	res, err := api.PatchWithHeaders(endpoint, payload, headers)
+	if err != nil {
+		return fmt.Errorf("PATCH on %s finished with error '%w'", endpoint, err)
+	}
+
+	CloseResource(res.Body)
+	return nil
+}
+
+// CloseResource is a generic function that closes an io.Closer
+// and handles the error, satisfying linters like errcheck.
+// T must be constrainted by io.Closer, meaning it must have a Close() error method implemented.
+func CloseResource[T io.Closer](resource T) {
+	if err := resource.Close(); err != nil {
+		log.Printf("Error closing resource: %v", err)
+	}
 }
@@ -30,10 +30,19 @@ import (
 )
 
 const (
-	PERSISTENT_BOOT_ORDER_KEY = "PersistentBootConfigOrder"
-	BIOS_SETTINGS_ENDPOINT    = "/redfish/v1/Systems/0/Bios/Settings"
+	PERSISTENT_BOOT_ORDER_KEY     = "PersistentBootConfigOrder"
+	BIOS_SETTINGS_ENDPOINT_SUFFIX = "/Bios/Settings"
 )
 
+// getBiosSettingsEndpoint returns location of BIOS settings of system managed with service.
+func getBiosSettingsEndpoint(service *gofish.Service) (string, error) {
+	system, err := GetSystemEndpoint(service)
+	if err != nil {
+		return "", err
+	}
+	return system + BIOS_SETTINGS_ENDPOINT_SUFFIX, nil
+}
+
 func waitTillBiosSettingsApplied(ctx context.Context, service *gofish.Service, timeout int64, resetType redfish.ResetType) (diags diag.Diagnostics) {
 	poweredOn, err := isPoweredOn(service)
 	if err != nil {
@@ -47,9 +56,9 @@ func waitTillBiosSettingsApplied(ctx context.Context, service *gofish.Service, t
 	startTime := time.Now().Unix()
 
 	if !poweredOn {
-		err = changePowerState(service, true, timeout)
+		err = changePowerState(ctx, service, true, timeout)
 	} else {
-		err = resetHost(service, resetType, timeout)
+		err = resetHost(ctx, service, resetType, timeout)
 	}
 
 	// Due to BIOS setting change it might happen that host will be powered off after
@@ -82,7 +91,10 @@ func waitTillBiosSettingsApplied(ctx context.Context, service *gofish.Service, t
 			break
 		}
 
-		time.Sleep(2 * time.Second)
+		if err := sleepWithContext(ctx, 2*time.Second); err != nil {
+			diags.AddError("Waiting for BIOS settings to be applied has been cancelled", err.Error())
+			return diags
+		}
 		if time.Now().Unix()-startTime > timeout {
 			diags.AddError("Job timeout exceeded while operation has not finished", "Terminate")
 			return diags
@@ -0,0 +1,340 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/pem"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestConnectTargetSystem(t *testing.T) {
+	m := newMockRedfishServer(t, FSAS)
+
+	t.Run("ResourceCredentials", func(t *testing.T) {
+		rserver := m.redfishServer()
+		api, err := ConnectTargetSystem(&IrmcProvider{}, &rserver)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+		defer api.Logout()
+
+		if _, err := GetSystemResource(api.Service); err != nil {
+			t.Errorf("Unexpected error while reading system: %s", err.Error())
+		}
+	})
+
+	t.Run("ProviderCredentials", func(t *testing.T) {
+		rserver := []models.RedfishServer{{
+			Endpoint:    types.StringValue(m.URL),
+			SslInsecure: types.BoolValue(true),
+		}}
+		api, err := ConnectTargetSystem(&IrmcProvider{Username: mockUsername, Password: mockPassword}, &rserver)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+		defer api.Logout()
+
+		if _, err := GetSystemResource(api.Service); err != nil {
+			t.Errorf("Unexpected error while reading system: %s", err.Error())
+		}
+	})
+
+	t.Run("ProviderEndpoint", func(t *testing.T) {
+		pconfig := &IrmcProvider{Username: mockUsername, Password: mockPassword, Endpoint: m.URL, SslInsecure: true}
+		api, err := ConnectTargetSystem(pconfig, &[]models.RedfishServer{})
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+		defer api.Logout()
+
+		if _, err := GetSystemResource(api.Service); err != nil {
+			t.Errorf("Unexpected error while reading system: %s", err.Error())
+		}
+
+		if endpoint := GetServerEndpoint(pconfig, []models.RedfishServer{}); endpoint != m.URL {
+			t.Errorf("Got endpoint %s, expected %s", endpoint, m.URL)
+		}
+	})
+
+	t.Run("ServerOverridesProvider", func(t *testing.T) {
+		pconfig := &IrmcProvider{Username: "other", Password: "other", Endpoint: "https://192.0.2.1", SslInsecure: false}
+		rserver := m.redfishServer()
+		api, err := ConnectTargetSystem(pconfig, &rserver)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+		defer api.Logout()
+
+		if _, err := GetSystemResource(api.Service); err != nil {
+			t.Errorf("Unexpected error while reading system: %s", err.Error())
+		}
+
+		if endpoint := GetServerEndpoint(pconfig, rserver); endpoint != m.URL {
+			t.Errorf("Got endpoint %s, expected %s", endpoint, m.URL)
+		}
+	})
+
+	t.Run("ProviderCaCertificate", func(t *testing.T) {
+		caCertificate := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: m.Certificate().Raw}))
+		rserver := []models.RedfishServer{{Endpoint: types.StringValue(m.URL)}}
+
+		// Without CA certificate self signed certificate of the mock must be rejected
+		if _, err := ConnectTargetSystem(&IrmcProvider{Username: mockUsername, Password: mockPassword}, &rserver); err == nil {
+			t.Fatalf("Expected error for not trusted certificate")
+		}
+
+		pconfig := &IrmcProvider{Username: mockUsername, Password: mockPassword, CaCertificate: caCertificate}
+		api, err := ConnectTargetSystem(pconfig, &rserver)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+		defer api.Logout()
+
+		pconfig.CaCertificate = "invalid"
+		if _, err := ConnectTargetSystem(pconfig, &rserver); err == nil {
+			t.Errorf("Expected error for invalid CA certificate")
+		}
+	})
+
+	t.Run("MissingCredentials", func(t *testing.T) {
+		rserver := []models.RedfishServer{{
+			Endpoint:    types.StringValue(m.URL),
+			SslInsecure: types.BoolValue(true),
+		}}
+		if _, err := ConnectTargetSystem(&IrmcProvider{}, &rserver); err == nil {
+			t.Errorf("Expected error for missing credentials")
+		}
+	})
+
+	t.Run("MissingServer", func(t *testing.T) {
+		if _, err := ConnectTargetSystem(&IrmcProvider{}, &[]models.RedfishServer{}); err == nil {
+			t.Errorf("Expected error for missing server block")
+		}
+	})
+
+	t.Run("WrongPassword", func(t *testing.T) {
+		rserver := m.redfishServer()
+		rserver[0].Password = types.StringValue("wrong")
+		api, err := ConnectTargetSystem(&IrmcProvider{}, &rserver)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+		defer api.Logout()
+
+		if _, err := GetSystemResource(api.Service); err == nil {
+			t.Errorf("Expected error for wrong credentials")
+		}
+	})
+}
+
+func TestConnectTargetSystemTLS(t *testing.T) {
+	m := newMockRedfishServer(t, FSAS)
+	caCertificate := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: m.Certificate().Raw}))
+	sum := sha256.Sum256(m.Certificate().Raw)
+	fingerprint := hex.EncodeToString(sum[:])
+
+	connect := func(server models.RedfishServer) error {
+		server.User = types.StringValue(mockUsername)
+		server.Password = types.StringValue(mockPassword)
+		server.Endpoint = types.StringValue(m.URL)
+		rserver := []models.RedfishServer{server}
+
+		api, err := ConnectTargetSystem(&IrmcProvider{}, &rserver)
+		if err != nil {
+			return err
+		}
+		api.Logout()
+		return nil
+	}
+
+	t.Run("CaCertificateText", func(t *testing.T) {
+		if err := connect(models.RedfishServer{CaCertificate: types.StringValue(caCertificate)}); err != nil {
+			t.Errorf("Unexpected error: %s", err.Error())
+		}
+	})
+
+	t.Run("CaCertificateFile", func(t *testing.T) {
+		file := filepath.Join(t.TempDir(), "ca.pem")
+		if err := os.WriteFile(file, []byte(caCertificate), 0600); err != nil {
+			t.Fatalf("Could not write CA file: %s", err.Error())
+		}
+
+		if err := connect(models.RedfishServer{CaCertificate: types.StringValue(file)}); err != nil {
+			t.Errorf("Unexpected error: %s", err.Error())
+		}
+
+		if err := connect(models.RedfishServer{CaCertificate: types.StringValue(file + ".missing")}); err == nil {
+			t.Errorf("Expected error for missing CA file")
+		}
+	})
+
+	t.Run("TlsServerName", func(t *testing.T) {
+		// certificate of the mock is issued also for example.com
+		server := models.RedfishServer{
+			CaCertificate: types.StringValue(caCertificate),
+			TlsServerName: types.StringValue("example.com"),
+		}
+		if err := connect(server); err != nil {
+			t.Errorf("Unexpected error: %s", err.Error())
+		}
+
+		server.TlsServerName = types.StringValue("irmc.example.org")
+		if err := connect(server); err == nil {
+			t.Errorf("Expected error for not matching server name")
+		}
+	})
+
+	t.Run("CertificateFingerprint", func(t *testing.T) {
+		if err := connect(models.RedfishServer{CertificateFingerprint: types.StringValue(strings.ToUpper(fingerprint))}); err != nil {
+			t.Errorf("Unexpected error: %s", err.Error())
+		}
+
+		wrong := strings.Repeat("ab:", 31) + "ab"
+		if err := connect(models.RedfishServer{CertificateFingerprint: types.StringValue(wrong)}); err == nil {
+			t.Errorf("Expected error for not matching fingerprint")
+		}
+	})
+}
+
+func TestSystemAndManagerSelection(t *testing.T) {
+	m := newMockRedfishServer(t, FSAS)
+	m.put("/redfish/v1/Systems/1", map[string]interface{}{"Id": "1", "Name": "Node 1"})
+	m.put("/redfish/v1/Managers/iRMC1", map[string]interface{}{"Id": "iRMC1", "Name": "iRMC of node 1"})
+	m.update("/redfish/v1/Systems", map[string]interface{}{
+		"Members": []interface{}{
+			map[string]interface{}{"@odata.id": mockSystemEndpoint},
+			map[string]interface{}{"@odata.id": "/redfish/v1/Systems/1"},
+		},
+	})
+	m.update("/redfish/v1/Managers", map[string]interface{}{
+		"Members": []interface{}{
+			map[string]interface{}{"@odata.id": mockManagerEndpoint},
+			map[string]interface{}{"@odata.id": "/redfish/v1/Managers/iRMC1"},
+		},
+	})
+
+	t.Run("Default", func(t *testing.T) {
+		api := m.connect()
+
+		if endpoint, err := GetSystemEndpoint(api.Service); err != nil || endpoint != mockSystemEndpoint {
+			t.Errorf("Got system endpoint '%s' (%v), expected '%s'", endpoint, err, mockSystemEndpoint)
+		}
+		if endpoint, err := GetManagerEndpoint(api.Service); err != nil || endpoint != mockManagerEndpoint {
+			t.Errorf("Got manager endpoint '%s' (%v), expected '%s'", endpoint, err, mockManagerEndpoint)
+		}
+	})
+
+	t.Run("ServerBlock", func(t *testing.T) {
+		rserver := m.redfishServer()
+		rserver[0].SystemId = types.StringValue("1")
+		rserver[0].ManagerId = types.StringValue("iRMC1")
+		api, err := ConnectTargetSystem(&IrmcProvider{}, &rserver)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+		defer api.Logout()
+
+		if endpoint, err := GetSystemEndpoint(api.Service); err != nil || endpoint != "/redfish/v1/Systems/1" {
+			t.Errorf("Got system endpoint '%s' (%v), expected '/redfish/v1/Systems/1'", endpoint, err)
+		}
+		if endpoint, err := GetManagerEndpoint(api.Service); err != nil || endpoint != "/redfish/v1/Managers/iRMC1" {
+			t.Errorf("Got manager endpoint '%s' (%v), expected '/redfish/v1/Managers/iRMC1'", endpoint, err)
+		}
+	})
+
+	t.Run("Provider", func(t *testing.T) {
+		rserver := m.redfishServer()
+		pconfig := &IrmcProvider{SystemId: "1", clients: InitClientCacheInstance()}
+		api, err := ConnectTargetSystem(pconfig, &rserver)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+
+		requests := len(m.requestsTo(http.MethodGet, "/redfish/v1/Systems/1"))
+		for i := 0; i < 2; i++ {
+			system, err := GetSystemResource(api.Service)
+			if err != nil || system.ID != "1" {
+				t.Errorf("Got system %v (%v), expected system with Id '1'", system, err)
+			}
+		}
+
+		// Selected system is looked up in collection only once for cached client
+		if count := len(m.requestsTo(http.MethodGet, "/redfish/v1/Systems/1")) - requests; count != 2 {
+			t.Errorf("Got %d requests to selected system, expected 2", count)
+		}
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		rserver := m.redfishServer()
+		rserver[0].SystemId = types.StringValue("7")
+		api, err := ConnectTargetSystem(&IrmcProvider{}, &rserver)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+		defer api.Logout()
+
+		if _, err := GetSystemResource(api.Service); err == nil || !strings.Contains(err.Error(), "'7'") {
+			t.Errorf("Expected error for not existing system, got %v", err)
+		}
+	})
+}
+
+func TestMockRedfishServerEtag(t *testing.T) {
+	m := newMockRedfishServer(t, FSAS)
+	api := m.connect()
+	const path = "/redfish/v1/Systems/0/Oem/Fsas/BootConfig"
+
+	etag := m.etag(path)
+	payload := map[string]interface{}{"BootDevice": "Pxe"}
+
+	res, err := api.PatchWithHeaders(path, payload, map[string]string{HTTP_HEADER_IF_MATCH: etag})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+	CloseResource(res.Body)
+
+	if res.StatusCode != http.StatusOK {
+		t.Errorf("Got status %d, expected %d", res.StatusCode, http.StatusOK)
+	}
+
+	if etag == m.etag(path) {
+		t.Errorf("ETag has not been changed after PATCH")
+	}
+
+	// Second request with same ETag must be rejected
+	if _, err = api.PatchWithHeaders(path, payload, map[string]string{HTTP_HEADER_IF_MATCH: etag}); err == nil {
+		t.Errorf("Expected error for outdated ETag")
+	}
+}
+
+func TestDifference(t *testing.T) {
+	diff := difference([]string{"a", "b", "c"}, []string{"b"})
+	if len(diff) != 2 || diff[0] != "a" || diff[1] != "c" {
+		t.Errorf("Got %v, expected [a c]", diff)
+	}
+}
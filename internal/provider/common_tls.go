@@ -0,0 +1,133 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+)
+
+const (
+	TLS_HANDSHAKE_TIMEOUT = 10 * time.Second
+	PEM_HEADER_PREFIX     = "-----BEGIN"
+)
+
+var certificateFingerprintRegex = regexp.MustCompile(`^([0-9a-fA-F]{2}:?){31}[0-9a-fA-F]{2}$`)
+
+// loadCaCertificate returns PEM content of CA certificate. Value might be
+// either PEM encoded certificate itself or path to file containing it.
+func loadCaCertificate(value string) ([]byte, error) {
+	if strings.Contains(value, PEM_HEADER_PREFIX) {
+		return []byte(value), nil
+	}
+
+	content, err := os.ReadFile(value)
+	if err != nil {
+		return nil, fmt.Errorf("could not read CA certificate file: %w", err)
+	}
+
+	return content, nil
+}
+
+// normalizeFingerprint converts fingerprint to lowercase hex string without separators.
+func normalizeFingerprint(fingerprint string) string {
+	return strings.ToLower(strings.ReplaceAll(fingerprint, ":", ""))
+}
+
+// verifyCertificateFingerprint returns function checking whether certificate
+// presented by server matches expected SHA-256 fingerprint.
+func verifyCertificateFingerprint(fingerprint string) func(cs tls.ConnectionState) error {
+	expected := normalizeFingerprint(fingerprint)
+	return func(cs tls.ConnectionState) error {
+		if len(cs.PeerCertificates) == 0 {
+			return fmt.Errorf("server did not present any certificate")
+		}
+
+		sum := sha256.Sum256(cs.PeerCertificates[0].Raw)
+		if actual := hex.EncodeToString(sum[:]); actual != expected {
+			return fmt.Errorf("certificate fingerprint %s does not match expected %s", actual, expected)
+		}
+
+		return nil
+	}
+}
+
+// newTLSConfig prepares TLS configuration based on server settings. If certificate fingerprint
+// is defined, certificate chain is not verified and only pinned certificate is accepted.
+func newTLSConfig(config ServerConfig) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: config.SslInsecure,
+		ServerName:         config.TlsServerName,
+	}
+
+	if len(config.CaCertificate) > 0 {
+		content, err := loadCaCertificate(config.CaCertificate)
+		if err != nil {
+			return nil, err
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(content) {
+			return nil, fmt.Errorf("no valid PEM encoded certificate has been found in ca_certificate")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if len(config.CertificateFingerprint) > 0 {
+		if !certificateFingerprintRegex.MatchString(config.CertificateFingerprint) {
+			return nil, fmt.Errorf("certificate_fingerprint must be SHA-256 fingerprint in hex format")
+		}
+		tlsConfig.InsecureSkipVerify = true
+		tlsConfig.VerifyConnection = verifyCertificateFingerprint(config.CertificateFingerprint)
+	}
+
+	return tlsConfig, nil
+}
+
+// newHTTPClient creates HTTP client used for communication with Redfish API
+// respecting TLS, timeout and retry settings of provider.
+func newHTTPClient(pconfig *IrmcProvider, config ServerConfig) (*http.Client, error) {
+	tlsConfig, err := newTLSConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
+	transport := &http.Transport{
+		Proxy:                 http.ProxyFromEnvironment,
+		MaxIdleConns:          100,
+		IdleConnTimeout:       90 * time.Second,
+		ExpectContinueTimeout: time.Second,
+		TLSHandshakeTimeout:   TLS_HANDSHAKE_TIMEOUT,
+		TLSClientConfig:       tlsConfig,
+	}
+
+	retry := &retryTransport{base: transport, policy: pconfig.retryPolicy()}
+	if pconfig != nil && pconfig.Timeout > 0 {
+		retry.timeout = time.Duration(pconfig.Timeout) * time.Second
+	}
+
+	return &http.Client{Transport: &contextTransport{base: retry}}, nil
+}
@@ -0,0 +1,153 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/stmcginnis/gofish"
+	"github.com/stmcginnis/gofish/common"
+)
+
+// HTTP_HEADER_CONTEXT_TOKEN marks requests sent on behalf of operation with its own context.
+// It's used only inside provider and removed before request is sent to the system.
+const HTTP_HEADER_CONTEXT_TOKEN = "X-Irmc-Provider-Context"
+
+// Gofish binds context to client once on connection, so contexts of operations
+// are kept here and assigned to their requests by contextTransport.
+var (
+	requestContexts    sync.Map
+	requestContextsSeq atomic.Uint64
+)
+
+// sleepWithContext waits for given time or until ctx is cancelled.
+func sleepWithContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// withContext returns copy of service sending all its requests with ctx, so they are
+// interrupted once ctx is cancelled. Returned function must be called when service is not used anymore.
+func withContext(ctx context.Context, service *gofish.Service) (*gofish.Service, func()) {
+	token := strconv.FormatUint(requestContextsSeq.Add(1), 10)
+	requestContexts.Store(token, ctx)
+
+	bound := *service
+	bound.SetClient(&contextClient{Client: service.GetClient(), token: token})
+
+	return &bound, func() { requestContexts.Delete(token) }
+}
+
+// contextClient marks every request with token of operation context.
+type contextClient struct {
+	common.Client
+	token string
+}
+
+func (c *contextClient) headers(customHeaders map[string]string) map[string]string {
+	headers := map[string]string{HTTP_HEADER_CONTEXT_TOKEN: c.token}
+	for key, val := range customHeaders {
+		headers[key] = val
+	}
+	return headers
+}
+
+func (c *contextClient) Get(url string) (*http.Response, error) {
+	return c.Client.GetWithHeaders(url, c.headers(nil))
+}
+
+func (c *contextClient) GetWithHeaders(url string, customHeaders map[string]string) (*http.Response, error) {
+	return c.Client.GetWithHeaders(url, c.headers(customHeaders))
+}
+
+func (c *contextClient) Post(url string, payload interface{}) (*http.Response, error) {
+	return c.Client.PostWithHeaders(url, payload, c.headers(nil))
+}
+
+func (c *contextClient) PostWithHeaders(url string, payload interface{}, customHeaders map[string]string) (*http.Response, error) {
+	return c.Client.PostWithHeaders(url, payload, c.headers(customHeaders))
+}
+
+func (c *contextClient) PostMultipart(url string, payload map[string]io.Reader) (*http.Response, error) {
+	return c.Client.PostMultipartWithHeaders(url, payload, c.headers(nil))
+}
+
+func (c *contextClient) PostMultipartWithHeaders(url string, payload map[string]io.Reader, customHeaders map[string]string) (*http.Response, error) {
+	return c.Client.PostMultipartWithHeaders(url, payload, c.headers(customHeaders))
+}
+
+func (c *contextClient) Patch(url string, payload interface{}) (*http.Response, error) {
+	return c.Client.PatchWithHeaders(url, payload, c.headers(nil))
+}
+
+func (c *contextClient) PatchWithHeaders(url string, payload interface{}, customHeaders map[string]string) (*http.Response, error) {
+	return c.Client.PatchWithHeaders(url, payload, c.headers(customHeaders))
+}
+
+func (c *contextClient) Put(url string, payload interface{}) (*http.Response, error) {
+	return c.Client.PutWithHeaders(url, payload, c.headers(nil))
+}
+
+func (c *contextClient) PutWithHeaders(url string, payload interface{}, customHeaders map[string]string) (*http.Response, error) {
+	return c.Client.PutWithHeaders(url, payload, c.headers(customHeaders))
+}
+
+func (c *contextClient) Delete(url string) (*http.Response, error) {
+	return c.Client.DeleteWithHeaders(url, c.headers(nil))
+}
+
+func (c *contextClient) DeleteWithHeaders(url string, customHeaders map[string]string) (*http.Response, error) {
+	return c.Client.DeleteWithHeaders(url, c.headers(customHeaders))
+}
+
+// contextTransport assigns context of operation to requests marked with its token.
+type contextTransport struct {
+	base http.RoundTripper
+}
+
+func (t *contextTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	token := req.Header.Get(HTTP_HEADER_CONTEXT_TOKEN)
+	if len(token) == 0 {
+		return t.base.RoundTrip(req)
+	}
+
+	// Context which has been already released is not used anymore
+	ctx := req.Context()
+	if value, ok := requestContexts.Load(token); ok {
+		if opCtx, ok := value.(context.Context); ok {
+			ctx = opCtx
+		}
+	}
+
+	out := req.Clone(ctx)
+	out.Header.Del(HTTP_HEADER_CONTEXT_TOKEN)
+	return t.base.RoundTrip(out)
+}
@@ -0,0 +1,83 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestContextCancellation(t *testing.T) {
+	m := newMockRedfishServer(t, FSAS)
+	api := m.connect()
+
+	t.Run("InFlightRequest", func(t *testing.T) {
+		m.mu.Lock()
+		m.getHooks["/redfish/v1/Systems/0"] = func(m *mockRedfishServer, path string) {
+			delete(m.getHooks, path)
+			time.Sleep(3 * time.Second)
+		}
+		m.mu.Unlock()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+		defer cancel()
+
+		start := time.Now()
+		err := waitUntilHostStateChanged(ctx, api.Service, false, 60)
+		if err == nil {
+			t.Fatalf("Expected error for cancelled context")
+		}
+
+		if elapsed := time.Since(start); elapsed > 2*time.Second {
+			t.Errorf("Request has not been interrupted, took %s", elapsed)
+		}
+	})
+
+	t.Run("IrmcStatusCheck", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		err := checkIrmcStatus(ctx, api, 1, 60)
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("Expected cancellation error, got %v", err)
+		}
+	})
+
+	t.Run("ContextReleased", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		service, release := withContext(ctx, api.Service)
+		release()
+		cancel()
+
+		// Objects retrieved with bound service stay usable after operation finished
+		res, err := service.GetClient().Get("/redfish/v1/Systems/0")
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+		CloseResource(res.Body)
+
+		for _, req := range m.requestsTo(http.MethodGet, mockSystemEndpoint) {
+			if req.Header.Get(HTTP_HEADER_CONTEXT_TOKEN) != "" {
+				t.Errorf("Internal context token has been sent to the system")
+			}
+		}
+	})
+}
@@ -0,0 +1,40 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"testing"
+)
+
+func TestGetFirmwareInventoryList(t *testing.T) {
+	forEachMockFlavor(t, func(t *testing.T, m *mockRedfishServer) {
+		inventory, err := GetFirmwareInventoryList(m.connect())
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+
+		if len(inventory) != 2 {
+			t.Fatalf("Got %d inventory items, expected 2", len(inventory))
+		}
+
+		if inventory[0].Id.ValueString() != "BMC" || inventory[0].Version.ValueString() != "3.10P" ||
+			!inventory[0].Updateable.ValueBool() || inventory[0].Health.ValueString() != "OK" {
+			t.Errorf("Inventory item read incorrectly: %+v", inventory[0])
+		}
+	})
+}
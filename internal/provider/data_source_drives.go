@@ -0,0 +1,284 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stmcginnis/gofish/common"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &DrivesDataSource{}
+
+func NewDrivesDataSource() datasource.DataSource {
+	return &DrivesDataSource{}
+}
+
+// DrivesDataSource defines the data source implementation.
+type DrivesDataSource struct {
+	p *IrmcProvider
+}
+
+func (d *DrivesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + drivesName
+}
+
+func DrivesDataSourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "ID of storage resource the drives are attached to.",
+			Description:         "ID of storage resource the drives are attached to.",
+		},
+		"storage_controller_serial_number": schema.StringAttribute{
+			Required:            true,
+			MarkdownDescription: "Serial number of storage controller.",
+			Description:         "Serial number of storage controller.",
+		},
+		"drives": schema.ListNestedAttribute{
+			Computed:            true,
+			MarkdownDescription: "Physical drives attached to the storage controller.",
+			Description:         "Physical drives attached to the storage controller.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "ID of the drive.",
+						Description:         "ID of the drive.",
+					},
+					"name": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Name of the drive.",
+						Description:         "Name of the drive.",
+					},
+					"slot": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Slot location of the drive in format accepted by physical_drives of storage volume resource.",
+						Description:         "Slot location of the drive in format accepted by physical_drives of storage volume resource.",
+					},
+					"location": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Location of the drive as reported by the controller.",
+						Description:         "Location of the drive as reported by the controller.",
+					},
+					"media_type": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Media type of the drive (e.g. HDD, SSD).",
+						Description:         "Media type of the drive (e.g. HDD, SSD).",
+					},
+					"protocol": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Protocol used by the drive (e.g. SAS, SATA, NVMe).",
+						Description:         "Protocol used by the drive (e.g. SAS, SATA, NVMe).",
+					},
+					"capacity_bytes": schema.Int64Attribute{
+						Computed:            true,
+						MarkdownDescription: "Capacity of the drive in bytes.",
+						Description:         "Capacity of the drive in bytes.",
+					},
+					"manufacturer": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Manufacturer of the drive.",
+						Description:         "Manufacturer of the drive.",
+					},
+					"model": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Model of the drive.",
+						Description:         "Model of the drive.",
+					},
+					"serial_number": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Serial number of the drive.",
+						Description:         "Serial number of the drive.",
+					},
+					"firmware_version": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Firmware version of the drive.",
+						Description:         "Firmware version of the drive.",
+					},
+					"hotspare_type": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Hot spare type of the drive (e.g. None, Global, Dedicated).",
+						Description:         "Hot spare type of the drive (e.g. None, Global, Dedicated).",
+					},
+					"volumes": schema.ListAttribute{
+						Computed:            true,
+						MarkdownDescription: "List of IDs of volumes the drive is member of. Empty if the drive is not used by any volume.",
+						Description:         "List of IDs of volumes the drive is member of. Empty if the drive is not used by any volume.",
+						ElementType:         types.StringType,
+					},
+					"state": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "State of the drive.",
+						Description:         "State of the drive.",
+					},
+					"health": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Health status of the drive.",
+						Description:         "Health status of the drive.",
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *DrivesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Drives data source",
+		Attributes:          DrivesDataSourceSchema(),
+		Blocks:              RedfishServerDatasourceBlockMap(),
+	}
+}
+
+func (d *DrivesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	p, ok := req.ProviderData.(*IrmcProvider)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *IrmcProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.p = p
+}
+
+func (d *DrivesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "data-source-drives: read starts")
+
+	var state models.DrivesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, err := ConnectTargetSystem(d.p, &state.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError("service error: ", err.Error())
+		return
+	}
+
+	defer api.Logout()
+
+	storage, err := getSystemStorageFromSerialNumber(api.Service, state.StorageControllerSN.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error while reading storage controller", err.Error())
+		return
+	}
+
+	drives, err := storage.Drives()
+	if err != nil {
+		resp.Diagnostics.AddError("Error while reading drives of storage controller", err.Error())
+		return
+	}
+
+	state.Id = types.StringValue(storage.ODataID)
+	state.Drives = readDrivesToModel(ctx, drives)
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	tflog.Info(ctx, "data-source-drives: read ends")
+}
+
+// driveLinks represents links of drive resource, which are not exposed by gofish.
+type driveLinks struct {
+	Links struct {
+		Volumes common.Links
+	}
+}
+
+// readDrivesToModel converts drives attached to storage controller into data source model.
+func readDrivesToModel(ctx context.Context, drives []*redfish.Drive) []models.Drive {
+	slices.SortFunc(drives, func(a *redfish.Drive, b *redfish.Drive) int {
+		return compareODataID(a.ODataID, b.ODataID)
+	})
+
+	result := []models.Drive{}
+	for _, drive := range drives {
+		slot := types.StringNull()
+		location := types.StringNull()
+		if len(drive.Location) != 0 {
+			location = types.StringValue(drive.Location[0].Info)
+			if value, err := getDriveSlot(drive); err == nil {
+				slot = types.StringValue(value)
+			} else {
+				tflog.Warn(ctx, "Scanning disk location failed", map[string]interface{}{
+					"drive": drive.Location[0].Info,
+				})
+			}
+		}
+
+		// Older drives report firmware version as revision only
+		firmwareVersion := drive.FirmwareVersion
+		if len(firmwareVersion) == 0 {
+			firmwareVersion = drive.Revision
+		}
+
+		var links driveLinks
+		if err := json.Unmarshal(drive.RawData, &links); err != nil {
+			tflog.Warn(ctx, "Could not read volumes of drive", map[string]interface{}{
+				"drive": drive.ODataID,
+				"error": err.Error(),
+			})
+		}
+
+		volumes := []types.String{}
+		for _, volume := range links.Links.Volumes.ToStrings() {
+			volumes = append(volumes, types.StringValue(volume))
+		}
+
+		result = append(result, models.Drive{
+			Id:              types.StringValue(drive.ID),
+			Name:            types.StringValue(drive.Name),
+			Slot:            slot,
+			Location:        location,
+			MediaType:       types.StringValue(string(drive.MediaType)),
+			Protocol:        types.StringValue(string(drive.Protocol)),
+			CapacityBytes:   types.Int64Value(drive.CapacityBytes),
+			Manufacturer:    types.StringValue(drive.Manufacturer),
+			Model:           types.StringValue(drive.Model),
+			SerialNumber:    types.StringValue(drive.SerialNumber),
+			FirmwareVersion: types.StringValue(firmwareVersion),
+			HotspareType:    types.StringValue(string(drive.HotspareType)),
+			Volumes:         volumes,
+			State:           types.StringValue(string(drive.Status.State)),
+			Health:          types.StringValue(string(drive.Status.Health)),
+		})
+	}
+	return result
+}
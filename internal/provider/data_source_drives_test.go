@@ -0,0 +1,116 @@
+/*
+Copyright (c) 2024 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDrivesDataSource_positive(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDrivesDataSourceConfig(creds, os.Getenv("TF_TESTING_STORAGE_SERIAL_NUMBER")),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.irmc-redfish_drives.drv", "drives.0.slot"),
+					resource.TestCheckResourceAttrSet("data.irmc-redfish_drives.drv", "drives.0.media_type"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDrivesDataSource_negative_invalidServerSerial(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDrivesDataSourceConfig(creds, "qwerty"),
+				ExpectError: regexp.MustCompile("Error while reading storage controller"),
+			},
+		},
+	})
+}
+
+func testAccDrivesDataSourceConfig(testingInfo TestingServerCredentials, serial string) string {
+	return fmt.Sprintf(`
+	data "irmc-redfish_drives" "drv" {
+		server {
+			username     = "%s"
+			password     = "%s"
+			endpoint     = "https://%s"
+			ssl_insecure = true
+		}
+
+		storage_controller_serial_number = "%s"
+	}
+	`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		serial,
+	)
+}
+
+func TestReadDrivesToModel(t *testing.T) {
+	forEachMockFlavor(t, func(t *testing.T, m *mockRedfishServer) {
+		api := m.connect()
+
+		storage, err := getSystemStorageFromSerialNumber(api.Service, "SKC4910421")
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+
+		drives, err := storage.Drives()
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+
+		model := readDrivesToModel(context.Background(), drives)
+		if len(model) != 3 {
+			t.Fatalf("Expected 3 drives, got %d", len(model))
+		}
+
+		hdd := model[1]
+		if hdd.Id.ValueString() != "1" || hdd.Slot.ValueString() != "64-1" || hdd.Location.ValueString() != "[ 0 : 0 : 64 : 1 ]" ||
+			hdd.MediaType.ValueString() != "HDD" || hdd.Protocol.ValueString() != "SAS" || hdd.CapacityBytes.ValueInt64() != 600127266816 ||
+			hdd.SerialNumber.ValueString() != "DRV000001" || hdd.Health.ValueString() != "OK" {
+			t.Errorf("Drive read incorrectly: %+v", hdd)
+		}
+
+		if len(hdd.Volumes) != 1 || hdd.Volumes[0].ValueString() != "/redfish/v1/Systems/0/Storage/0/Volumes/0" {
+			t.Errorf("Unexpected volumes of drive: %v", hdd.Volumes)
+		}
+
+		ssd := model[2]
+		if ssd.Slot.ValueString() != "2" || ssd.MediaType.ValueString() != "SSD" || ssd.FirmwareVersion.ValueString() != "JXTC404Q" {
+			t.Errorf("Directly attached drive read incorrectly: %+v", ssd)
+		}
+
+		if len(ssd.Volumes) != 0 {
+			t.Errorf("Expected unused drive not to be member of any volume, got %v", ssd.Volumes)
+		}
+	})
+}
@@ -0,0 +1,491 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stmcginnis/gofish"
+	"github.com/stmcginnis/gofish/common"
+)
+
+const (
+	PCI_SLOT_LOCATION_TYPE = "Slot"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &HostEthernetInterfacesDataSource{}
+
+func NewHostEthernetInterfacesDataSource() datasource.DataSource {
+	return &HostEthernetInterfacesDataSource{}
+}
+
+// HostEthernetInterfacesDataSource defines the data source implementation.
+type HostEthernetInterfacesDataSource struct {
+	p *IrmcProvider
+}
+
+func (d *HostEthernetInterfacesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + hostEthernetInterfaces
+}
+
+func HostEthernetInterfacesDataSourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "ID of ethernet interfaces collection of the system.",
+			Description:         "ID of ethernet interfaces collection of the system.",
+		},
+		"interfaces": schema.ListNestedAttribute{
+			Computed:            true,
+			MarkdownDescription: "Ethernet interfaces of the system reported by iRMC.",
+			Description:         "Ethernet interfaces of the system reported by iRMC.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "ID of the ethernet interface.",
+						Description:         "ID of the ethernet interface.",
+					},
+					"name": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Name of the ethernet interface.",
+						Description:         "Name of the ethernet interface.",
+					},
+					"mac_address": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Currently configured MAC address of the interface.",
+						Description:         "Currently configured MAC address of the interface.",
+					},
+					"permanent_mac_address": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Permanent (burned-in) MAC address of the interface.",
+						Description:         "Permanent (burned-in) MAC address of the interface.",
+					},
+					"link_status": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Link status of the interface (e.g. LinkUp, LinkDown, NoLink).",
+						Description:         "Link status of the interface (e.g. LinkUp, LinkDown, NoLink).",
+					},
+					"speed_mbps": schema.Int64Attribute{
+						Computed:            true,
+						MarkdownDescription: "Current speed of the interface in Mbit/s.",
+						Description:         "Current speed of the interface in Mbit/s.",
+					},
+					"interface_enabled": schema.BoolAttribute{
+						Computed:            true,
+						MarkdownDescription: "Indicates whether the interface is enabled.",
+						Description:         "Indicates whether the interface is enabled.",
+					},
+					"health": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Health status of the interface.",
+						Description:         "Health status of the interface.",
+					},
+				},
+			},
+		},
+		"network_device_functions": schema.ListNestedAttribute{
+			Computed:            true,
+			MarkdownDescription: "Network device functions of network adapters installed in the system, together with physical ports they are assigned to.",
+			Description:         "Network device functions of network adapters installed in the system, together with physical ports they are assigned to.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "ID of the network device function.",
+						Description:         "ID of the network device function.",
+					},
+					"adapter_id": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "ID of the network adapter providing the function.",
+						Description:         "ID of the network adapter providing the function.",
+					},
+					"adapter_manufacturer": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Manufacturer of the network adapter.",
+						Description:         "Manufacturer of the network adapter.",
+					},
+					"adapter_model": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Model of the network adapter.",
+						Description:         "Model of the network adapter.",
+					},
+					"function_type": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Type of the network device function (e.g. Ethernet, iSCSI, FibreChannel).",
+						Description:         "Type of the network device function (e.g. Ethernet, iSCSI, FibreChannel).",
+					},
+					"pci_slot": schema.Int64Attribute{
+						Computed:            true,
+						MarkdownDescription: "Number of PCI slot the network adapter is installed in. Not set for onboard adapters or if not reported by iRMC.",
+						Description:         "Number of PCI slot the network adapter is installed in. Not set for onboard adapters or if not reported by iRMC.",
+					},
+					"port_number": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Number of physical port of the adapter the function is assigned to.",
+						Description:         "Number of physical port of the adapter the function is assigned to.",
+					},
+					"mac_address": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Currently configured MAC address of the function.",
+						Description:         "Currently configured MAC address of the function.",
+					},
+					"permanent_mac_address": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Permanent (burned-in) MAC address of the function.",
+						Description:         "Permanent (burned-in) MAC address of the function.",
+					},
+					"link_status": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Link status of the physical port (e.g. Up, Down).",
+						Description:         "Link status of the physical port (e.g. Up, Down).",
+					},
+					"speed_mbps": schema.Int64Attribute{
+						Computed:            true,
+						MarkdownDescription: "Current link speed of the physical port in Mbit/s.",
+						Description:         "Current link speed of the physical port in Mbit/s.",
+					},
+					"health": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Health status of the network device function.",
+						Description:         "Health status of the network device function.",
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *HostEthernetInterfacesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Host ethernet interfaces data source",
+		Attributes:          HostEthernetInterfacesDataSourceSchema(),
+		Blocks:              RedfishServerDatasourceBlockMap(),
+	}
+}
+
+func (d *HostEthernetInterfacesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	p, ok := req.ProviderData.(*IrmcProvider)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *IrmcProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.p = p
+}
+
+func (d *HostEthernetInterfacesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "data-source-host-ethernet-interfaces: read starts")
+
+	var state models.HostEthernetInterfacesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, err := ConnectTargetSystem(d.p, &state.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError("service error: ", err.Error())
+		return
+	}
+
+	defer api.Logout()
+
+	system, err := getHostSystemLinks(api)
+	if err != nil {
+		resp.Diagnostics.AddError("System Resource Detection Failed", err.Error())
+		return
+	}
+
+	state.Interfaces, err = readHostEthernetInterfaces(api, system.EthernetInterfaces.String())
+	if err != nil {
+		resp.Diagnostics.AddError("Error while reading host ethernet interfaces", err.Error())
+		return
+	}
+
+	state.NetworkDeviceFunctions, err = readHostNetworkDeviceFunctions(api, system.chassis)
+	if err != nil {
+		resp.Diagnostics.AddError("Error while reading network device functions", err.Error())
+		return
+	}
+
+	state.Id = types.StringValue(system.EthernetInterfaces.String())
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	tflog.Info(ctx, "data-source-host-ethernet-interfaces: read ends")
+}
+
+// hostSystemLinks contains links of ComputerSystem resource to related collections and chassis.
+type hostSystemLinks struct {
+	EthernetInterfaces common.Link `json:"EthernetInterfaces"`
+	Links              struct {
+		Chassis common.Links `json:"Chassis"`
+	} `json:"Links"`
+
+	// chassis contains chassis of the system or all chassis, if the system does not report them.
+	chassis []string
+}
+
+// getHostSystemLinks reads links of managed system to its collections and chassis.
+func getHostSystemLinks(api *gofish.APIClient) (*hostSystemLinks, error) {
+	endpoint, err := GetSystemEndpoint(api.Service)
+	if err != nil {
+		return nil, err
+	}
+
+	var system hostSystemLinks
+	if _, err = getRedfishResource(api, endpoint, &system); err != nil {
+		return nil, err
+	}
+
+	system.chassis = system.Links.Chassis.ToStrings()
+	if len(system.chassis) == 0 {
+		system.chassis, err = getCollectionMembers(api, CHASSIS_COLLECTION_ENDPOINT)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &system, nil
+}
+
+type redfishStatus struct {
+	State  string `json:"State"`
+	Health string `json:"Health"`
+}
+
+// readHostEthernetInterfaces reads all members of ethernet interfaces collection of the system.
+func readHostEthernetInterfaces(api *gofish.APIClient, collection string) ([]models.HostEthernetInterface, error) {
+	interfaces := []models.HostEthernetInterface{}
+	if len(collection) == 0 {
+		return interfaces, nil
+	}
+
+	members, err := getCollectionMembers(api, collection)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, member := range members {
+		var detail struct {
+			Id                  string        `json:"Id"`
+			Name                string        `json:"Name"`
+			MACAddress          string        `json:"MACAddress"`
+			PermanentMACAddress string        `json:"PermanentMACAddress"`
+			LinkStatus          string        `json:"LinkStatus"`
+			SpeedMbps           *int64        `json:"SpeedMbps"`
+			InterfaceEnabled    *bool         `json:"InterfaceEnabled"`
+			Status              redfishStatus `json:"Status"`
+		}
+
+		if _, err = getRedfishResource(api, member, &detail); err != nil {
+			return nil, err
+		}
+
+		interfaces = append(interfaces, models.HostEthernetInterface{
+			Id:                  types.StringValue(detail.Id),
+			Name:                types.StringValue(detail.Name),
+			MacAddress:          types.StringValue(detail.MACAddress),
+			PermanentMacAddress: types.StringValue(detail.PermanentMACAddress),
+			LinkStatus:          types.StringValue(detail.LinkStatus),
+			SpeedMbps:           types.Int64PointerValue(detail.SpeedMbps),
+			InterfaceEnabled:    types.BoolPointerValue(detail.InterfaceEnabled),
+			Health:              types.StringValue(detail.Status.Health),
+		})
+	}
+
+	return interfaces, nil
+}
+
+type networkAdapterSettings struct {
+	Id           string `json:"Id"`
+	Manufacturer string `json:"Manufacturer"`
+	Model        string `json:"Model"`
+	Location     struct {
+		PartLocation struct {
+			LocationType         string `json:"LocationType"`
+			LocationOrdinalValue *int64 `json:"LocationOrdinalValue"`
+		} `json:"PartLocation"`
+	} `json:"Location"`
+	NetworkDeviceFunctions common.Link `json:"NetworkDeviceFunctions"`
+}
+
+// pciSlot returns number of PCI slot the adapter is installed in, if it's reported.
+func (a *networkAdapterSettings) pciSlot() types.Int64 {
+	if a.Location.PartLocation.LocationType != PCI_SLOT_LOCATION_TYPE {
+		return types.Int64Null()
+	}
+	return types.Int64PointerValue(a.Location.PartLocation.LocationOrdinalValue)
+}
+
+type networkDeviceFunctionSettings struct {
+	Id             string `json:"Id"`
+	NetDevFuncType string `json:"NetDevFuncType"`
+	Ethernet       struct {
+		MACAddress          string `json:"MACAddress"`
+		PermanentMACAddress string `json:"PermanentMACAddress"`
+	} `json:"Ethernet"`
+	Status                  redfishStatus `json:"Status"`
+	AssignablePhysicalPorts common.Links  `json:"AssignablePhysicalPorts"`
+	PhysicalPortAssignment  common.Link   `json:"PhysicalPortAssignment"`
+	Links                   struct {
+		PhysicalNetworkPortAssignment common.Link `json:"PhysicalNetworkPortAssignment"`
+		PhysicalPortAssignment        common.Link `json:"PhysicalPortAssignment"`
+	} `json:"Links"`
+}
+
+// port returns path of physical port the function is assigned to. Port is looked up in links
+// used by subsequent versions of Redfish schema (Port and deprecated NetworkPort resources).
+func (f *networkDeviceFunctionSettings) port() string {
+	for _, link := range []common.Link{f.Links.PhysicalNetworkPortAssignment, f.Links.PhysicalPortAssignment, f.PhysicalPortAssignment} {
+		if len(link) > 0 {
+			return link.String()
+		}
+	}
+	if len(f.AssignablePhysicalPorts) == 1 {
+		return f.AssignablePhysicalPorts[0].String()
+	}
+	return ""
+}
+
+// physicalPortSettings contains properties of both NetworkPort and Port resources.
+type physicalPortSettings struct {
+	PhysicalPortNumber   string   `json:"PhysicalPortNumber"`
+	PortId               string   `json:"PortId"`
+	LinkStatus           string   `json:"LinkStatus"`
+	CurrentLinkSpeedMbps *int64   `json:"CurrentLinkSpeedMbps"`
+	CurrentSpeedGbps     *float64 `json:"CurrentSpeedGbps"`
+}
+
+func (p *physicalPortSettings) number() string {
+	if len(p.PhysicalPortNumber) > 0 {
+		return p.PhysicalPortNumber
+	}
+	return p.PortId
+}
+
+func (p *physicalPortSettings) speedMbps() types.Int64 {
+	if p.CurrentLinkSpeedMbps != nil {
+		return types.Int64Value(*p.CurrentLinkSpeedMbps)
+	}
+	if p.CurrentSpeedGbps != nil {
+		return types.Int64Value(int64(*p.CurrentSpeedGbps * 1000))
+	}
+	return types.Int64Null()
+}
+
+// readHostNetworkDeviceFunctions reads network device functions of all network adapters of given chassis.
+func readHostNetworkDeviceFunctions(api *gofish.APIClient, chassis []string) ([]models.HostNetworkDeviceFunction, error) {
+	functions := []models.HostNetworkDeviceFunction{}
+
+	for _, endpoint := range chassis {
+		var links struct {
+			NetworkAdapters common.Link `json:"NetworkAdapters"`
+		}
+		if _, err := getRedfishResource(api, endpoint, &links); err != nil {
+			return nil, err
+		}
+
+		if len(links.NetworkAdapters) == 0 {
+			continue
+		}
+
+		adapters, err := getCollectionMembers(api, links.NetworkAdapters.String())
+		if err != nil {
+			return nil, err
+		}
+
+		for _, adapterEndpoint := range adapters {
+			var adapter networkAdapterSettings
+			if _, err = getRedfishResource(api, adapterEndpoint, &adapter); err != nil {
+				return nil, err
+			}
+
+			adapterFunctions, err := readAdapterNetworkDeviceFunctions(api, &adapter)
+			if err != nil {
+				return nil, err
+			}
+			functions = append(functions, adapterFunctions...)
+		}
+	}
+
+	return functions, nil
+}
+
+func readAdapterNetworkDeviceFunctions(api *gofish.APIClient, adapter *networkAdapterSettings) ([]models.HostNetworkDeviceFunction, error) {
+	functions := []models.HostNetworkDeviceFunction{}
+	if len(adapter.NetworkDeviceFunctions) == 0 {
+		return functions, nil
+	}
+
+	members, err := getCollectionMembers(api, adapter.NetworkDeviceFunctions.String())
+	if err != nil {
+		return nil, err
+	}
+
+	for _, member := range members {
+		var function networkDeviceFunctionSettings
+		if _, err = getRedfishResource(api, member, &function); err != nil {
+			return nil, err
+		}
+
+		var port physicalPortSettings
+		if endpoint := function.port(); len(endpoint) > 0 {
+			if _, err = getRedfishResource(api, endpoint, &port); err != nil {
+				return nil, err
+			}
+		}
+
+		functions = append(functions, models.HostNetworkDeviceFunction{
+			Id:                  types.StringValue(function.Id),
+			AdapterId:           types.StringValue(adapter.Id),
+			AdapterManufacturer: types.StringValue(adapter.Manufacturer),
+			AdapterModel:        types.StringValue(adapter.Model),
+			FunctionType:        types.StringValue(function.NetDevFuncType),
+			PciSlot:             adapter.pciSlot(),
+			PortNumber:          types.StringValue(port.number()),
+			MacAddress:          types.StringValue(function.Ethernet.MACAddress),
+			PermanentMacAddress: types.StringValue(function.Ethernet.PermanentMACAddress),
+			LinkStatus:          types.StringValue(port.LinkStatus),
+			SpeedMbps:           port.speedMbps(),
+			Health:              types.StringValue(function.Status.Health),
+		})
+	}
+
+	return functions, nil
+}
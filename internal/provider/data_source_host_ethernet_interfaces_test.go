@@ -0,0 +1,154 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccHostEthernetInterfacesDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccHostEthernetInterfacesDataSourceConfig(creds),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.irmc-redfish_host_ethernet_interfaces.nics", "id"),
+					resource.TestCheckResourceAttrSet("data.irmc-redfish_host_ethernet_interfaces.nics", "interfaces.0.mac_address"),
+				),
+			},
+		},
+	})
+}
+
+func testAccHostEthernetInterfacesDataSourceConfig(testingInfo TestingServerCredentials) string {
+	return fmt.Sprintf(`
+	data "irmc-redfish_host_ethernet_interfaces" "nics" {
+		server {
+			username     = "%s"
+			password     = "%s"
+			endpoint     = "https://%s"
+			ssl_insecure = true
+		}
+	}
+	`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+	)
+}
+
+func TestReadHostEthernetInterfaces(t *testing.T) {
+	m := newMockRedfishServer(t, FSAS)
+	api := m.connect()
+
+	system, err := getHostSystemLinks(api)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+
+	interfaces, err := readHostEthernetInterfaces(api, system.EthernetInterfaces.String())
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+
+	if len(interfaces) != 2 {
+		t.Fatalf("Got %d interfaces, expected 2", len(interfaces))
+	}
+
+	if interfaces[0].MacAddress.ValueString() != "90:1b:0e:00:10:01" || interfaces[0].LinkStatus.ValueString() != "LinkUp" ||
+		interfaces[0].SpeedMbps.ValueInt64() != 1000 || !interfaces[0].InterfaceEnabled.ValueBool() {
+		t.Errorf("Interface read incorrectly: %+v", interfaces[0])
+	}
+
+	// Speed of interface without link is not reported
+	if !interfaces[1].SpeedMbps.IsNull() {
+		t.Errorf("Got speed %v of interface without link, expected null", interfaces[1].SpeedMbps)
+	}
+}
+
+func TestReadHostNetworkDeviceFunctions(t *testing.T) {
+	m := newMockRedfishServer(t, FSAS)
+	api := m.connect()
+
+	system, err := getHostSystemLinks(api)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+
+	functions, err := readHostNetworkDeviceFunctions(api, system.chassis)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+
+	if len(functions) != 2 {
+		t.Fatalf("Got %d network device functions, expected 2", len(functions))
+	}
+
+	for i, expected := range []struct {
+		mac        string
+		port       string
+		linkStatus string
+	}{
+		{"3c:fd:fe:00:00:01", "1", "Up"},
+		{"3c:fd:fe:00:00:02", "2", "Down"},
+	} {
+		function := functions[i]
+		if function.MacAddress.ValueString() != expected.mac || function.PortNumber.ValueString() != expected.port ||
+			function.LinkStatus.ValueString() != expected.linkStatus || function.PciSlot.ValueInt64() != 2 ||
+			function.AdapterId.ValueString() != "0" {
+			t.Errorf("Network device function %d read incorrectly: %+v", i, function)
+		}
+	}
+
+	if functions[0].SpeedMbps.ValueInt64() != 10000 {
+		t.Errorf("Got speed %v, expected 10000", functions[0].SpeedMbps)
+	}
+
+	// Onboard adapter is not placed in PCI slot
+	m.update("/redfish/v1/Chassis/0/NetworkAdapters/0", map[string]interface{}{
+		"Location": map[string]interface{}{"PartLocation": map[string]interface{}{"LocationType": "Embedded"}},
+	})
+	functions, err = readHostNetworkDeviceFunctions(api, system.chassis)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+	if !functions[0].PciSlot.IsNull() {
+		t.Errorf("Got PCI slot %v for onboard adapter, expected null", functions[0].PciSlot)
+	}
+}
+
+func TestGetHostSystemLinksWithoutChassis(t *testing.T) {
+	m := newMockRedfishServer(t, FSAS)
+	api := m.connect()
+
+	// All chassis are used if system does not link its chassis
+	m.update("/redfish/v1/Systems/0", map[string]interface{}{"Links": nil})
+
+	system, err := getHostSystemLinks(api)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+
+	if fmt.Sprint(system.chassis) != "[/redfish/v1/Chassis/0]" {
+		t.Errorf("Got chassis %v, expected [/redfish/v1/Chassis/0]", system.chassis)
+	}
+}
@@ -100,13 +100,18 @@ func (d *IrmcAttributesDataSource) Read(ctx context.Context, req datasource.Read
 		return
 	}
 
-	isFsas, err := IsFsasCheck(ctx, api)
+	vendor, err := GetOemVendor(ctx, api)
 	if err != nil {
 		resp.Diagnostics.AddError("Vendor Detection Failed", err.Error())
 		return
 	}
 
-	endp := getIrmcAttributesEndpoints(isFsas)
+	manager, err := GetManagerEndpoint(api.Service)
+	if err != nil {
+		resp.Diagnostics.AddError("Manager Resource Detection Failed", err.Error())
+		return
+	}
+	endp := getIrmcAttributesEndpoints(vendor, manager)
 
 	diags := readIrmcAttributesSettingsToModel(ctx, api.Service, &data.Attributes, true, endp.irmcAttributesSettingsEndpoint)
 	resp.Diagnostics.Append(diags...)
@@ -0,0 +1,221 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &MemoryDataSource{}
+
+func NewMemoryDataSource() datasource.DataSource {
+	return &MemoryDataSource{}
+}
+
+// MemoryDataSource defines the data source implementation.
+type MemoryDataSource struct {
+	p *IrmcProvider
+}
+
+func (d *MemoryDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + memoryName
+}
+
+func MemoryDataSourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "ID of memory collection of the system.",
+			Description:         "ID of memory collection of the system.",
+		},
+		"populated_only": schema.BoolAttribute{
+			Optional:            true,
+			MarkdownDescription: "If set to true, only populated memory module slots are reported.",
+			Description:         "If set to true, only populated memory module slots are reported.",
+		},
+		"unhealthy_only": schema.BoolAttribute{
+			Optional:            true,
+			MarkdownDescription: "If set to true, only memory modules with health status other than OK are reported.",
+			Description:         "If set to true, only memory modules with health status other than OK are reported.",
+		},
+		"memory": schema.ListNestedAttribute{
+			Computed:            true,
+			MarkdownDescription: "Memory modules (DIMMs) of the system matching filters.",
+			Description:         "Memory modules (DIMMs) of the system matching filters.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "ID of the memory module.",
+						Description:         "ID of the memory module.",
+					},
+					"device_locator": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Location of the memory slot (e.g. DIMM-1A).",
+						Description:         "Location of the memory slot (e.g. DIMM-1A).",
+					},
+					"capacity_mib": schema.Int64Attribute{
+						Computed:            true,
+						MarkdownDescription: "Capacity of the memory module in MiB.",
+						Description:         "Capacity of the memory module in MiB.",
+					},
+					"operating_speed_mhz": schema.Int64Attribute{
+						Computed:            true,
+						MarkdownDescription: "Operating speed of the memory module in MHz or MT/s.",
+						Description:         "Operating speed of the memory module in MHz or MT/s.",
+					},
+					"memory_device_type": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Type of the memory module (e.g. DDR5).",
+						Description:         "Type of the memory module (e.g. DDR5).",
+					},
+					"manufacturer": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Manufacturer of the memory module.",
+						Description:         "Manufacturer of the memory module.",
+					},
+					"part_number": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Part number of the memory module.",
+						Description:         "Part number of the memory module.",
+					},
+					"serial_number": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Serial number of the memory module.",
+						Description:         "Serial number of the memory module.",
+					},
+					"state": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "State of the memory slot (e.g. Enabled, Absent).",
+						Description:         "State of the memory slot (e.g. Enabled, Absent).",
+					},
+					"health": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Health status of the memory module.",
+						Description:         "Health status of the memory module.",
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *MemoryDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Memory data source",
+		Attributes:          MemoryDataSourceSchema(),
+		Blocks:              RedfishServerDatasourceBlockMap(),
+	}
+}
+
+func (d *MemoryDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	p, ok := req.ProviderData.(*IrmcProvider)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *IrmcProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.p = p
+}
+
+func (d *MemoryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "data-source-memory: read starts")
+
+	var state models.MemoryDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, err := ConnectTargetSystem(d.p, &state.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError("service error: ", err.Error())
+		return
+	}
+
+	defer api.Logout()
+
+	system, err := GetSystemResource(api.Service)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Fetching System Resource", err.Error())
+		return
+	}
+
+	items, err := system.Memory()
+	if err != nil {
+		resp.Diagnostics.AddError("Error while reading memory of the system", err.Error())
+		return
+	}
+
+	state.Id = types.StringValue(system.ODataID + "/Memory")
+	state.Memory = readMemoryToModel(items, state.PopulatedOnly.ValueBool(), state.UnhealthyOnly.ValueBool())
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	tflog.Info(ctx, "data-source-memory: read ends")
+}
+
+// readMemoryToModel converts memory modules matching filters into data source model.
+func readMemoryToModel(items []*redfish.Memory, populatedOnly bool, unhealthyOnly bool) []models.Memory {
+	slices.SortFunc(items, func(a *redfish.Memory, b *redfish.Memory) int {
+		return compareODataID(a.ODataID, b.ODataID)
+	})
+
+	result := []models.Memory{}
+	for _, item := range items {
+		if !matchesInventoryFilter(item.Status, populatedOnly, unhealthyOnly) {
+			continue
+		}
+
+		result = append(result, models.Memory{
+			Id:                types.StringValue(item.ID),
+			DeviceLocator:     types.StringValue(item.DeviceLocator),
+			CapacityMiB:       types.Int64Value(int64(item.CapacityMiB)),
+			OperatingSpeedMhz: types.Int64Value(int64(item.OperatingSpeedMhz)),
+			MemoryDeviceType:  types.StringValue(string(item.MemoryDeviceType)),
+			Manufacturer:      types.StringValue(item.Manufacturer),
+			PartNumber:        types.StringValue(item.PartNumber),
+			SerialNumber:      types.StringValue(item.SerialNumber),
+			State:             types.StringValue(string(item.Status.State)),
+			Health:            types.StringValue(string(item.Status.Health)),
+		})
+	}
+	return result
+}
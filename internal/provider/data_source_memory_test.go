@@ -0,0 +1,103 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccMemoryDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMemoryDataSourceConfig(creds),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.irmc-redfish_memory.dimms", "memory.0.device_locator"),
+					resource.TestCheckResourceAttrSet("data.irmc-redfish_memory.dimms", "memory.0.capacity_mib"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMemoryDataSourceConfig(testingInfo TestingServerCredentials) string {
+	return fmt.Sprintf(`
+	data "irmc-redfish_memory" "dimms" {
+		server {
+			username     = "%s"
+			password     = "%s"
+			endpoint     = "https://%s"
+			ssl_insecure = true
+		}
+
+		populated_only = true
+	}
+	`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+	)
+}
+
+func TestReadMemoryToModel(t *testing.T) {
+	forEachMockFlavor(t, func(t *testing.T, m *mockRedfishServer) {
+		m.update("/redfish/v1/Systems/0/Memory/2", map[string]any{"Status": map[string]any{"Health": "Warning"}})
+		api := m.connect()
+
+		system, err := GetSystemResource(api.Service)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+
+		memory, err := system.Memory()
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+
+		all := readMemoryToModel(memory, false, false)
+		if len(all) != 3 {
+			t.Fatalf("Expected 3 memory slots, got %d", len(all))
+		}
+
+		dimm := all[0]
+		if dimm.DeviceLocator.ValueString() != "DIMM-1A" || dimm.CapacityMiB.ValueInt64() != 32768 ||
+			dimm.OperatingSpeedMhz.ValueInt64() != 4800 || dimm.MemoryDeviceType.ValueString() != "DDR5" ||
+			dimm.Manufacturer.ValueString() != "Samsung" || dimm.PartNumber.ValueString() != "M321R4GA3BB6-CQK" ||
+			dimm.SerialNumber.ValueString() != "S0000001" || dimm.Health.ValueString() != "OK" {
+			t.Errorf("Memory read incorrectly: %+v", dimm)
+		}
+
+		if all[1].State.ValueString() != "Absent" {
+			t.Errorf("Expected empty slot to be reported as absent, got %+v", all[1])
+		}
+
+		populated := readMemoryToModel(memory, true, false)
+		if len(populated) != 2 || populated[0].DeviceLocator.ValueString() != "DIMM-1A" || populated[1].DeviceLocator.ValueString() != "DIMM-2A" {
+			t.Errorf("Unexpected populated memory slots: %+v", populated)
+		}
+
+		unhealthy := readMemoryToModel(memory, false, true)
+		if len(unhealthy) != 1 || unhealthy[0].DeviceLocator.ValueString() != "DIMM-2A" {
+			t.Errorf("Unexpected unhealthy memory slots: %+v", unhealthy)
+		}
+	})
+}
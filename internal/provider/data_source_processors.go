@@ -0,0 +1,233 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ProcessorsDataSource{}
+
+func NewProcessorsDataSource() datasource.DataSource {
+	return &ProcessorsDataSource{}
+}
+
+// ProcessorsDataSource defines the data source implementation.
+type ProcessorsDataSource struct {
+	p *IrmcProvider
+}
+
+func (d *ProcessorsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + processorsName
+}
+
+func ProcessorsDataSourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "ID of processors collection of the system.",
+			Description:         "ID of processors collection of the system.",
+		},
+		"populated_only": schema.BoolAttribute{
+			Optional:            true,
+			MarkdownDescription: "If set to true, only populated processor slots are reported.",
+			Description:         "If set to true, only populated processor slots are reported.",
+		},
+		"unhealthy_only": schema.BoolAttribute{
+			Optional:            true,
+			MarkdownDescription: "If set to true, only processors with health status other than OK are reported.",
+			Description:         "If set to true, only processors with health status other than OK are reported.",
+		},
+		"processors": schema.ListNestedAttribute{
+			Computed:            true,
+			MarkdownDescription: "Processors of the system matching filters.",
+			Description:         "Processors of the system matching filters.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "ID of the processor.",
+						Description:         "ID of the processor.",
+					},
+					"socket": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Socket or slot the processor is installed in.",
+						Description:         "Socket or slot the processor is installed in.",
+					},
+					"manufacturer": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Manufacturer of the processor.",
+						Description:         "Manufacturer of the processor.",
+					},
+					"model": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Model of the processor.",
+						Description:         "Model of the processor.",
+					},
+					"processor_type": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Type of the processor (e.g. CPU, GPU).",
+						Description:         "Type of the processor (e.g. CPU, GPU).",
+					},
+					"processor_architecture": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Architecture of the processor (e.g. x86).",
+						Description:         "Architecture of the processor (e.g. x86).",
+					},
+					"total_cores": schema.Int64Attribute{
+						Computed:            true,
+						MarkdownDescription: "Number of cores of the processor.",
+						Description:         "Number of cores of the processor.",
+					},
+					"total_threads": schema.Int64Attribute{
+						Computed:            true,
+						MarkdownDescription: "Number of threads of the processor.",
+						Description:         "Number of threads of the processor.",
+					},
+					"max_speed_mhz": schema.Int64Attribute{
+						Computed:            true,
+						MarkdownDescription: "Maximum clock speed of the processor in MHz.",
+						Description:         "Maximum clock speed of the processor in MHz.",
+					},
+					"operating_speed_mhz": schema.Int64Attribute{
+						Computed:            true,
+						MarkdownDescription: "Current clock speed of the processor in MHz.",
+						Description:         "Current clock speed of the processor in MHz.",
+					},
+					"state": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "State of the processor slot (e.g. Enabled, Absent).",
+						Description:         "State of the processor slot (e.g. Enabled, Absent).",
+					},
+					"health": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Health status of the processor.",
+						Description:         "Health status of the processor.",
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ProcessorsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Processors data source",
+		Attributes:          ProcessorsDataSourceSchema(),
+		Blocks:              RedfishServerDatasourceBlockMap(),
+	}
+}
+
+func (d *ProcessorsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	p, ok := req.ProviderData.(*IrmcProvider)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *IrmcProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.p = p
+}
+
+func (d *ProcessorsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "data-source-processors: read starts")
+
+	var state models.ProcessorsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, err := ConnectTargetSystem(d.p, &state.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError("service error: ", err.Error())
+		return
+	}
+
+	defer api.Logout()
+
+	system, err := GetSystemResource(api.Service)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Fetching System Resource", err.Error())
+		return
+	}
+
+	items, err := system.Processors()
+	if err != nil {
+		resp.Diagnostics.AddError("Error while reading processors of the system", err.Error())
+		return
+	}
+
+	state.Id = types.StringValue(system.ODataID + "/Processors")
+	state.Processors = readProcessorsToModel(items, state.PopulatedOnly.ValueBool(), state.UnhealthyOnly.ValueBool())
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	tflog.Info(ctx, "data-source-processors: read ends")
+}
+
+// readProcessorsToModel converts processors matching filters into data source model.
+func readProcessorsToModel(items []*redfish.Processor, populatedOnly bool, unhealthyOnly bool) []models.Processor {
+	slices.SortFunc(items, func(a *redfish.Processor, b *redfish.Processor) int {
+		return compareODataID(a.ODataID, b.ODataID)
+	})
+
+	result := []models.Processor{}
+	for _, item := range items {
+		if !matchesInventoryFilter(item.Status, populatedOnly, unhealthyOnly) {
+			continue
+		}
+
+		result = append(result, models.Processor{
+			Id:                    types.StringValue(item.ID),
+			Socket:                types.StringValue(item.Socket),
+			Manufacturer:          types.StringValue(item.Manufacturer),
+			Model:                 types.StringValue(item.Model),
+			ProcessorType:         types.StringValue(string(item.ProcessorType)),
+			ProcessorArchitecture: types.StringValue(string(item.ProcessorArchitecture)),
+			TotalCores:            types.Int64Value(int64(item.TotalCores)),
+			TotalThreads:          types.Int64Value(int64(item.TotalThreads)),
+			MaxSpeedMHz:           types.Int64Value(int64(item.MaxSpeedMHz)),
+			OperatingSpeedMHz:     types.Int64Value(int64(item.OperatingSpeedMHz)),
+			State:                 types.StringValue(string(item.Status.State)),
+			Health:                types.StringValue(string(item.Status.Health)),
+		})
+	}
+	return result
+}
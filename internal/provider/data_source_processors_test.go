@@ -0,0 +1,95 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccProcessorsDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProcessorsDataSourceConfig(creds),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.irmc-redfish_processors.cpus", "processors.0.model"),
+					resource.TestCheckResourceAttrSet("data.irmc-redfish_processors.cpus", "processors.0.total_cores"),
+				),
+			},
+		},
+	})
+}
+
+func testAccProcessorsDataSourceConfig(testingInfo TestingServerCredentials) string {
+	return fmt.Sprintf(`
+	data "irmc-redfish_processors" "cpus" {
+		server {
+			username     = "%s"
+			password     = "%s"
+			endpoint     = "https://%s"
+			ssl_insecure = true
+		}
+
+		populated_only = true
+	}
+	`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+	)
+}
+
+func TestReadProcessorsToModel(t *testing.T) {
+	forEachMockFlavor(t, func(t *testing.T, m *mockRedfishServer) {
+		m.update("/redfish/v1/Systems/0/Processors/1", map[string]any{"Status": map[string]any{"Health": "Critical"}})
+		api := m.connect()
+
+		system, err := GetSystemResource(api.Service)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+
+		processors, err := system.Processors()
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+
+		all := readProcessorsToModel(processors, false, false)
+		if len(all) != 2 {
+			t.Fatalf("Expected 2 processors, got %d", len(all))
+		}
+
+		cpu := all[0]
+		if cpu.Id.ValueString() != "0" || cpu.Socket.ValueString() != "CPU1" || cpu.Model.ValueString() != "Intel(R) Xeon(R) Gold 6430" ||
+			cpu.ProcessorType.ValueString() != "CPU" || cpu.ProcessorArchitecture.ValueString() != "x86" ||
+			cpu.TotalCores.ValueInt64() != 16 || cpu.TotalThreads.ValueInt64() != 32 ||
+			cpu.MaxSpeedMHz.ValueInt64() != 4000 || cpu.OperatingSpeedMHz.ValueInt64() != 2100 ||
+			cpu.State.ValueString() != "Enabled" || cpu.Health.ValueString() != "OK" {
+			t.Errorf("Processor read incorrectly: %+v", cpu)
+		}
+
+		unhealthy := readProcessorsToModel(processors, true, true)
+		if len(unhealthy) != 1 || unhealthy[0].Socket.ValueString() != "CPU2" || unhealthy[0].Health.ValueString() != "Critical" {
+			t.Errorf("Unexpected unhealthy processors: %+v", unhealthy)
+		}
+	})
+}
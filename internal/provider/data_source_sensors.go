@@ -0,0 +1,678 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stmcginnis/gofish"
+	"github.com/stmcginnis/gofish/common"
+)
+
+const (
+	SENSOR_READING_TYPE_TEMPERATURE = "Temperature"
+	SENSOR_READING_UNITS_RPM        = "RPM"
+	SENSOR_READING_UNITS_PERCENT    = "Percent"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &SensorsDataSource{}
+
+func NewSensorsDataSource() datasource.DataSource {
+	return &SensorsDataSource{}
+}
+
+// SensorsDataSource defines the data source implementation.
+type SensorsDataSource struct {
+	p *IrmcProvider
+}
+
+func (d *SensorsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + sensorsName
+}
+
+func SensorsDataSourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "ID of chassis resource the sensors belong to.",
+			Description:         "ID of chassis resource the sensors belong to.",
+		},
+		"power_consumed_watts": schema.Float64Attribute{
+			Computed:            true,
+			MarkdownDescription: "Current power consumption of the chassis in Watts.",
+			Description:         "Current power consumption of the chassis in Watts.",
+		},
+		"temperatures": schema.ListNestedAttribute{
+			Computed:            true,
+			MarkdownDescription: "Temperature sensors of the chassis.",
+			Description:         "Temperature sensors of the chassis.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Name of the temperature sensor.",
+						Description:         "Name of the temperature sensor.",
+					},
+					"physical_context": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Area or device the temperature is measured for (e.g. Intake, CPU).",
+						Description:         "Area or device the temperature is measured for (e.g. Intake, CPU).",
+					},
+					"reading_celsius": schema.Float64Attribute{
+						Computed:            true,
+						MarkdownDescription: "Current temperature in degrees Celsius.",
+						Description:         "Current temperature in degrees Celsius.",
+					},
+					"upper_threshold_non_critical": schema.Float64Attribute{
+						Computed:            true,
+						MarkdownDescription: "Temperature in degrees Celsius above which the reading is out of normal range.",
+						Description:         "Temperature in degrees Celsius above which the reading is out of normal range.",
+					},
+					"upper_threshold_critical": schema.Float64Attribute{
+						Computed:            true,
+						MarkdownDescription: "Temperature in degrees Celsius above which the reading is critical.",
+						Description:         "Temperature in degrees Celsius above which the reading is critical.",
+					},
+					"upper_threshold_fatal": schema.Float64Attribute{
+						Computed:            true,
+						MarkdownDescription: "Temperature in degrees Celsius above which the reading is fatal.",
+						Description:         "Temperature in degrees Celsius above which the reading is fatal.",
+					},
+					"state": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "State of the sensor.",
+						Description:         "State of the sensor.",
+					},
+					"health": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Health status of the sensor.",
+						Description:         "Health status of the sensor.",
+					},
+				},
+			},
+		},
+		"fans": schema.ListNestedAttribute{
+			Computed:            true,
+			MarkdownDescription: "Fans of the chassis.",
+			Description:         "Fans of the chassis.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Name of the fan.",
+						Description:         "Name of the fan.",
+					},
+					"reading": schema.Float64Attribute{
+						Computed:            true,
+						MarkdownDescription: "Current speed of the fan.",
+						Description:         "Current speed of the fan.",
+					},
+					"reading_units": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Units of speed of the fan (RPM or Percent).",
+						Description:         "Units of speed of the fan (RPM or Percent).",
+					},
+					"lower_threshold_non_critical": schema.Float64Attribute{
+						Computed:            true,
+						MarkdownDescription: "Speed of the fan below which the reading is out of normal range.",
+						Description:         "Speed of the fan below which the reading is out of normal range.",
+					},
+					"lower_threshold_critical": schema.Float64Attribute{
+						Computed:            true,
+						MarkdownDescription: "Speed of the fan below which the reading is critical.",
+						Description:         "Speed of the fan below which the reading is critical.",
+					},
+					"state": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "State of the fan.",
+						Description:         "State of the fan.",
+					},
+					"health": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Health status of the fan.",
+						Description:         "Health status of the fan.",
+					},
+				},
+			},
+		},
+		"power_supplies": schema.ListNestedAttribute{
+			Computed:            true,
+			MarkdownDescription: "Power supplies of the chassis.",
+			Description:         "Power supplies of the chassis.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Name of the power supply.",
+						Description:         "Name of the power supply.",
+					},
+					"model": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Model of the power supply.",
+						Description:         "Model of the power supply.",
+					},
+					"serial_number": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Serial number of the power supply.",
+						Description:         "Serial number of the power supply.",
+					},
+					"power_capacity_watts": schema.Float64Attribute{
+						Computed:            true,
+						MarkdownDescription: "Maximum capacity of the power supply in Watts.",
+						Description:         "Maximum capacity of the power supply in Watts.",
+					},
+					"input_power_watts": schema.Float64Attribute{
+						Computed:            true,
+						MarkdownDescription: "Current input power of the power supply in Watts.",
+						Description:         "Current input power of the power supply in Watts.",
+					},
+					"output_power_watts": schema.Float64Attribute{
+						Computed:            true,
+						MarkdownDescription: "Current output power of the power supply in Watts.",
+						Description:         "Current output power of the power supply in Watts.",
+					},
+					"line_input_voltage": schema.Float64Attribute{
+						Computed:            true,
+						MarkdownDescription: "Current line input voltage of the power supply in Volts.",
+						Description:         "Current line input voltage of the power supply in Volts.",
+					},
+					"state": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "State of the power supply.",
+						Description:         "State of the power supply.",
+					},
+					"health": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Health status of the power supply.",
+						Description:         "Health status of the power supply.",
+					},
+				},
+			},
+		},
+		"redundancy": schema.ListNestedAttribute{
+			Computed:            true,
+			MarkdownDescription: "Redundancy groups of power supplies and fans of the chassis.",
+			Description:         "Redundancy groups of power supplies and fans of the chassis.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Name of the redundancy group.",
+						Description:         "Name of the redundancy group.",
+					},
+					"mode": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Redundancy mode of the group (e.g. N+m, Sparing).",
+						Description:         "Redundancy mode of the group (e.g. N+m, Sparing).",
+					},
+					"state": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "State of the redundancy group.",
+						Description:         "State of the redundancy group.",
+					},
+					"health": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Health status of the redundancy group, which is not OK when redundancy is lost.",
+						Description:         "Health status of the redundancy group, which is not OK when redundancy is lost.",
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *SensorsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Sensors data source",
+		Attributes:          SensorsDataSourceSchema(),
+		Blocks:              RedfishServerDatasourceBlockMap(),
+	}
+}
+
+func (d *SensorsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	p, ok := req.ProviderData.(*IrmcProvider)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *IrmcProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.p = p
+}
+
+func (d *SensorsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "data-source-sensors: read starts")
+
+	var state models.SensorsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, err := ConnectTargetSystem(d.p, &state.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError("service error: ", err.Error())
+		return
+	}
+
+	defer api.Logout()
+
+	system, err := getHostSystemLinks(api)
+	if err != nil {
+		resp.Diagnostics.AddError("Error while reading system links", err.Error())
+		return
+	}
+
+	if len(system.chassis) == 0 {
+		resp.Diagnostics.AddError("Error while reading sensors", "System does not report any chassis")
+		return
+	}
+
+	if err = readSensorsToModel(ctx, api, system.chassis[0], &state); err != nil {
+		resp.Diagnostics.AddError("Error while reading sensors of the chassis", err.Error())
+		return
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	tflog.Info(ctx, "data-source-sensors: read ends")
+}
+
+// chassisSensorLinks contains links of Chassis resource to telemetry resources. Older firmware
+// reports Thermal and Power resources, newer one ThermalSubsystem, PowerSubsystem and Sensors.
+type chassisSensorLinks struct {
+	Thermal            common.Link `json:"Thermal"`
+	Power              common.Link `json:"Power"`
+	ThermalSubsystem   common.Link `json:"ThermalSubsystem"`
+	PowerSubsystem     common.Link `json:"PowerSubsystem"`
+	Sensors            common.Link `json:"Sensors"`
+	EnvironmentMetrics common.Link `json:"EnvironmentMetrics"`
+}
+
+type redundancySettings struct {
+	Name   string        `json:"Name"`
+	Mode   string        `json:"Mode"`
+	Status redfishStatus `json:"Status"`
+}
+
+type thermalSettings struct {
+	Temperatures []struct {
+		Name                      string        `json:"Name"`
+		PhysicalContext           string        `json:"PhysicalContext"`
+		ReadingCelsius            *float64      `json:"ReadingCelsius"`
+		UpperThresholdNonCritical *float64      `json:"UpperThresholdNonCritical"`
+		UpperThresholdCritical    *float64      `json:"UpperThresholdCritical"`
+		UpperThresholdFatal       *float64      `json:"UpperThresholdFatal"`
+		Status                    redfishStatus `json:"Status"`
+	} `json:"Temperatures"`
+	Fans []struct {
+		Name                      string        `json:"Name"`
+		FanName                   string        `json:"FanName"`
+		Reading                   *float64      `json:"Reading"`
+		ReadingUnits              string        `json:"ReadingUnits"`
+		LowerThresholdNonCritical *float64      `json:"LowerThresholdNonCritical"`
+		LowerThresholdCritical    *float64      `json:"LowerThresholdCritical"`
+		Status                    redfishStatus `json:"Status"`
+	} `json:"Fans"`
+	Redundancy []redundancySettings `json:"Redundancy"`
+}
+
+type powerSettings struct {
+	PowerControl []struct {
+		PowerConsumedWatts *float64 `json:"PowerConsumedWatts"`
+	} `json:"PowerControl"`
+	PowerSupplies []struct {
+		Name                 string        `json:"Name"`
+		Model                string        `json:"Model"`
+		SerialNumber         string        `json:"SerialNumber"`
+		PowerCapacityWatts   *float64      `json:"PowerCapacityWatts"`
+		PowerInputWatts      *float64      `json:"PowerInputWatts"`
+		LastPowerOutputWatts *float64      `json:"LastPowerOutputWatts"`
+		LineInputVoltage     *float64      `json:"LineInputVoltage"`
+		Status               redfishStatus `json:"Status"`
+	} `json:"PowerSupplies"`
+	Redundancy []redundancySettings `json:"Redundancy"`
+}
+
+// sensorExcerpt represents reading of sensor embedded in other resources.
+type sensorExcerpt struct {
+	Reading *float64 `json:"Reading"`
+}
+
+type sensorSettings struct {
+	Name            string        `json:"Name"`
+	ReadingType     string        `json:"ReadingType"`
+	Reading         *float64      `json:"Reading"`
+	PhysicalContext string        `json:"PhysicalContext"`
+	Status          redfishStatus `json:"Status"`
+	Thresholds      struct {
+		UpperCaution  sensorExcerpt `json:"UpperCaution"`
+		UpperCritical sensorExcerpt `json:"UpperCritical"`
+		UpperFatal    sensorExcerpt `json:"UpperFatal"`
+	} `json:"Thresholds"`
+}
+
+// readSensorsToModel reads telemetry of chassis into data source model, preferring Thermal and Power
+// resources and falling back to ThermalSubsystem and PowerSubsystem if chassis does not report them.
+func readSensorsToModel(ctx context.Context, api *gofish.APIClient, chassis string, state *models.SensorsDataSourceModel) error {
+	var links chassisSensorLinks
+	if _, err := getRedfishResource(api, chassis, &links); err != nil {
+		return err
+	}
+
+	state.Id = types.StringValue(chassis)
+	state.PowerConsumedWatts = types.Float64Null()
+	state.Temperatures = []models.TemperatureSensor{}
+	state.Fans = []models.FanSensor{}
+	state.PowerSupplies = []models.PowerSupplySensor{}
+	state.Redundancy = []models.SensorRedundancy{}
+
+	var err error
+	if len(links.Thermal) != 0 {
+		err = readThermalToModel(api, string(links.Thermal), state)
+	} else if len(links.ThermalSubsystem) != 0 {
+		tflog.Info(ctx, "Chassis does not report Thermal resource, reading ThermalSubsystem")
+		err = readThermalSubsystemToModel(api, string(links.ThermalSubsystem), string(links.Sensors), state)
+	}
+	if err != nil {
+		return err
+	}
+
+	if len(links.Power) != 0 {
+		err = readPowerToModel(api, string(links.Power), state)
+	} else if len(links.PowerSubsystem) != 0 {
+		tflog.Info(ctx, "Chassis does not report Power resource, reading PowerSubsystem")
+		err = readPowerSubsystemToModel(api, string(links.PowerSubsystem), string(links.EnvironmentMetrics), state)
+	}
+	return err
+}
+
+func redundancyToModel(redundancy []redundancySettings) []models.SensorRedundancy {
+	out := []models.SensorRedundancy{}
+	for _, group := range redundancy {
+		out = append(out, models.SensorRedundancy{
+			Name:   types.StringValue(group.Name),
+			Mode:   types.StringValue(group.Mode),
+			State:  types.StringValue(group.Status.State),
+			Health: types.StringValue(group.Status.Health),
+		})
+	}
+	return out
+}
+
+// readThermalToModel reads temperatures and fans from Thermal resource of chassis.
+func readThermalToModel(api *gofish.APIClient, endpoint string, state *models.SensorsDataSourceModel) error {
+	var thermal thermalSettings
+	if _, err := getRedfishResource(api, endpoint, &thermal); err != nil {
+		return err
+	}
+
+	for _, temperature := range thermal.Temperatures {
+		state.Temperatures = append(state.Temperatures, models.TemperatureSensor{
+			Name:                      types.StringValue(temperature.Name),
+			PhysicalContext:           types.StringValue(temperature.PhysicalContext),
+			ReadingCelsius:            types.Float64PointerValue(temperature.ReadingCelsius),
+			UpperThresholdNonCritical: types.Float64PointerValue(temperature.UpperThresholdNonCritical),
+			UpperThresholdCritical:    types.Float64PointerValue(temperature.UpperThresholdCritical),
+			UpperThresholdFatal:       types.Float64PointerValue(temperature.UpperThresholdFatal),
+			State:                     types.StringValue(temperature.Status.State),
+			Health:                    types.StringValue(temperature.Status.Health),
+		})
+	}
+
+	for _, fan := range thermal.Fans {
+		// Fans of older schema versions are named by FanName property
+		name := fan.Name
+		if len(name) == 0 {
+			name = fan.FanName
+		}
+
+		state.Fans = append(state.Fans, models.FanSensor{
+			Name:                      types.StringValue(name),
+			Reading:                   types.Float64PointerValue(fan.Reading),
+			ReadingUnits:              types.StringValue(fan.ReadingUnits),
+			LowerThresholdNonCritical: types.Float64PointerValue(fan.LowerThresholdNonCritical),
+			LowerThresholdCritical:    types.Float64PointerValue(fan.LowerThresholdCritical),
+			State:                     types.StringValue(fan.Status.State),
+			Health:                    types.StringValue(fan.Status.Health),
+		})
+	}
+
+	state.Redundancy = append(state.Redundancy, redundancyToModel(thermal.Redundancy)...)
+	return nil
+}
+
+// readPowerToModel reads power consumption and power supplies from Power resource of chassis.
+func readPowerToModel(api *gofish.APIClient, endpoint string, state *models.SensorsDataSourceModel) error {
+	var power powerSettings
+	if _, err := getRedfishResource(api, endpoint, &power); err != nil {
+		return err
+	}
+
+	if len(power.PowerControl) != 0 {
+		state.PowerConsumedWatts = types.Float64PointerValue(power.PowerControl[0].PowerConsumedWatts)
+	}
+
+	for _, psu := range power.PowerSupplies {
+		state.PowerSupplies = append(state.PowerSupplies, models.PowerSupplySensor{
+			Name:               types.StringValue(psu.Name),
+			Model:              types.StringValue(psu.Model),
+			SerialNumber:       types.StringValue(psu.SerialNumber),
+			PowerCapacityWatts: types.Float64PointerValue(psu.PowerCapacityWatts),
+			InputPowerWatts:    types.Float64PointerValue(psu.PowerInputWatts),
+			OutputPowerWatts:   types.Float64PointerValue(psu.LastPowerOutputWatts),
+			LineInputVoltage:   types.Float64PointerValue(psu.LineInputVoltage),
+			State:              types.StringValue(psu.Status.State),
+			Health:             types.StringValue(psu.Status.Health),
+		})
+	}
+
+	state.Redundancy = append(state.Redundancy, redundancyToModel(power.Redundancy)...)
+	return nil
+}
+
+// readThermalSubsystemToModel reads fans from ThermalSubsystem resource of chassis and temperatures
+// from Sensors collection of chassis, which reports thresholds of the readings.
+func readThermalSubsystemToModel(api *gofish.APIClient, endpoint string, sensors string, state *models.SensorsDataSourceModel) error {
+	var subsystem struct {
+		Fans          common.Link `json:"Fans"`
+		FanRedundancy []struct {
+			RedundancyType string        `json:"RedundancyType"`
+			Status         redfishStatus `json:"Status"`
+		} `json:"FanRedundancy"`
+	}
+	if _, err := getRedfishResource(api, endpoint, &subsystem); err != nil {
+		return err
+	}
+
+	if len(subsystem.Fans) != 0 {
+		members, err := getCollectionMembers(api, string(subsystem.Fans))
+		if err != nil {
+			return err
+		}
+
+		for _, member := range members {
+			var fan struct {
+				Name         string `json:"Name"`
+				SpeedPercent struct {
+					Reading  *float64 `json:"Reading"`
+					SpeedRPM *float64 `json:"SpeedRPM"`
+				} `json:"SpeedPercent"`
+				Status redfishStatus `json:"Status"`
+			}
+			if _, err = getRedfishResource(api, member, &fan); err != nil {
+				return err
+			}
+
+			reading, units := fan.SpeedPercent.SpeedRPM, SENSOR_READING_UNITS_RPM
+			if reading == nil {
+				reading, units = fan.SpeedPercent.Reading, SENSOR_READING_UNITS_PERCENT
+			}
+
+			state.Fans = append(state.Fans, models.FanSensor{
+				Name:                      types.StringValue(fan.Name),
+				Reading:                   types.Float64PointerValue(reading),
+				ReadingUnits:              types.StringValue(units),
+				LowerThresholdNonCritical: types.Float64Null(),
+				LowerThresholdCritical:    types.Float64Null(),
+				State:                     types.StringValue(fan.Status.State),
+				Health:                    types.StringValue(fan.Status.Health),
+			})
+		}
+	}
+
+	for _, group := range subsystem.FanRedundancy {
+		state.Redundancy = append(state.Redundancy, models.SensorRedundancy{
+			Name:   types.StringValue("FanRedundancy"),
+			Mode:   types.StringValue(group.RedundancyType),
+			State:  types.StringValue(group.Status.State),
+			Health: types.StringValue(group.Status.Health),
+		})
+	}
+
+	if len(sensors) == 0 {
+		return nil
+	}
+
+	members, err := getCollectionMembers(api, sensors)
+	if err != nil {
+		return err
+	}
+
+	for _, member := range members {
+		var sensor sensorSettings
+		if _, err = getRedfishResource(api, member, &sensor); err != nil {
+			return err
+		}
+
+		if sensor.ReadingType != SENSOR_READING_TYPE_TEMPERATURE {
+			continue
+		}
+
+		state.Temperatures = append(state.Temperatures, models.TemperatureSensor{
+			Name:                      types.StringValue(sensor.Name),
+			PhysicalContext:           types.StringValue(sensor.PhysicalContext),
+			ReadingCelsius:            types.Float64PointerValue(sensor.Reading),
+			UpperThresholdNonCritical: types.Float64PointerValue(sensor.Thresholds.UpperCaution.Reading),
+			UpperThresholdCritical:    types.Float64PointerValue(sensor.Thresholds.UpperCritical.Reading),
+			UpperThresholdFatal:       types.Float64PointerValue(sensor.Thresholds.UpperFatal.Reading),
+			State:                     types.StringValue(sensor.Status.State),
+			Health:                    types.StringValue(sensor.Status.Health),
+		})
+	}
+
+	return nil
+}
+
+// readPowerSubsystemToModel reads power supplies from PowerSubsystem resource of chassis and power
+// consumption from EnvironmentMetrics resource of chassis.
+func readPowerSubsystemToModel(api *gofish.APIClient, endpoint string, metrics string, state *models.SensorsDataSourceModel) error {
+	var subsystem struct {
+		PowerSupplies         common.Link `json:"PowerSupplies"`
+		PowerSupplyRedundancy []struct {
+			RedundancyType string        `json:"RedundancyType"`
+			Status         redfishStatus `json:"Status"`
+		} `json:"PowerSupplyRedundancy"`
+	}
+	if _, err := getRedfishResource(api, endpoint, &subsystem); err != nil {
+		return err
+	}
+
+	if len(subsystem.PowerSupplies) != 0 {
+		members, err := getCollectionMembers(api, string(subsystem.PowerSupplies))
+		if err != nil {
+			return err
+		}
+
+		for _, member := range members {
+			var psu struct {
+				Name               string        `json:"Name"`
+				Model              string        `json:"Model"`
+				SerialNumber       string        `json:"SerialNumber"`
+				PowerCapacityWatts *float64      `json:"PowerCapacityWatts"`
+				Metrics            common.Link   `json:"Metrics"`
+				Status             redfishStatus `json:"Status"`
+			}
+			if _, err = getRedfishResource(api, member, &psu); err != nil {
+				return err
+			}
+
+			var psuMetrics struct {
+				InputPowerWatts  sensorExcerpt `json:"InputPowerWatts"`
+				OutputPowerWatts sensorExcerpt `json:"OutputPowerWatts"`
+				InputVoltage     sensorExcerpt `json:"InputVoltage"`
+			}
+			if len(psu.Metrics) != 0 {
+				if _, err = getRedfishResource(api, string(psu.Metrics), &psuMetrics); err != nil {
+					return err
+				}
+			}
+
+			state.PowerSupplies = append(state.PowerSupplies, models.PowerSupplySensor{
+				Name:               types.StringValue(psu.Name),
+				Model:              types.StringValue(psu.Model),
+				SerialNumber:       types.StringValue(psu.SerialNumber),
+				PowerCapacityWatts: types.Float64PointerValue(psu.PowerCapacityWatts),
+				InputPowerWatts:    types.Float64PointerValue(psuMetrics.InputPowerWatts.Reading),
+				OutputPowerWatts:   types.Float64PointerValue(psuMetrics.OutputPowerWatts.Reading),
+				LineInputVoltage:   types.Float64PointerValue(psuMetrics.InputVoltage.Reading),
+				State:              types.StringValue(psu.Status.State),
+				Health:             types.StringValue(psu.Status.Health),
+			})
+		}
+	}
+
+	for _, group := range subsystem.PowerSupplyRedundancy {
+		state.Redundancy = append(state.Redundancy, models.SensorRedundancy{
+			Name:   types.StringValue("PowerSupplyRedundancy"),
+			Mode:   types.StringValue(group.RedundancyType),
+			State:  types.StringValue(group.Status.State),
+			Health: types.StringValue(group.Status.Health),
+		})
+	}
+
+	if len(metrics) != 0 {
+		var environment struct {
+			PowerWatts sensorExcerpt `json:"PowerWatts"`
+		}
+		if _, err := getRedfishResource(api, metrics, &environment); err != nil {
+			return err
+		}
+		state.PowerConsumedWatts = types.Float64PointerValue(environment.PowerWatts.Reading)
+	}
+
+	return nil
+}
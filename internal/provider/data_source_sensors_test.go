@@ -0,0 +1,207 @@
+/*
+Copyright (c) 2024 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSensorsDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSensorsDataSourceConfig(creds),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.irmc-redfish_sensors.sensors", "power_consumed_watts"),
+					resource.TestCheckResourceAttrSet("data.irmc-redfish_sensors.sensors", "temperatures.0.reading_celsius"),
+					resource.TestCheckResourceAttrSet("data.irmc-redfish_sensors.sensors", "power_supplies.0.health"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSensorsDataSourceConfig(testingInfo TestingServerCredentials) string {
+	return fmt.Sprintf(`
+	data "irmc-redfish_sensors" "sensors" {
+		server {
+			username     = "%s"
+			password     = "%s"
+			endpoint     = "https://%s"
+			ssl_insecure = true
+		}
+	}
+	`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+	)
+}
+
+func TestReadSensorsToModel_thermalAndPower(t *testing.T) {
+	forEachMockFlavor(t, func(t *testing.T, m *mockRedfishServer) {
+		api := m.connect()
+
+		var state models.SensorsDataSourceModel
+		if err := readSensorsToModel(context.Background(), api, "/redfish/v1/Chassis/0", &state); err != nil {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+
+		if state.Id.ValueString() != "/redfish/v1/Chassis/0" || state.PowerConsumedWatts.ValueFloat64() != 212 {
+			t.Errorf("Chassis power read incorrectly: %+v", state)
+		}
+
+		if len(state.Temperatures) != 2 {
+			t.Fatalf("Expected 2 temperature sensors, got %d", len(state.Temperatures))
+		}
+
+		ambient := state.Temperatures[0]
+		if ambient.Name.ValueString() != "Ambient" || ambient.PhysicalContext.ValueString() != "Intake" ||
+			ambient.ReadingCelsius.ValueFloat64() != 24 || ambient.UpperThresholdNonCritical.ValueFloat64() != 37 ||
+			ambient.UpperThresholdCritical.ValueFloat64() != 42 || !ambient.UpperThresholdFatal.IsNull() {
+			t.Errorf("Temperature read incorrectly: %+v", ambient)
+		}
+
+		if len(state.Fans) != 1 || state.Fans[0].Reading.ValueFloat64() != 5640 || state.Fans[0].ReadingUnits.ValueString() != "RPM" ||
+			state.Fans[0].LowerThresholdCritical.ValueFloat64() != 600 || !state.Fans[0].LowerThresholdNonCritical.IsNull() {
+			t.Errorf("Fans read incorrectly: %+v", state.Fans)
+		}
+
+		if len(state.PowerSupplies) != 2 {
+			t.Fatalf("Expected 2 power supplies, got %d", len(state.PowerSupplies))
+		}
+
+		psu := state.PowerSupplies[1]
+		if psu.Name.ValueString() != "PSU2" || psu.SerialNumber.ValueString() != "PSU000002" || psu.PowerCapacityWatts.ValueFloat64() != 900 ||
+			psu.InputPowerWatts.ValueFloat64() != 104 || psu.OutputPowerWatts.ValueFloat64() != 96 ||
+			psu.LineInputVoltage.ValueFloat64() != 230 || psu.Health.ValueString() != "OK" {
+			t.Errorf("Power supply read incorrectly: %+v", psu)
+		}
+
+		if len(state.Redundancy) != 2 || state.Redundancy[0].Name.ValueString() != "Fan Redundancy" ||
+			state.Redundancy[1].Name.ValueString() != "PSU Redundancy" || state.Redundancy[1].Mode.ValueString() != "N+m" {
+			t.Errorf("Redundancy read incorrectly: %+v", state.Redundancy)
+		}
+	})
+}
+
+func TestReadSensorsToModel_subsystems(t *testing.T) {
+	forEachMockFlavor(t, func(t *testing.T, m *mockRedfishServer) {
+		m.update("/redfish/v1/Chassis/0", map[string]interface{}{
+			"Thermal":            nil,
+			"Power":              nil,
+			"ThermalSubsystem":   map[string]interface{}{"@odata.id": "/redfish/v1/Chassis/0/ThermalSubsystem"},
+			"PowerSubsystem":     map[string]interface{}{"@odata.id": "/redfish/v1/Chassis/0/PowerSubsystem"},
+			"Sensors":            map[string]interface{}{"@odata.id": "/redfish/v1/Chassis/0/Sensors"},
+			"EnvironmentMetrics": map[string]interface{}{"@odata.id": "/redfish/v1/Chassis/0/EnvironmentMetrics"},
+		})
+		m.set("/redfish/v1/Chassis/0/ThermalSubsystem", map[string]interface{}{
+			"Fans": map[string]interface{}{"@odata.id": "/redfish/v1/Chassis/0/ThermalSubsystem/Fans"},
+		})
+		m.set("/redfish/v1/Chassis/0/ThermalSubsystem/Fans", map[string]interface{}{
+			"Members": []interface{}{map[string]interface{}{"@odata.id": "/redfish/v1/Chassis/0/ThermalSubsystem/Fans/0"}},
+		})
+		m.set("/redfish/v1/Chassis/0/ThermalSubsystem/Fans/0", map[string]interface{}{
+			"Name":         "FAN1 SYS",
+			"SpeedPercent": map[string]interface{}{"Reading": 35, "SpeedRPM": 5640},
+			"Status":       map[string]interface{}{"State": "Enabled", "Health": "OK"},
+		})
+		m.set("/redfish/v1/Chassis/0/Sensors", map[string]interface{}{
+			"Members": []interface{}{
+				map[string]interface{}{"@odata.id": "/redfish/v1/Chassis/0/Sensors/0"},
+				map[string]interface{}{"@odata.id": "/redfish/v1/Chassis/0/Sensors/1"},
+			},
+		})
+		m.set("/redfish/v1/Chassis/0/Sensors/0", map[string]interface{}{
+			"Name":            "Ambient",
+			"ReadingType":     "Temperature",
+			"Reading":         24,
+			"PhysicalContext": "Intake",
+			"Thresholds": map[string]interface{}{
+				"UpperCaution":  map[string]interface{}{"Reading": 37},
+				"UpperCritical": map[string]interface{}{"Reading": 42},
+			},
+			"Status": map[string]interface{}{"State": "Enabled", "Health": "Warning"},
+		})
+		m.set("/redfish/v1/Chassis/0/Sensors/1", map[string]interface{}{
+			"Name":        "PSU1 Input Voltage",
+			"ReadingType": "Voltage",
+			"Reading":     230,
+		})
+		m.set("/redfish/v1/Chassis/0/PowerSubsystem", map[string]interface{}{
+			"PowerSupplies": map[string]interface{}{"@odata.id": "/redfish/v1/Chassis/0/PowerSubsystem/PowerSupplies"},
+			"PowerSupplyRedundancy": []interface{}{
+				map[string]interface{}{"RedundancyType": "Failover", "Status": map[string]interface{}{"State": "Enabled", "Health": "Critical"}},
+			},
+		})
+		m.set("/redfish/v1/Chassis/0/PowerSubsystem/PowerSupplies", map[string]interface{}{
+			"Members": []interface{}{map[string]interface{}{"@odata.id": "/redfish/v1/Chassis/0/PowerSubsystem/PowerSupplies/0"}},
+		})
+		m.set("/redfish/v1/Chassis/0/PowerSubsystem/PowerSupplies/0", map[string]interface{}{
+			"Name":               "PSU1",
+			"SerialNumber":       "PSU000001",
+			"PowerCapacityWatts": 900,
+			"Metrics":            map[string]interface{}{"@odata.id": "/redfish/v1/Chassis/0/PowerSubsystem/PowerSupplies/0/Metrics"},
+			"Status":             map[string]interface{}{"State": "Enabled", "Health": "OK"},
+		})
+		m.set("/redfish/v1/Chassis/0/PowerSubsystem/PowerSupplies/0/Metrics", map[string]interface{}{
+			"InputPowerWatts": map[string]interface{}{"Reading": 110},
+			"InputVoltage":    map[string]interface{}{"Reading": 230},
+		})
+		m.set("/redfish/v1/Chassis/0/EnvironmentMetrics", map[string]interface{}{
+			"PowerWatts": map[string]interface{}{"Reading": 215},
+		})
+
+		api := m.connect()
+
+		var state models.SensorsDataSourceModel
+		if err := readSensorsToModel(context.Background(), api, "/redfish/v1/Chassis/0", &state); err != nil {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+
+		if state.PowerConsumedWatts.ValueFloat64() != 215 {
+			t.Errorf("Unexpected power consumption: %v", state.PowerConsumedWatts)
+		}
+
+		if len(state.Temperatures) != 1 || state.Temperatures[0].ReadingCelsius.ValueFloat64() != 24 ||
+			state.Temperatures[0].UpperThresholdNonCritical.ValueFloat64() != 37 || state.Temperatures[0].UpperThresholdCritical.ValueFloat64() != 42 ||
+			!state.Temperatures[0].UpperThresholdFatal.IsNull() || state.Temperatures[0].Health.ValueString() != "Warning" {
+			t.Errorf("Temperatures read incorrectly: %+v", state.Temperatures)
+		}
+
+		if len(state.Fans) != 1 || state.Fans[0].Reading.ValueFloat64() != 5640 || state.Fans[0].ReadingUnits.ValueString() != "RPM" {
+			t.Errorf("Fans read incorrectly: %+v", state.Fans)
+		}
+
+		if len(state.PowerSupplies) != 1 || state.PowerSupplies[0].InputPowerWatts.ValueFloat64() != 110 ||
+			!state.PowerSupplies[0].OutputPowerWatts.IsNull() || state.PowerSupplies[0].LineInputVoltage.ValueFloat64() != 230 {
+			t.Errorf("Power supplies read incorrectly: %+v", state.PowerSupplies)
+		}
+
+		if len(state.Redundancy) != 1 || state.Redundancy[0].Mode.ValueString() != "Failover" || state.Redundancy[0].Health.ValueString() != "Critical" {
+			t.Errorf("Redundancy read incorrectly: %+v", state.Redundancy)
+		}
+	})
+}
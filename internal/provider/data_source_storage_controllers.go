@@ -0,0 +1,264 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stmcginnis/gofish"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &StorageControllersDataSource{}
+
+func NewStorageControllersDataSource() datasource.DataSource {
+	return &StorageControllersDataSource{}
+}
+
+// StorageControllersDataSource defines the data source implementation.
+type StorageControllersDataSource struct {
+	p *IrmcProvider
+}
+
+func (d *StorageControllersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + storageControllersName
+}
+
+func StorageControllersDataSourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "ID of storage collection of the system.",
+			Description:         "ID of storage collection of the system.",
+		},
+		"controllers": schema.ListNestedAttribute{
+			Computed:            true,
+			MarkdownDescription: "Storage controllers of the system.",
+			Description:         "Storage controllers of the system.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "ID of storage resource of the controller.",
+						Description:         "ID of storage resource of the controller.",
+					},
+					"name": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Name of the storage controller.",
+						Description:         "Name of the storage controller.",
+					},
+					"model": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Model of the storage controller.",
+						Description:         "Model of the storage controller.",
+					},
+					"serial_number": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Serial number of the storage controller, used to identify controller by other storage resources and data sources.",
+						Description:         "Serial number of the storage controller, used to identify controller by other storage resources and data sources.",
+					},
+					"firmware_version": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Firmware version of the storage controller.",
+						Description:         "Firmware version of the storage controller.",
+					},
+					"supported_raid_types": schema.ListAttribute{
+						Computed:            true,
+						MarkdownDescription: "RAID types which can be used for volumes created on the controller.",
+						Description:         "RAID types which can be used for volumes created on the controller.",
+						ElementType:         types.StringType,
+					},
+					"drives_count": schema.Int64Attribute{
+						Computed:            true,
+						MarkdownDescription: "Number of drives attached to the storage controller.",
+						Description:         "Number of drives attached to the storage controller.",
+					},
+					"unused_drives_count": schema.Int64Attribute{
+						Computed:            true,
+						MarkdownDescription: "Number of drives attached to the storage controller which are not member of any volume.",
+						Description:         "Number of drives attached to the storage controller which are not member of any volume.",
+					},
+					"state": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "State of the storage controller.",
+						Description:         "State of the storage controller.",
+					},
+					"health": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Health status of the storage controller.",
+						Description:         "Health status of the storage controller.",
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *StorageControllersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Storage controllers data source",
+		Attributes:          StorageControllersDataSourceSchema(),
+		Blocks:              RedfishServerDatasourceBlockMap(),
+	}
+}
+
+func (d *StorageControllersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	p, ok := req.ProviderData.(*IrmcProvider)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *IrmcProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.p = p
+}
+
+func (d *StorageControllersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "data-source-storage-controllers: read starts")
+
+	var state models.StorageControllersDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, err := ConnectTargetSystem(d.p, &state.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError("service error: ", err.Error())
+		return
+	}
+
+	defer api.Logout()
+
+	vendor, err := GetOemVendor(ctx, api)
+	if err != nil {
+		resp.Diagnostics.AddError("Could not determine OEM vendor of the server", err.Error())
+		return
+	}
+
+	system, err := GetSystemResource(api.Service)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Fetching System Resource", err.Error())
+		return
+	}
+
+	controllers, diags := readStorageControllersToModel(ctx, api.Service, system, vendor)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Id = types.StringValue(system.ODataID + "/Storage")
+	state.Controllers = controllers
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	tflog.Info(ctx, "data-source-storage-controllers: read ends")
+}
+
+// readStorageControllersToModel converts all storage controllers of the system into data source model.
+func readStorageControllersToModel(ctx context.Context, service *gofish.Service, system *redfish.ComputerSystem,
+	vendor *OemVendor) (out []models.StorageController, diags diag.Diagnostics) {
+	storages, err := system.Storage()
+	if err != nil {
+		diags.AddError("Could not obtain storage controllers of the system", err.Error())
+		return
+	}
+
+	slices.SortFunc(storages, func(a *redfish.Storage, b *redfish.Storage) int {
+		return compareODataID(a.ODataID, b.ODataID)
+	})
+
+	out = []models.StorageController{}
+	for _, storage := range storages {
+		if len(storage.StorageControllers) == 0 {
+			continue
+		}
+
+		controller := storage.StorageControllers[0]
+
+		// RAIDCapabilities lists RAID levels accepted by storage volume resource, controllers
+		// which do not report them (e.g. without RAID support) fall back to standard property
+		raid_types := []types.String{}
+		raidc_endpoint := vendor.OemPath(storage.ODataID, STORAGE_RAIDCAPABILITIES_OEM_PATH)
+		capabilities, err := getSystemStorageOemRaidCapabilitiesResource(service, raidc_endpoint)
+		if err == nil {
+			for _, level := range capabilities.RaidLevelCap {
+				raid_types = append(raid_types, types.StringValue(level.RaidType))
+			}
+		} else {
+			tflog.Warn(ctx, "Storage controller capabilities could not be obtained", map[string]interface{}{
+				"storage": storage.ODataID,
+				"error":   err.Error(),
+			})
+
+			for _, raid_type := range controller.SupportedRAIDTypes {
+				raid_types = append(raid_types, types.StringValue(string(raid_type)))
+			}
+		}
+
+		storage_drives, err := storage.Drives()
+		if err != nil {
+			diags.AddError("Could not read drives of storage controller", err.Error())
+			return
+		}
+
+		drives := readDrivesToModel(ctx, storage_drives)
+		unused := 0
+		for _, drive := range drives {
+			if len(drive.Volumes) == 0 {
+				unused++
+			}
+		}
+
+		out = append(out, models.StorageController{
+			Id:                 types.StringValue(storage.ODataID),
+			Name:               types.StringValue(controller.Name),
+			Model:              types.StringValue(controller.Model),
+			SerialNumber:       types.StringValue(controller.SerialNumber),
+			FirmwareVersion:    types.StringValue(controller.FirmwareVersion),
+			SupportedRaidTypes: raid_types,
+			DrivesCount:        types.Int64Value(int64(len(drives))),
+			UnusedDrivesCount:  types.Int64Value(int64(unused)),
+			State:              types.StringValue(string(controller.Status.State)),
+			Health:             types.StringValue(string(controller.Status.Health)),
+		})
+	}
+
+	return out, diags
+}
@@ -0,0 +1,106 @@
+/*
+Copyright (c) 2024 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccStorageControllersDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStorageControllersDataSourceConfig(creds),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.irmc-redfish_storage_controllers.ctrl", "controllers.0.serial_number"),
+					resource.TestCheckResourceAttrSet("data.irmc-redfish_storage_controllers.ctrl", "controllers.0.drives_count"),
+				),
+			},
+		},
+	})
+}
+
+func testAccStorageControllersDataSourceConfig(testingInfo TestingServerCredentials) string {
+	return fmt.Sprintf(`
+	data "irmc-redfish_storage_controllers" "ctrl" {
+		server {
+			username     = "%s"
+			password     = "%s"
+			endpoint     = "https://%s"
+			ssl_insecure = true
+		}
+	}
+	`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+	)
+}
+
+func TestReadStorageControllersToModel(t *testing.T) {
+	forEachMockFlavor(t, func(t *testing.T, m *mockRedfishServer) {
+		api := m.connect()
+		ctx := context.Background()
+
+		system, err := GetSystemResource(api.Service)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+
+		controllers, diags := readStorageControllersToModel(ctx, api.Service, system, m.vendor())
+		if diags.HasError() {
+			t.Fatalf("Unexpected error: %v", diags)
+		}
+
+		if len(controllers) != 1 {
+			t.Fatalf("Expected 1 storage controller, got %d", len(controllers))
+		}
+
+		controller := controllers[0]
+		if controller.Id.ValueString() != "/redfish/v1/Systems/0/Storage/0" || controller.SerialNumber.ValueString() != "SKC4910421" ||
+			controller.Model.ValueString() != "PRAID EP540i" || controller.FirmwareVersion.ValueString() != "5.200.02-3618" ||
+			controller.DrivesCount.ValueInt64() != 3 || controller.UnusedDrivesCount.ValueInt64() != 1 ||
+			controller.Health.ValueString() != "OK" {
+			t.Errorf("Storage controller read incorrectly: %+v", controller)
+		}
+
+		if len(controller.SupportedRaidTypes) != 2 || controller.SupportedRaidTypes[0].ValueString() != "RAID0" ||
+			controller.SupportedRaidTypes[1].ValueString() != "RAID1" {
+			t.Errorf("Unexpected RAID types from RAIDCapabilities: %v", controller.SupportedRaidTypes)
+		}
+
+		// Controller without RAIDCapabilities reports RAID types from standard property
+		m.failNext(http.MethodGet, m.vendor().OemPath("/redfish/v1/Systems/0/Storage/0", STORAGE_RAIDCAPABILITIES_OEM_PATH),
+			mockResponse{Status: http.StatusNotFound})
+
+		controllers, diags = readStorageControllersToModel(ctx, api.Service, system, m.vendor())
+		if diags.HasError() {
+			t.Fatalf("Unexpected error: %v", diags)
+		}
+
+		if len(controllers[0].SupportedRaidTypes) != 4 || controllers[0].SupportedRaidTypes[3].ValueString() != "RAID10" {
+			t.Errorf("Unexpected RAID types from controller: %v", controllers[0].SupportedRaidTypes)
+		}
+	})
+}
@@ -0,0 +1,316 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stmcginnis/gofish"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &StorageVolumesDataSource{}
+
+func NewStorageVolumesDataSource() datasource.DataSource {
+	return &StorageVolumesDataSource{}
+}
+
+// StorageVolumesDataSource defines the data source implementation.
+type StorageVolumesDataSource struct {
+	p *IrmcProvider
+}
+
+func (d *StorageVolumesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + storageVolumesName
+}
+
+func StorageVolumesDataSourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "ID of storage resource or storage collection the volumes were read from.",
+			Description:         "ID of storage resource or storage collection the volumes were read from.",
+		},
+		"storage_controller_serial_number": schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: "Serial number of storage controller. If not set, volumes of all storage controllers are reported.",
+			Description:         "Serial number of storage controller. If not set, volumes of all storage controllers are reported.",
+		},
+		"volumes": schema.ListNestedAttribute{
+			Computed:            true,
+			MarkdownDescription: "Volumes (logical drives) of storage controllers.",
+			Description:         "Volumes (logical drives) of storage controllers.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "ID of the volume. It can be used to import volume as storage volume resource.",
+						Description:         "ID of the volume. It can be used to import volume as storage volume resource.",
+					},
+					"storage_controller_serial_number": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Serial number of storage controller the volume belongs to.",
+						Description:         "Serial number of storage controller the volume belongs to.",
+					},
+					"name": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Name of the volume.",
+						Description:         "Name of the volume.",
+					},
+					"raid_type": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "RAID type of the volume.",
+						Description:         "RAID type of the volume.",
+					},
+					"capacity_bytes": schema.Int64Attribute{
+						Computed:            true,
+						MarkdownDescription: "Capacity of the volume in bytes.",
+						Description:         "Capacity of the volume in bytes.",
+					},
+					"optimum_io_size_bytes": schema.Int64Attribute{
+						Computed:            true,
+						MarkdownDescription: "Optimum IO size (stripe size) of the volume in bytes.",
+						Description:         "Optimum IO size (stripe size) of the volume in bytes.",
+					},
+					"read_mode": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Read mode of the volume.",
+						Description:         "Read mode of the volume.",
+					},
+					"write_mode": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Write mode of the volume.",
+						Description:         "Write mode of the volume.",
+					},
+					"drive_cache_mode": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Drive cache mode of the volume.",
+						Description:         "Drive cache mode of the volume.",
+					},
+					"physical_drives": schema.ListAttribute{
+						Computed:            true,
+						MarkdownDescription: "Slot locations of drives the volume is built on.",
+						Description:         "Slot locations of drives the volume is built on.",
+						ElementType:         types.StringType,
+					},
+					"state": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "State of the volume.",
+						Description:         "State of the volume.",
+					},
+					"health": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "Health status of the volume.",
+						Description:         "Health status of the volume.",
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *StorageVolumesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Storage volumes data source",
+		Attributes:          StorageVolumesDataSourceSchema(),
+		Blocks:              RedfishServerDatasourceBlockMap(),
+	}
+}
+
+func (d *StorageVolumesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	p, ok := req.ProviderData.(*IrmcProvider)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *IrmcProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.p = p
+}
+
+func (d *StorageVolumesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "data-source-storage-volumes: read starts")
+
+	var state models.StorageVolumesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, err := ConnectTargetSystem(d.p, &state.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError("service error: ", err.Error())
+		return
+	}
+
+	defer api.Logout()
+
+	id, volumes, diags := readStorageVolumesToModel(ctx, api.Service, state.StorageControllerSN.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Id = types.StringValue(id)
+	state.Volumes = volumes
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	tflog.Info(ctx, "data-source-storage-volumes: read ends")
+}
+
+// readStorageVolumesToModel reads volumes of storage controller represented by serial or of all
+// storage controllers if serial is empty. Returned ID points to storage resource or storage collection.
+func readStorageVolumesToModel(ctx context.Context, service *gofish.Service, serial string) (
+	id string, out []models.StorageVolume, diags diag.Diagnostics) {
+	system, err := GetSystemResource(service)
+	if err != nil {
+		diags.AddError("Error Fetching System Resource", err.Error())
+		return
+	}
+
+	storages, err := system.Storage()
+	if err != nil {
+		diags.AddError("Could not obtain storage controllers of the system", err.Error())
+		return
+	}
+
+	slices.SortFunc(storages, func(a *redfish.Storage, b *redfish.Storage) int {
+		return compareODataID(a.ODataID, b.ODataID)
+	})
+
+	id = system.ODataID + "/Storage"
+	out = []models.StorageVolume{}
+	controllerFound := false
+	for _, storage := range storages {
+		if len(storage.StorageControllers) == 0 {
+			continue
+		}
+
+		storage_serial := storage.StorageControllers[0].SerialNumber
+		if len(serial) != 0 {
+			if storage_serial != serial {
+				continue
+			}
+			id = storage.ODataID
+		}
+		controllerFound = true
+
+		volume_ids, internal_diags := getVolumesIdsList(service, storage_serial)
+		diags.Append(internal_diags...)
+		if diags.HasError() {
+			return
+		}
+
+		slices.SortFunc(volume_ids, compareODataID)
+		for _, volume_id := range volume_ids {
+			volume, err := redfish.GetVolume(service.GetClient(), volume_id)
+			if err != nil {
+				diags.AddError("Could not obtain volume", err.Error())
+				return
+			}
+
+			item, internal_diags := readStorageVolumeToModel(ctx, volume, storage_serial)
+			diags.Append(internal_diags...)
+			if diags.HasError() {
+				return
+			}
+
+			out = append(out, item)
+		}
+	}
+
+	if len(serial) != 0 && !controllerFound {
+		diags.AddError("Could not obtain storage controller with requested id",
+			"storage controller represented by serial has not been found on list of controllers for the target system")
+	}
+
+	return id, out, diags
+}
+
+// readStorageVolumeToModel converts volume into data source model, reusing conversion
+// of storage volume resource.
+func readStorageVolumeToModel(ctx context.Context, volume *redfish.Volume, storage_serial string) (
+	out models.StorageVolume, diags diag.Diagnostics) {
+	state := models.StorageVolumeResourceModel{
+		ReadMode:  &models.StorageVolumeDynamicParam{},
+		WriteMode: &models.StorageVolumeDynamicParam{},
+	}
+
+	diags = readStorageVolumeToState(volume, storage_serial, &state)
+	if diags.HasError() {
+		return
+	}
+
+	drives, err := volume.Drives()
+	if err != nil {
+		diags.AddError("Could not obtain drives of volume", err.Error())
+		return
+	}
+
+	slices.SortFunc(drives, func(a *redfish.Drive, b *redfish.Drive) int {
+		return compareODataID(a.ODataID, b.ODataID)
+	})
+
+	physical_drives := []types.String{}
+	for _, drive := range drives {
+		slot, err := getDriveSlot(drive)
+		if err != nil {
+			tflog.Warn(ctx, "Scanning disk location failed", map[string]interface{}{
+				"drive": drive.ODataID,
+			})
+			continue
+		}
+		physical_drives = append(physical_drives, types.StringValue(slot))
+	}
+
+	return models.StorageVolume{
+		Id:                  types.StringValue(volume.ODataID),
+		StorageControllerSN: state.StorageControllerSN,
+		VolumeName:          state.VolumeName,
+		RaidType:            state.RaidType,
+		CapacityBytes:       state.CapacityBytes.Int64Value,
+		OptimumIOSizeBytes:  state.OptimumIOSizeBytes,
+		ReadMode:            state.ReadMode.Actual,
+		WriteMode:           state.WriteMode.Actual,
+		DriveCacheMode:      state.DriveCacheMode,
+		PhysicalDrives:      physical_drives,
+		State:               types.StringValue(string(volume.Status.State)),
+		Health:              types.StringValue(string(volume.Status.Health)),
+	}, diags
+}
@@ -0,0 +1,123 @@
+/*
+Copyright (c) 2024 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccStorageVolumesDataSource_positive(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStorageVolumesDataSourceConfig(creds, os.Getenv("TF_TESTING_STORAGE_SERIAL_NUMBER")),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.irmc-redfish_storage_volumes.vol", "storage_controller_serial_number",
+						os.Getenv("TF_TESTING_STORAGE_SERIAL_NUMBER")),
+					resource.TestCheckResourceAttrSet("data.irmc-redfish_storage_volumes.vol", "volumes.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccStorageVolumesDataSourceConfig(testingInfo TestingServerCredentials, serial string) string {
+	return fmt.Sprintf(`
+	data "irmc-redfish_storage_volumes" "vol" {
+		server {
+			username     = "%s"
+			password     = "%s"
+			endpoint     = "https://%s"
+			ssl_insecure = true
+		}
+
+		storage_controller_serial_number = "%s"
+	}
+	`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		serial,
+	)
+}
+
+func TestReadStorageVolumesToModel(t *testing.T) {
+	forEachMockFlavor(t, func(t *testing.T, m *mockRedfishServer) {
+		// Second controller without any volume
+		storage := m.get("/redfish/v1/Systems/0/Storage/0")
+		storage["Id"] = "1"
+		storage["@odata.id"] = "/redfish/v1/Systems/0/Storage/1"
+		storage["Volumes"] = map[string]interface{}{"@odata.id": "/redfish/v1/Systems/0/Storage/1/Volumes"}
+		controllers, _ := storage["StorageControllers"].([]interface{})
+		controller, _ := controllers[0].(map[string]interface{})
+		controller["SerialNumber"] = "SKC0000002"
+		m.set("/redfish/v1/Systems/0/Storage/1", storage)
+		m.set("/redfish/v1/Systems/0/Storage/1/Volumes", map[string]interface{}{"Members": []interface{}{}})
+		m.set("/redfish/v1/Systems/0/Storage", map[string]interface{}{
+			"Members": []interface{}{
+				map[string]interface{}{"@odata.id": "/redfish/v1/Systems/0/Storage/0"},
+				map[string]interface{}{"@odata.id": "/redfish/v1/Systems/0/Storage/1"},
+			},
+		})
+
+		api := m.connect()
+		ctx := context.Background()
+
+		id, volumes, diags := readStorageVolumesToModel(ctx, api.Service, "")
+		if diags.HasError() {
+			t.Fatalf("Unexpected error: %v", diags)
+		}
+
+		if id != "/redfish/v1/Systems/0/Storage" || len(volumes) != 1 {
+			t.Fatalf("Unexpected volumes of all controllers (%s): %+v", id, volumes)
+		}
+
+		volume := volumes[0]
+		if volume.Id.ValueString() != "/redfish/v1/Systems/0/Storage/0/Volumes/0" || volume.StorageControllerSN.ValueString() != "SKC4910421" ||
+			volume.VolumeName.ValueString() != "LogicalDrive_0" || volume.RaidType.ValueString() != "RAID1" ||
+			volume.CapacityBytes.ValueInt64() != 599550590976 || volume.OptimumIOSizeBytes.ValueInt64() != 65536 ||
+			volume.ReadMode.ValueString() != "ReadAhead" || volume.WriteMode.ValueString() != "WriteBack" ||
+			volume.DriveCacheMode.ValueString() != "Enabled" || volume.Health.ValueString() != "OK" {
+			t.Errorf("Volume read incorrectly: %+v", volume)
+		}
+
+		if len(volume.PhysicalDrives) != 2 || volume.PhysicalDrives[0].ValueString() != "64-0" || volume.PhysicalDrives[1].ValueString() != "64-1" {
+			t.Errorf("Unexpected drives of volume: %v", volume.PhysicalDrives)
+		}
+
+		id, volumes, diags = readStorageVolumesToModel(ctx, api.Service, "SKC0000002")
+		if diags.HasError() {
+			t.Fatalf("Unexpected error: %v", diags)
+		}
+
+		if id != "/redfish/v1/Systems/0/Storage/1" || len(volumes) != 0 {
+			t.Errorf("Unexpected volumes of controller without volumes (%s): %+v", id, volumes)
+		}
+
+		_, _, diags = readStorageVolumesToModel(ctx, api.Service, "qwerty")
+		if !diags.HasError() {
+			t.Errorf("Expected error for unknown storage controller")
+		}
+	})
+}
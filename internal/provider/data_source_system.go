@@ -0,0 +1,267 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &SystemDataSource{}
+
+func NewSystemDataSource() datasource.DataSource {
+	return &SystemDataSource{}
+}
+
+// SystemDataSource defines the data source implementation.
+type SystemDataSource struct {
+	p *IrmcProvider
+}
+
+func (d *SystemDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + systemName
+}
+
+func SystemDataSourceSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "ID of system resource on iRMC.",
+			Description:         "ID of system resource on iRMC.",
+		},
+		"system_id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "ID of the system within Systems collection.",
+			Description:         "ID of the system within Systems collection.",
+		},
+		"name": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Name of the system.",
+			Description:         "Name of the system.",
+		},
+		"manufacturer": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Manufacturer of the system.",
+			Description:         "Manufacturer of the system.",
+		},
+		"model": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Model of the system.",
+			Description:         "Model of the system.",
+		},
+		"sku": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Stock keeping unit (SKU) of the system.",
+			Description:         "Stock keeping unit (SKU) of the system.",
+		},
+		"part_number": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Part number of the system.",
+			Description:         "Part number of the system.",
+		},
+		"serial_number": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Serial number of the system.",
+			Description:         "Serial number of the system.",
+		},
+		"uuid": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "UUID of the system.",
+			Description:         "UUID of the system.",
+		},
+		"asset_tag": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Asset tag of the system.",
+			Description:         "Asset tag of the system.",
+		},
+		"host_name": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Host name of the system reported by iRMC.",
+			Description:         "Host name of the system reported by iRMC.",
+		},
+		"bios_version": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Version of BIOS firmware of the system.",
+			Description:         "Version of BIOS firmware of the system.",
+		},
+		"power_state": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Current power state of the system (e.g. On, Off).",
+			Description:         "Current power state of the system (e.g. On, Off).",
+		},
+		"state": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "State of the system (e.g. Enabled).",
+			Description:         "State of the system (e.g. Enabled).",
+		},
+		"health": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Health status of the system.",
+			Description:         "Health status of the system.",
+		},
+		"health_rollup": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Health status of the system and its dependent resources.",
+			Description:         "Health status of the system and its dependent resources.",
+		},
+		"processor_summary": schema.SingleNestedAttribute{
+			Computed:            true,
+			MarkdownDescription: "Summary of processors installed in the system.",
+			Description:         "Summary of processors installed in the system.",
+			Attributes: map[string]schema.Attribute{
+				"count": schema.Int64Attribute{
+					Computed:            true,
+					MarkdownDescription: "Number of processors installed in the system.",
+					Description:         "Number of processors installed in the system.",
+				},
+				"logical_processor_count": schema.Int64Attribute{
+					Computed:            true,
+					MarkdownDescription: "Number of logical processors (threads) available in the system.",
+					Description:         "Number of logical processors (threads) available in the system.",
+				},
+				"model": schema.StringAttribute{
+					Computed:            true,
+					MarkdownDescription: "Model of processors installed in the system.",
+					Description:         "Model of processors installed in the system.",
+				},
+				"health_rollup": schema.StringAttribute{
+					Computed:            true,
+					MarkdownDescription: "Health status of all processors.",
+					Description:         "Health status of all processors.",
+				},
+			},
+		},
+		"memory_summary": schema.SingleNestedAttribute{
+			Computed:            true,
+			MarkdownDescription: "Summary of memory installed in the system.",
+			Description:         "Summary of memory installed in the system.",
+			Attributes: map[string]schema.Attribute{
+				"total_system_memory_gib": schema.Float64Attribute{
+					Computed:            true,
+					MarkdownDescription: "Total amount of system memory in GiB.",
+					Description:         "Total amount of system memory in GiB.",
+				},
+				"health_rollup": schema.StringAttribute{
+					Computed:            true,
+					MarkdownDescription: "Health status of all memory modules.",
+					Description:         "Health status of all memory modules.",
+				},
+			},
+		},
+	}
+}
+
+func (d *SystemDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "System data source",
+		Attributes:          SystemDataSourceSchema(),
+		Blocks:              RedfishServerDatasourceBlockMap(),
+	}
+}
+
+func (d *SystemDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	p, ok := req.ProviderData.(*IrmcProvider)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *IrmcProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.p = p
+}
+
+func (d *SystemDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "data-source-system: read starts")
+
+	var state models.SystemDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, err := ConnectTargetSystem(d.p, &state.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError("service error: ", err.Error())
+		return
+	}
+
+	defer api.Logout()
+
+	system, err := GetSystemResource(api.Service)
+	if err != nil {
+		resp.Diagnostics.AddError("Error Fetching System Resource", err.Error())
+		return
+	}
+
+	readSystemToModel(system, &state)
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	tflog.Info(ctx, "data-source-system: read ends")
+}
+
+// readSystemToModel copies inventory data of the system into model.
+func readSystemToModel(system *redfish.ComputerSystem, model *models.SystemDataSourceModel) {
+	model.Id = types.StringValue(system.ODataID)
+	model.SystemId = types.StringValue(system.ID)
+	model.Name = types.StringValue(system.Name)
+	model.Manufacturer = types.StringValue(system.Manufacturer)
+	model.Model = types.StringValue(system.Model)
+	model.Sku = types.StringValue(system.SKU)
+	model.PartNumber = types.StringValue(system.PartNumber)
+	model.SerialNumber = types.StringValue(system.SerialNumber)
+	model.Uuid = types.StringValue(system.UUID)
+	model.AssetTag = types.StringValue(system.AssetTag)
+	model.HostName = types.StringValue(system.HostName)
+	model.BiosVersion = types.StringValue(system.BIOSVersion)
+	model.PowerState = types.StringValue(string(system.PowerState))
+	model.State = types.StringValue(string(system.Status.State))
+	model.Health = types.StringValue(string(system.Status.Health))
+	model.HealthRollup = types.StringValue(string(system.Status.HealthRollup))
+
+	model.ProcessorSummary = &models.SystemProcessorSummary{
+		Count:                 types.Int64Value(int64(system.ProcessorSummary.Count)),
+		LogicalProcessorCount: types.Int64Value(int64(system.ProcessorSummary.LogicalProcessorCount)),
+		Model:                 types.StringValue(system.ProcessorSummary.Model),
+		HealthRollup:          types.StringValue(string(system.ProcessorSummary.Status.HealthRollup)),
+	}
+
+	model.MemorySummary = &models.SystemMemorySummary{
+		TotalSystemMemoryGiB: types.Float64Value(float64(system.MemorySummary.TotalSystemMemoryGiB)),
+		HealthRollup:         types.StringValue(string(system.MemorySummary.Status.HealthRollup)),
+	}
+}
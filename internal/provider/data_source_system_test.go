@@ -0,0 +1,89 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSystemDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSystemDataSourceConfig(creds),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.irmc-redfish_system.sys", "serial_number"),
+					resource.TestCheckResourceAttrSet("data.irmc-redfish_system.sys", "processor_summary.count"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSystemDataSourceConfig(testingInfo TestingServerCredentials) string {
+	return fmt.Sprintf(`
+	data "irmc-redfish_system" "sys" {
+		server {
+			username     = "%s"
+			password     = "%s"
+			endpoint     = "https://%s"
+			ssl_insecure = true
+		}
+	}
+	`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+	)
+}
+
+func TestReadSystemToModel(t *testing.T) {
+	forEachMockFlavor(t, func(t *testing.T, m *mockRedfishServer) {
+		api := m.connect()
+
+		system, err := GetSystemResource(api.Service)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+
+		var model models.SystemDataSourceModel
+		readSystemToModel(system, &model)
+
+		if model.Id.ValueString() != "/redfish/v1/Systems/0" || model.SystemId.ValueString() != "0" ||
+			model.SerialNumber.ValueString() != "MOCK000001" || model.Sku.ValueString() != "S26361-K1789-V101" ||
+			model.Uuid.ValueString() != "00000000-0000-0000-0000-000000000002" || model.AssetTag.ValueString() != "MockTag" ||
+			model.PowerState.ValueString() != "Off" || model.HealthRollup.ValueString() != "OK" {
+			t.Errorf("System read incorrectly: %+v", model)
+		}
+
+		if model.ProcessorSummary.Count.ValueInt64() != 2 || model.ProcessorSummary.LogicalProcessorCount.ValueInt64() != 64 ||
+			model.ProcessorSummary.Model.ValueString() != "Intel(R) Xeon(R) Gold 6430" {
+			t.Errorf("Processor summary read incorrectly: %+v", model.ProcessorSummary)
+		}
+
+		if model.MemorySummary.TotalSystemMemoryGiB.ValueFloat64() != 64 || model.MemorySummary.HealthRollup.ValueString() != "OK" {
+			t.Errorf("Memory summary read incorrectly: %+v", model.MemorySummary)
+		}
+	})
+}
@@ -113,13 +113,13 @@ func (d *IrmcVirtualMediaDataSource) Read(ctx context.Context, req datasource.Re
 	}
 
 	// And look for virtual media resources
-	managers, err := api.Service.Managers()
+	manager, err := GetManagerResource(api.Service)
 	if err != nil {
 		resp.Diagnostics.AddError("Could not connect to the service: ", err.Error())
 		return
 	}
 
-	vmedia_collection, err := managers[0].VirtualMedia()
+	vmedia_collection, err := manager.VirtualMedia()
 	if err != nil {
 		resp.Diagnostics.AddError("Virtual media does not exist: ", err.Error())
 		return
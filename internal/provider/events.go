@@ -0,0 +1,265 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stmcginnis/gofish"
+)
+
+const (
+	// EVENT_STREAM_FALLBACK_INTERVAL is time after which state is checked again even if
+	// no related event has been received, so lost events do not block waiting.
+	EVENT_STREAM_FALLBACK_INTERVAL = 30 * time.Second
+	EVENT_STREAM_BUFFER_SIZE       = 64
+	EVENT_STREAM_MAX_EVENT_SIZE    = 1024 * 1024
+	EVENT_STREAM_CONTENT_TYPE      = "text/event-stream"
+
+	TASK_EVENT_PREFIX            = "TaskEvent."
+	TASK_EVENT_PROGRESS_CHANGED  = "TaskProgressChanged"
+	POWER_STATE_EVENT_IDENTIFIER = "PowerState"
+)
+
+// eventRecord is single record of Redfish event received from Server-Sent Events stream.
+type eventRecord struct {
+	EventType         string   `json:"EventType"`
+	MessageId         string   `json:"MessageId"`
+	Message           string   `json:"Message"`
+	MessageArgs       []string `json:"MessageArgs"`
+	OriginOfCondition struct {
+		ODataID string `json:"@odata.id"`
+	} `json:"OriginOfCondition"`
+}
+
+type redfishEvent struct {
+	Events []eventRecord `json:"Events"`
+}
+
+// eventStream receives events sent by the system over Server-Sent Events connection
+// of EventService. Nil stream is valid and behaves as if system would not send any events.
+type eventStream struct {
+	body   io.Closer
+	events chan eventRecord
+	done   chan struct{}
+	once   sync.Once
+}
+
+// openEventStream connects to Server-Sent Events stream of the system if it has been enabled
+// with event_stream setting of the provider. Nil is returned if stream is not enabled or
+// system does not support it, so caller falls back to polling.
+func openEventStream(ctx context.Context, service *gofish.Service) *eventStream {
+	cached := lookupCachedClient(service.GetClient())
+	if cached == nil || !cached.eventStream {
+		return nil
+	}
+
+	stream, err := connectEventStream(service)
+	if err != nil {
+		tflog.Info(ctx, "Event stream is not available, state will be polled", map[string]interface{}{
+			"reason": err.Error(),
+		})
+		return nil
+	}
+
+	return stream
+}
+
+// connectEventStream opens connection to ServerSentEventUri of EventService and starts reading events.
+// Stream is requested with Accept header of event stream, so timeout of single request configured
+// for the provider does not apply to it (see retryTransport).
+func connectEventStream(service *gofish.Service) (*eventStream, error) {
+	eventService, err := service.EventService()
+	if err != nil {
+		return nil, fmt.Errorf("could not read EventService: %w", err)
+	}
+
+	if len(eventService.ServerSentEventURI) == 0 {
+		return nil, fmt.Errorf("EventService does not report ServerSentEventUri")
+	}
+
+	res, err := service.GetClient().GetWithHeaders(eventService.ServerSentEventURI, map[string]string{"Accept": EVENT_STREAM_CONTENT_TYPE})
+	if err != nil {
+		return nil, fmt.Errorf("could not connect to event stream: %w", err)
+	}
+
+	if res.StatusCode != http.StatusOK || !strings.HasPrefix(res.Header.Get("Content-Type"), EVENT_STREAM_CONTENT_TYPE) {
+		CloseResource(res.Body)
+		return nil, fmt.Errorf("event stream responded with status code %d and content type '%s'", res.StatusCode, res.Header.Get("Content-Type"))
+	}
+
+	stream := &eventStream{
+		body:   res.Body,
+		events: make(chan eventRecord, EVENT_STREAM_BUFFER_SIZE),
+		done:   make(chan struct{}),
+	}
+	go stream.read(res.Body)
+
+	return stream, nil
+}
+
+// read parses stream as defined by HTML5 Server-Sent Events specification. Only data fields
+// are used, every dispatched event is expected to contain Redfish Event resource.
+func (s *eventStream) read(body io.Reader) {
+	defer close(s.events)
+
+	scanner := bufio.NewScanner(body)
+	scanner.Buffer(make([]byte, 0, 64*1024), EVENT_STREAM_MAX_EVENT_SIZE)
+
+	var data strings.Builder
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case len(line) == 0:
+			if data.Len() > 0 && !s.dispatch(data.String()) {
+				return
+			}
+			data.Reset()
+		case strings.HasPrefix(line, "data:"):
+			if data.Len() > 0 {
+				data.WriteByte('\n')
+			}
+			data.WriteString(strings.TrimPrefix(strings.TrimPrefix(line, "data:"), " "))
+		}
+	}
+}
+
+// dispatch passes records of event to waiting caller. False is returned if stream has been closed.
+func (s *eventStream) dispatch(data string) bool {
+	var event redfishEvent
+	if err := json.Unmarshal([]byte(data), &event); err != nil {
+		return true
+	}
+
+	for _, record := range event.Events {
+		select {
+		case s.events <- record:
+		case <-s.done:
+			return false
+		}
+	}
+	return true
+}
+
+// Close disconnects from event stream.
+func (s *eventStream) Close() {
+	if s == nil {
+		return
+	}
+
+	s.once.Do(func() {
+		close(s.done)
+		CloseResource(s.body)
+	})
+}
+
+// wait blocks until event accepted by filter is received or ctx is cancelled. If no such event
+// arrives within EVENT_STREAM_FALLBACK_INTERVAL, it returns anyway, so caller checks state again.
+// If stream is not available (or has been closed by the system), it simply waits pollInterval.
+func (s *eventStream) wait(ctx context.Context, pollInterval time.Duration, filter func(eventRecord) bool) error {
+	if s == nil || s.events == nil {
+		return sleepWithContext(ctx, pollInterval)
+	}
+
+	timer := time.NewTimer(EVENT_STREAM_FALLBACK_INTERVAL)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+			return nil
+		case record, ok := <-s.events:
+			if !ok {
+				tflog.Info(ctx, "Event stream has been closed by the system, state will be polled")
+				s.events = nil
+				return sleepWithContext(ctx, pollInterval)
+			}
+
+			if filter(record) {
+				return nil
+			}
+		}
+	}
+}
+
+// taskEventFilter accepts events notifying about change of state of task pointed by location.
+// Progress of the task reported with events is only logged.
+func taskEventFilter(ctx context.Context, location string) func(eventRecord) bool {
+	taskId := path.Base(location)
+	return func(record eventRecord) bool {
+		if !strings.HasPrefix(record.MessageId, TASK_EVENT_PREFIX) {
+			return false
+		}
+
+		related := strings.TrimSuffix(record.OriginOfCondition.ODataID, "/") == strings.TrimSuffix(location, "/")
+		if len(record.MessageArgs) > 0 && record.MessageArgs[0] == taskId {
+			related = true
+		}
+
+		if !related {
+			return false
+		}
+
+		if strings.HasSuffix(record.MessageId, TASK_EVENT_PROGRESS_CHANGED) {
+			progress := map[string]interface{}{"location": location}
+			if len(record.MessageArgs) > 1 {
+				progress["percent"] = record.MessageArgs[1]
+			}
+			tflog.Info(ctx, "Task progress", progress)
+			return false
+		}
+
+		return true
+	}
+}
+
+// powerStateEventFilter accepts events which might notify about change of power state of the system.
+func powerStateEventFilter(system string) func(eventRecord) bool {
+	return func(record eventRecord) bool {
+		if strings.Contains(record.MessageId, POWER_STATE_EVENT_IDENTIFIER) {
+			return true
+		}
+
+		return len(system) > 0 && strings.TrimSuffix(record.OriginOfCondition.ODataID, "/") == system
+	}
+}
+
+// hostStateEventFilter accepts events which might notify about change of power state of the system
+// or about change of its POST phase reported by BIOS resource of the system.
+func hostStateEventFilter(system string) func(eventRecord) bool {
+	powerState := powerStateEventFilter(system)
+	return func(record eventRecord) bool {
+		if powerState(record) {
+			return true
+		}
+
+		return len(system) > 0 && strings.TrimSuffix(record.OriginOfCondition.ODataID, "/") == system+BIOS_ENDPOINT_SUFFIX
+	}
+}
@@ -0,0 +1,252 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stmcginnis/gofish"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+// eventStreamClient returns client connected to the mock with event stream enabled.
+func eventStreamClient(t *testing.T, m *mockRedfishServer) *gofish.APIClient {
+	t.Helper()
+
+	rserver := m.redfishServer()
+	api, err := ConnectTargetSystem(&IrmcProvider{EventStream: true, clients: InitClientCacheInstance()}, &rserver)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+	return api
+}
+
+func TestEventStreamTaskWait(t *testing.T) {
+	m := newMockRedfishServer(t, FSAS)
+	api := eventStreamClient(t, m)
+	location := m.addTask(nil, redfish.RunningTaskState, redfish.CompletedTaskState)
+
+	type result struct {
+		status bool
+		err    error
+	}
+	done := make(chan result, 1)
+	start := time.Now()
+	go func() {
+		status, err := WaitForRedfishTaskEnd(context.Background(), api.Service, location, 60)
+		done <- result{status, err}
+	}()
+
+	// Progress of the task must not cause task to be read again
+	m.sendEvent(map[string]interface{}{
+		"EventType":   "Other",
+		"MessageId":   "TaskEvent.1.0.TaskProgressChanged",
+		"MessageArgs": []string{path.Base(location), "50"},
+	})
+	time.Sleep(200 * time.Millisecond)
+	if count := len(m.requestsTo(http.MethodGet, location)); count != 1 {
+		t.Errorf("Got %d task reads after progress event, expected 1", count)
+	}
+
+	m.sendEvent(map[string]interface{}{
+		"EventType":         "Other",
+		"MessageId":         "TaskEvent.1.0.TaskCompletedOK",
+		"MessageArgs":       []string{path.Base(location)},
+		"OriginOfCondition": map[string]interface{}{"@odata.id": location},
+	})
+
+	select {
+	case res := <-done:
+		if !res.status || res.err != nil {
+			t.Fatalf("Got status %t with error %v, expected successfully finished task", res.status, res.err)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatalf("Waiting for task has not finished after event")
+	}
+
+	if elapsed := time.Since(start); elapsed >= TASK_POLL_INTERVAL {
+		t.Errorf("Task end has been noticed after %s, expected before next poll", elapsed)
+	}
+}
+
+func TestEventStreamHostState(t *testing.T) {
+	m := newMockRedfishServer(t, FSAS)
+	api := eventStreamClient(t, m)
+	m.update(mockSystemEndpoint, map[string]interface{}{"PowerState": "Off"})
+
+	done := make(chan error, 1)
+	start := time.Now()
+	go func() {
+		done <- waitUntilHostStateChanged(context.Background(), api.Service, true, 60)
+	}()
+
+	// Event unrelated to the system must not wake up waiting
+	m.sendEvent(map[string]interface{}{
+		"MessageId":         "Base.1.0.ResourceChanged",
+		"OriginOfCondition": map[string]interface{}{"@odata.id": mockManagerEndpoint},
+	})
+
+	m.update(mockSystemEndpoint, map[string]interface{}{"PowerState": "On"})
+	m.sendEvent(map[string]interface{}{
+		"MessageId":         "ResourceEvent.1.0.ResourceChanged",
+		"OriginOfCondition": map[string]interface{}{"@odata.id": mockSystemEndpoint},
+	})
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatalf("Waiting for host state has not finished after event")
+	}
+
+	if elapsed := time.Since(start); elapsed >= HOST_STATE_POLL_INTERVAL {
+		t.Errorf("Host state change has been noticed after %s, expected before next poll", elapsed)
+	}
+}
+
+func TestEventStreamPowerOn(t *testing.T) {
+	m := newMockRedfishServer(t, FSAS)
+	api := eventStreamClient(t, m)
+	m.update(mockSystemEndpoint, map[string]interface{}{"PowerState": "Off"})
+
+	done := make(chan error, 1)
+	start := time.Now()
+	go func() {
+		done <- changePowerState(context.Background(), api.Service, true, 60)
+	}()
+
+	// BIOS notifies about end of POST phase once it has been read as being in POST twice
+	bios := mockSystemEndpoint + BIOS_ENDPOINT_SUFFIX
+	for len(m.requestsTo(http.MethodGet, bios)) < 2 {
+		if time.Since(start) > 10*time.Second {
+			t.Fatalf("POST phase has not been checked")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	m.sendEvent(map[string]interface{}{
+		"MessageId":         "ResourceEvent.1.0.ResourceChanged",
+		"OriginOfCondition": map[string]interface{}{"@odata.id": bios},
+	})
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatalf("Waiting for power on has not finished after event")
+	}
+
+	if elapsed := time.Since(start); elapsed >= HOST_STATE_POLL_INTERVAL {
+		t.Errorf("End of POST phase has been noticed after %s, expected before next poll", elapsed)
+	}
+}
+
+func TestEventStreamFallback(t *testing.T) {
+	t.Run("Disabled", func(t *testing.T) {
+		m := newMockRedfishServer(t, FSAS)
+		if stream := openEventStream(context.Background(), m.connect().Service); stream != nil {
+			stream.Close()
+			t.Errorf("Expected no event stream if it's not enabled")
+		}
+	})
+
+	t.Run("NotSupported", func(t *testing.T) {
+		m := newMockRedfishServer(t, FSAS)
+		eventService := m.get("/redfish/v1/EventService")
+		delete(eventService, "ServerSentEventUri")
+		m.set("/redfish/v1/EventService", eventService)
+
+		if stream := openEventStream(context.Background(), eventStreamClient(t, m).Service); stream != nil {
+			stream.Close()
+			t.Errorf("Expected no event stream if system does not support it")
+		}
+	})
+
+	t.Run("ClosedBySystem", func(t *testing.T) {
+		m := newMockRedfishServer(t, FSAS)
+		stream := openEventStream(context.Background(), eventStreamClient(t, m).Service)
+		if stream == nil {
+			t.Fatalf("Expected event stream to be opened")
+		}
+		defer stream.Close()
+
+		m.closeEventStreams()
+
+		// Once stream is closed, every following wait behaves like polling
+		for i := 0; i < 2; i++ {
+			start := time.Now()
+			if err := stream.wait(context.Background(), 100*time.Millisecond, func(eventRecord) bool { return false }); err != nil {
+				t.Fatalf("Unexpected error: %s", err.Error())
+			}
+			if elapsed := time.Since(start); elapsed >= HOST_STATE_POLL_INTERVAL {
+				t.Errorf("Wait %d took %s, expected poll interval", i+1, elapsed)
+			}
+		}
+	})
+
+	t.Run("RequestTimeout", func(t *testing.T) {
+		m := newMockRedfishServer(t, FSAS)
+		rserver := m.redfishServer()
+		p := &IrmcProvider{EventStream: true, Timeout: 1, clients: InitClientCacheInstance()}
+		api, err := ConnectTargetSystem(p, &rserver)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+
+		stream := openEventStream(context.Background(), api.Service)
+		if stream == nil {
+			t.Fatalf("Expected event stream to be opened")
+		}
+		defer stream.Close()
+
+		// Stream must outlive timeout of single request
+		time.Sleep(1500 * time.Millisecond)
+		m.sendEvent(map[string]interface{}{"MessageId": "ResourceEvent.1.0.PowerStateChanged"})
+
+		start := time.Now()
+		if err := stream.wait(context.Background(), time.Minute, powerStateEventFilter(mockSystemEndpoint)); err != nil {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+		if elapsed := time.Since(start); elapsed >= time.Minute/2 {
+			t.Errorf("Event has not been received after request timeout, waiting took %s", elapsed)
+		}
+	})
+
+	t.Run("Cancelled", func(t *testing.T) {
+		m := newMockRedfishServer(t, FSAS)
+		stream := openEventStream(context.Background(), eventStreamClient(t, m).Service)
+		if stream == nil {
+			t.Fatalf("Expected event stream to be opened")
+		}
+		defer stream.Close()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+
+		if err := stream.wait(ctx, time.Minute, func(eventRecord) bool { return true }); err == nil {
+			t.Errorf("Expected error after context cancellation")
+		}
+	})
+}
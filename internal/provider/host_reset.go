@@ -18,6 +18,7 @@ limitations under the License.
 package provider
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -29,7 +30,8 @@ import (
 )
 
 const (
-	BIOS_ENDPOINT = "/redfish/v1/Systems/0/Bios"
+	BIOS_ENDPOINT_SUFFIX     = "/Bios"
+	HOST_STATE_POLL_INTERVAL = 2 * time.Second
 )
 
 // isPoweredOn returns information whether host defined by service is powered on or not.
@@ -47,8 +49,21 @@ func isPoweredOn(service *gofish.Service) (bool, error) {
 }
 
 // waitUntilHostStateChanged waits with timeout until expectedPoweredOn will be reached
-// by target defined as service.
-func waitUntilHostStateChanged(service *gofish.Service, expectedPoweredOn bool, timeout int64) error {
+// by target defined as service. Waiting is interrupted once ctx is cancelled. If event stream
+// is enabled, power state is checked again once the system notifies about its change.
+func waitUntilHostStateChanged(ctx context.Context, service *gofish.Service, expectedPoweredOn bool, timeout int64) error {
+	service, release := withContext(ctx, service)
+	defer release()
+
+	events := openEventStream(ctx, service)
+	defer events.Close()
+
+	var system string
+	if events != nil {
+		// location of the system is memoized for clients which can use event stream
+		system, _ = GetSystemEndpoint(service)
+	}
+
 	startTime := time.Now().Unix()
 	for {
 		poweredOn, err := isPoweredOn(service)
@@ -70,27 +85,34 @@ func waitUntilHostStateChanged(service *gofish.Service, expectedPoweredOn bool,
 			return fmt.Errorf("error. Host state has not been changed within given timeout %d", timeout)
 		}
 
-		time.Sleep(2 * time.Second)
+		if err := events.wait(ctx, HOST_STATE_POLL_INTERVAL, powerStateEventFilter(system)); err != nil {
+			return hostStateWaitCancelledError(err)
+		}
 	}
 }
 
-type tsBiosObject struct {
-	IsBiosInPOST *bool `json:"IsBiosInPostPhase,omitempty"`
+// hostStateWaitCancelledError describes interrupted waiting for host state change.
+func hostStateWaitCancelledError(err error) error {
+	return fmt.Errorf("waiting for host state change has been cancelled, operation might be still in progress on the system: %w", err)
 }
 
-type biosOemObject struct {
-	OemFujitsu tsBiosObject `json:"ts_fujitsu"`
-	OemFsas    tsBiosObject `json:"Fsas"`
+type tsBiosObject struct {
+	IsBiosInPOST *bool `json:"IsBiosInPostPhase,omitempty"`
 }
 
 type biosObject struct {
-	Oem biosOemObject `json:"Oem"`
+	Oem OemObject[tsBiosObject] `json:"Oem"`
 }
 
 // isBiosInPOSTPhase returns information whether host reports
 // being in POST state or not.
 func isBiosInPOSTPhase(service *gofish.Service) (bool, error) {
-	res, err := service.GetClient().Get(BIOS_ENDPOINT)
+	system, err := GetSystemEndpoint(service)
+	if err != nil {
+		return false, err
+	}
+
+	res, err := service.GetClient().Get(system + BIOS_ENDPOINT_SUFFIX)
 	if err != nil {
 		return false, err
 	}
@@ -112,23 +134,32 @@ func isBiosInPOSTPhase(service *gofish.Service) (bool, error) {
 		return false, err
 	}
 
-	if config.Oem.OemFujitsu.IsBiosInPOST != nil {
-		return *config.Oem.OemFujitsu.IsBiosInPOST, nil
-	} else {
-		if config.Oem.OemFsas.IsBiosInPOST != nil {
-			return *config.Oem.OemFsas.IsBiosInPOST, nil
-		} else {
-			return false, fmt.Errorf("could not find IsBiosInPostPhase object")
-		}
+	if oem := config.Oem.Get(); oem != nil && oem.IsBiosInPOST != nil {
+		return *oem.IsBiosInPOST, nil
 	}
+
+	return false, fmt.Errorf("could not find IsBiosInPostPhase object")
 }
 
 // waitUntilHostStateChangedEnhanced waits until host will change its state
 // based on BIOS POST phase (exit of the POST phase together with host powered on state
-// is treated as reached powered on state).
-func waitUntilHostStateChangedEnhanced(service *gofish.Service, expectedPoweredOn bool, timeout int64) error {
+// is treated as reached powered on state). If event stream is enabled, state is checked
+// again once the system notifies about its change.
+func waitUntilHostStateChangedEnhanced(ctx context.Context, service *gofish.Service, expectedPoweredOn bool, timeout int64) error {
 	if !expectedPoweredOn {
-		return waitUntilHostStateChanged(service, expectedPoweredOn, timeout)
+		return waitUntilHostStateChanged(ctx, service, expectedPoweredOn, timeout)
+	}
+
+	service, release := withContext(ctx, service)
+	defer release()
+
+	events := openEventStream(ctx, service)
+	defer events.Close()
+
+	var system string
+	if events != nil {
+		// location of the system is memoized for clients which can use event stream
+		system, _ = GetSystemEndpoint(service)
 	}
 
 	startTime := time.Now().Unix()
@@ -145,8 +176,8 @@ func waitUntilHostStateChangedEnhanced(service *gofish.Service, expectedPoweredO
 
 			if biosDuringPOST {
 				break
-			} else {
-				time.Sleep(time.Second)
+			} else if err := events.wait(ctx, time.Second, hostStateEventFilter(system)); err != nil {
+				return hostStateWaitCancelledError(err)
 			}
 		}
 
@@ -168,42 +199,55 @@ func waitUntilHostStateChangedEnhanced(service *gofish.Service, expectedPoweredO
 
 				if isSystemPoweredOn {
 					return nil
+				}
+
+				// host might be powered off by BIOS for a moment, so it's given some time to power on again
+				const restartWaitSeconds = 20
+				restartWait := min(restartWaitSeconds, timeout-(time.Now().Unix()-startTime))
+				didPowerOnInTime, err := waitUntilPoweredOnAfterPost(ctx, service, events, system, time.Duration(restartWait)*time.Second)
+				if err != nil {
+					return err
+				}
+
+				if didPowerOnInTime {
+					break
 				} else {
-					const restartWaitSeconds = 20
-					restartDeadline := time.Now().Unix() + restartWaitSeconds
-					didPowerOnInTime := false
-
-					for time.Now().Unix() < restartDeadline {
-						if time.Now().Unix()-startTime > timeout {
-							break
-						}
-						powerStateAfterPost, err := isPoweredOn(service)
-						if err != nil {
-							return err
-						}
-						if powerStateAfterPost {
-							didPowerOnInTime = true
-							break
-						}
-						time.Sleep(2 * time.Second)
-					}
-
-					if didPowerOnInTime {
-						break
-					} else {
-						return fmt.Errorf("BIOS exited POST but host powered off")
-					}
+					return fmt.Errorf("BIOS exited POST but host powered off")
 				}
-			} else {
-				time.Sleep(2 * time.Second)
+			} else if err := events.wait(ctx, 2*time.Second, hostStateEventFilter(system)); err != nil {
+				return hostStateWaitCancelledError(err)
+			}
+		}
+	}
+}
+
+// waitUntilPoweredOnAfterPost waits at most restartWait until host powers on again after it has
+// exited POST phase. False is returned if host has not been powered on in time.
+func waitUntilPoweredOnAfterPost(ctx context.Context, service *gofish.Service, events *eventStream, system string, restartWait time.Duration) (bool, error) {
+	restartCtx, cancel := context.WithTimeout(ctx, restartWait)
+	defer cancel()
+
+	for {
+		poweredOn, err := isPoweredOn(service)
+		if err != nil {
+			return false, err
+		}
+		if poweredOn {
+			return true, nil
+		}
+
+		if err := events.wait(restartCtx, 2*time.Second, powerStateEventFilter(system)); err != nil {
+			if ctx.Err() != nil {
+				return false, hostStateWaitCancelledError(ctx.Err())
 			}
+			return false, nil
 		}
 	}
 }
 
 // changePowerState tries to change host state to value defined in powerOn with timeout
 // when requested power state should be reached.
-func changePowerState(service *gofish.Service, powerOn bool, timeout int64) error {
+func changePowerState(ctx context.Context, service *gofish.Service, powerOn bool, timeout int64) error {
 	system, err := GetSystemResource(service)
 	if err != nil {
 		return err
@@ -234,7 +278,7 @@ func changePowerState(service *gofish.Service, powerOn bool, timeout int64) erro
 		return err
 	}
 
-	err = waitUntilHostStateChangedEnhanced(service, expectedTargetState, timeout)
+	err = waitUntilHostStateChangedEnhanced(ctx, service, expectedTargetState, timeout)
 	if err != nil {
 		return err
 	}
@@ -243,7 +287,7 @@ func changePowerState(service *gofish.Service, powerOn bool, timeout int64) erro
 }
 
 // resetHost calls host reset using resetType defined by caller.
-func resetHost(service *gofish.Service, resetType redfish.ResetType, timeout int64) error {
+func resetHost(ctx context.Context, service *gofish.Service, resetType redfish.ResetType, timeout int64) error {
 	system, err := GetSystemResource(service)
 	if err != nil {
 		return err
@@ -256,7 +300,7 @@ func resetHost(service *gofish.Service, resetType redfish.ResetType, timeout int
 
 	expectedTargetState := resetType != redfish.GracefulShutdownResetType && resetType != redfish.PushPowerButtonResetType
 
-	err = waitUntilHostStateChangedEnhanced(service, expectedTargetState, timeout)
+	err = waitUntilHostStateChangedEnhanced(ctx, service, expectedTargetState, timeout)
 	if err != nil {
 		return err
 	}
@@ -266,18 +310,18 @@ func resetHost(service *gofish.Service, resetType redfish.ResetType, timeout int
 
 // resetOrPowerOnHostWithPostCheck powers on host if it's currently powered off
 // or performs requested resetType operation if host is on within given timeout.
-func resetOrPowerOnHostWithPostCheck(service *gofish.Service, resetType redfish.ResetType, timeout int64) error {
+func resetOrPowerOnHostWithPostCheck(ctx context.Context, service *gofish.Service, resetType redfish.ResetType, timeout int64) error {
 	poweredOn, err := isPoweredOn(service)
 	if err != nil {
 		return err
 	}
 
 	if !poweredOn {
-		if err = changePowerState(service, true, timeout); err != nil {
+		if err = changePowerState(ctx, service, true, timeout); err != nil {
 			return err
 		}
 	} else {
-		if err = resetHost(service, resetType, timeout); err != nil {
+		if err = resetHost(ctx, service, resetType, timeout); err != nil {
 			return err
 		}
 	}
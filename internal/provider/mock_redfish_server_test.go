@@ -0,0 +1,1649 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stmcginnis/gofish"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+const (
+	mockUsername        = "admin"
+	mockPassword        = "adminADMIN123"
+	mockSystemEndpoint  = "/redfish/v1/Systems/0"
+	mockManagerEndpoint = "/redfish/v1/Managers/iRMC"
+	mockEventStreamPath = "/redfish/v1/EventService/SSE"
+)
+
+// mockRequest represents single request received by mockRedfishServer.
+type mockRequest struct {
+	Method string
+	Path   string
+	Header http.Header
+	Body   map[string]interface{}
+	Raw    []byte
+}
+
+// mockResponse is returned by registered action handlers of mockRedfishServer.
+type mockResponse struct {
+	Status   int
+	Body     interface{}
+	Location string
+	Headers  map[string]string
+}
+
+// mockDropConnection used as status of injected failure closes connection without response.
+const mockDropConnection = -1
+
+// mockHandler is called with server lock held, so it can freely modify resources.
+type mockHandler func(m *mockRedfishServer, req mockRequest) mockResponse
+
+// mockTask represents Redfish task which goes through states one by one
+// with every GET request on task endpoint.
+type mockTask struct {
+	states []redfish.TaskState
+	index  int
+	logs   []string
+}
+
+// mockRedfishServer emulates subset of iRMC Redfish API used by the provider, so
+// resources can be tested without access to real hardware. Depending on oemKey
+// the server behaves either as Fsas or as ts_fujitsu flavored iRMC.
+type mockRedfishServer struct {
+	*httptest.Server
+
+	t      *testing.T
+	oemKey string
+
+	mu         sync.Mutex
+	resources  map[string]map[string]interface{}
+	versions   map[string]int
+	actions    map[string]mockHandler
+	patchHooks map[string]mockHandler
+	getHooks   map[string]func(m *mockRedfishServer, path string)
+	tasks      map[string]*mockTask
+	sessions   map[string]string
+	failures   map[string][]mockResponse
+	requests   []mockRequest
+	taskSeq    int
+	sessionSeq int
+	postReads  int
+
+	eventStreams []chan []byte
+}
+
+// newMockRedfishServer starts mock iRMC with default resources tree for requested
+// flavor (FSAS or TS_FUJITSU). Server is closed automatically when test finishes.
+func newMockRedfishServer(t *testing.T, oemKey string) *mockRedfishServer {
+	t.Helper()
+
+	m := &mockRedfishServer{
+		t:          t,
+		oemKey:     oemKey,
+		resources:  make(map[string]map[string]interface{}),
+		versions:   make(map[string]int),
+		actions:    make(map[string]mockHandler),
+		patchHooks: make(map[string]mockHandler),
+		getHooks:   make(map[string]func(m *mockRedfishServer, path string)),
+		tasks:      make(map[string]*mockTask),
+		sessions:   make(map[string]string),
+		failures:   make(map[string][]mockResponse),
+	}
+
+	m.seed()
+	m.registerDefaultHandlers()
+
+	m.Server = httptest.NewTLSServer(http.HandlerFunc(m.serveHTTP))
+	t.Cleanup(m.Server.Close)
+	// Open event streams must be finished before server can be closed
+	t.Cleanup(m.closeEventStreams)
+
+	return m
+}
+
+// forEachMockFlavor runs test against mock server of every supported flavor.
+func forEachMockFlavor(t *testing.T, test func(t *testing.T, m *mockRedfishServer)) {
+	for _, oemKey := range []string{FSAS, TS_FUJITSU} {
+		t.Run(oemKey, func(t *testing.T) {
+			test(t, newMockRedfishServer(t, oemKey))
+		})
+	}
+}
+
+// oemActionPrefix returns prefix used by OEM actions for the server flavor.
+func (m *mockRedfishServer) oemActionPrefix() string {
+	return m.vendor().ActionPrefix
+}
+
+// vendor returns dialect of OEM extensions implemented by the server flavor.
+func (m *mockRedfishServer) vendor() *OemVendor {
+	return lookupOemVendor(m.oemKey)
+}
+
+// redfishServer returns server block pointing to the mock.
+func (m *mockRedfishServer) redfishServer() []models.RedfishServer {
+	return []models.RedfishServer{
+		{
+			User:        types.StringValue(mockUsername),
+			Password:    types.StringValue(mockPassword),
+			Endpoint:    types.StringValue(m.URL),
+			SslInsecure: types.BoolValue(true),
+		},
+	}
+}
+
+// connect returns API client connected to the mock.
+func (m *mockRedfishServer) connect() *gofish.APIClient {
+	m.t.Helper()
+
+	rserver := m.redfishServer()
+	api, err := ConnectTargetSystem(&IrmcProvider{}, &rserver)
+	if err != nil {
+		m.t.Fatalf("could not connect to mock server: %s", err.Error())
+	}
+	m.t.Cleanup(api.Logout)
+
+	return api
+}
+
+// get returns deep copy of resource stored under path.
+func (m *mockRedfishServer) get(path string) map[string]interface{} {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return deepCopyJSON(m.resources[normalizeMockPath(path)])
+}
+
+// set stores resource under path, overwriting existing one.
+func (m *mockRedfishServer) set(path string, resource map[string]interface{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.put(path, resource)
+}
+
+// update merges patch into resource stored under path.
+func (m *mockRedfishServer) update(path string, patch map[string]interface{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	path = normalizeMockPath(path)
+	mergeJSON(m.resources[path], patch)
+	m.versions[path]++
+}
+
+// oem returns OEM part of resource stored under path for server flavor.
+func (m *mockRedfishServer) oem(path string) map[string]interface{} {
+	res := m.get(path)
+	if oem, ok := res["Oem"].(map[string]interface{}); ok {
+		if out, ok := oem[m.oemKey].(map[string]interface{}); ok {
+			return out
+		}
+	}
+	return nil
+}
+
+// etag returns current ETag of resource stored under path.
+func (m *mockRedfishServer) etag(path string) string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.etagLocked(normalizeMockPath(path))
+}
+
+// handle registers handler for POST requests on path.
+func (m *mockRedfishServer) handle(path string, handler mockHandler) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.actions[normalizeMockPath(path)] = handler
+}
+
+// handlePatch registers handler replacing default PATCH behavior on path.
+func (m *mockRedfishServer) handlePatch(path string, handler mockHandler) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.patchHooks[normalizeMockPath(path)] = handler
+}
+
+// addTask registers task which will report states one by one on every read.
+// Location of the task is returned.
+func (m *mockRedfishServer) addTask(logs []string, states ...redfish.TaskState) string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.addTaskLocked(logs, states...)
+}
+
+// failNext makes server respond with given responses to next requests with method on path,
+// before the path starts to behave normally again.
+func (m *mockRedfishServer) failNext(method, path string, responses ...mockResponse) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	key := method + " " + normalizeMockPath(path)
+	m.failures[key] = append(m.failures[key], responses...)
+}
+
+// requestsTo returns list of requests with method received on path.
+func (m *mockRedfishServer) requestsTo(method, path string) []mockRequest {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	path = normalizeMockPath(path)
+	var out []mockRequest
+	for _, req := range m.requests {
+		if req.Method == method && req.Path == path {
+			out = append(out, req)
+		}
+	}
+	return out
+}
+
+func (m *mockRedfishServer) put(path string, resource map[string]interface{}) {
+	path = normalizeMockPath(path)
+	if _, ok := resource["@odata.id"]; !ok {
+		resource["@odata.id"] = path
+	}
+	m.resources[path] = resource
+	m.versions[path]++
+}
+
+func (m *mockRedfishServer) etagLocked(path string) string {
+	return fmt.Sprintf("W/\"%d\"", m.versions[path])
+}
+
+func (m *mockRedfishServer) addTaskLocked(logs []string, states ...redfish.TaskState) string {
+	if len(states) == 0 {
+		states = []redfish.TaskState{redfish.CompletedTaskState}
+	}
+
+	m.taskSeq++
+	location := fmt.Sprintf("/redfish/v1/TaskService/Tasks/%d", m.taskSeq)
+	m.tasks[location] = &mockTask{states: states, logs: logs}
+
+	collection := m.resources["/redfish/v1/TaskService/Tasks"]
+	members, _ := collection["Members"].([]interface{})
+	collection["Members"] = append(members, map[string]interface{}{"@odata.id": location})
+	collection["Members@odata.count"] = len(members) + 1
+
+	return location
+}
+
+func (m *mockRedfishServer) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	raw, _ := io.ReadAll(r.Body)
+	req := mockRequest{
+		Method: r.Method,
+		Path:   normalizeMockPath(r.URL.Path),
+		Header: r.Header.Clone(),
+		Raw:    raw,
+	}
+	if len(raw) > 0 && strings.HasPrefix(r.Header.Get("Content-Type"), "application/json") {
+		_ = json.Unmarshal(raw, &req.Body)
+	}
+
+	// Event stream is served without holding the lock for the whole connection
+	if req.Method == http.MethodGet && req.Path == mockEventStreamPath {
+		m.serveEventStream(w, r, req)
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.requests = append(m.requests, req)
+
+	key := req.Method + " " + req.Path
+	if failures := m.failures[key]; len(failures) > 0 {
+		m.failures[key] = failures[1:]
+		if failures[0].Status == mockDropConnection {
+			if conn, _, err := http.NewResponseController(w).Hijack(); err == nil {
+				_ = conn.Close()
+			}
+			return
+		}
+		m.writeMockResponse(w, failures[0])
+		return
+	}
+
+	// Service root and session creation are accessible without authentication as on real iRMC
+	isServiceRoot := req.Path == "/redfish/v1" && req.Method == http.MethodGet
+	isLogin := req.Path == SESSIONS_ENDPOINT && req.Method == http.MethodPost
+	if !isServiceRoot && !isLogin && !m.authorizedLocked(r) {
+		writeMockJSON(w, http.StatusUnauthorized, mockError("Unauthorized"), nil)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		m.serveGet(w, req)
+	case http.MethodPatch:
+		m.servePatch(w, req)
+	case http.MethodPost:
+		m.servePost(w, req)
+	case http.MethodDelete:
+		m.serveDelete(w, req)
+	default:
+		writeMockJSON(w, http.StatusMethodNotAllowed, mockError("Method not allowed"), nil)
+	}
+}
+
+// serveEventStream sends events passed with sendEvent as Server-Sent Events until client disconnects
+// or stream is closed with closeEventStreams.
+func (m *mockRedfishServer) serveEventStream(w http.ResponseWriter, r *http.Request, req mockRequest) {
+	m.mu.Lock()
+	m.requests = append(m.requests, req)
+	authorized := m.authorizedLocked(r)
+	events := make(chan []byte, 16)
+	if authorized {
+		m.eventStreams = append(m.eventStreams, events)
+	}
+	m.mu.Unlock()
+
+	if !authorized {
+		writeMockJSON(w, http.StatusUnauthorized, mockError("Unauthorized"), nil)
+		return
+	}
+
+	defer func() {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+
+		m.eventStreams = slices.DeleteFunc(m.eventStreams, func(stream chan []byte) bool { return stream == events })
+	}()
+
+	controller := http.NewResponseController(w)
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.WriteHeader(http.StatusOK)
+	_ = controller.Flush()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case data, ok := <-events:
+			if !ok {
+				return
+			}
+			fmt.Fprintf(w, "id: %d\ndata: %s\n\n", time.Now().UnixNano(), data)
+			_ = controller.Flush()
+		}
+	}
+}
+
+// sendEvent sends Redfish event containing records to all open event streams. It waits
+// shortly for stream to be opened, so test can send event right after starting operation.
+func (m *mockRedfishServer) sendEvent(records ...map[string]interface{}) {
+	m.t.Helper()
+
+	data, err := json.Marshal(map[string]interface{}{
+		"@odata.type": "#Event.v1_7_0.Event",
+		"Id":          "1",
+		"Name":        "Event",
+		"Events":      records,
+	})
+	if err != nil {
+		m.t.Fatalf("could not marshal event: %s", err.Error())
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		m.mu.Lock()
+		sent := len(m.eventStreams) > 0
+		for _, stream := range m.eventStreams {
+			stream <- data
+		}
+		m.mu.Unlock()
+
+		if sent {
+			return
+		}
+
+		if time.Now().After(deadline) {
+			m.t.Fatalf("no event stream has been opened")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// closeEventStreams finishes all open event streams, as system does e.g. after iRMC reset.
+func (m *mockRedfishServer) closeEventStreams() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, stream := range m.eventStreams {
+		close(stream)
+	}
+	m.eventStreams = nil
+}
+
+// authorizedLocked checks whether request contains valid basic auth credentials or session token.
+func (m *mockRedfishServer) authorizedLocked(r *http.Request) bool {
+	if token := r.Header.Get(HTTP_HEADER_AUTH_TOKEN); token != "" {
+		_, ok := m.sessions[token]
+		return ok
+	}
+
+	user, pass, ok := r.BasicAuth()
+	return ok && user == mockUsername && pass == mockPassword
+}
+
+// expireSessions invalidates all session tokens, as iRMC does after session timeout.
+func (m *mockRedfishServer) expireSessions() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for token, path := range m.sessions {
+		m.remove(path)
+		delete(m.sessions, token)
+	}
+}
+
+// sessionCount returns number of currently active sessions.
+func (m *mockRedfishServer) sessionCount() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return len(m.sessions)
+}
+
+func (m *mockRedfishServer) serveGet(w http.ResponseWriter, req mockRequest) {
+	if task, ok := m.tasks[req.Path]; ok {
+		writeMockJSON(w, http.StatusOK, task.read(req.Path), nil)
+		return
+	}
+
+	logsSuffix := fmt.Sprintf("/Oem/%s/Logs", m.oemKey)
+	if task, ok := m.tasks[strings.TrimSuffix(req.Path, logsSuffix)]; ok && strings.HasSuffix(req.Path, logsSuffix) {
+		messages := []interface{}{}
+		for _, msg := range task.logs {
+			messages = append(messages, map[string]interface{}{"Time": "2025-01-01T00:00:00", "Message": msg})
+		}
+		writeMockJSON(w, http.StatusOK, map[string]interface{}{"Messages": messages}, nil)
+		return
+	}
+
+	if hook, ok := m.getHooks[req.Path]; ok {
+		hook(m, req.Path)
+	}
+
+	res, ok := m.resources[req.Path]
+	if !ok {
+		writeMockJSON(w, http.StatusNotFound, mockError("Resource not found"), nil)
+		return
+	}
+
+	out := deepCopyJSON(res)
+	out["@odata.etag"] = m.etagLocked(req.Path)
+	writeMockJSON(w, http.StatusOK, out, map[string]string{HTTP_HEADER_ETAG: m.etagLocked(req.Path)})
+}
+
+func (m *mockRedfishServer) servePatch(w http.ResponseWriter, req mockRequest) {
+	res, ok := m.resources[req.Path]
+	if !ok {
+		writeMockJSON(w, http.StatusNotFound, mockError("Resource not found"), nil)
+		return
+	}
+
+	if ifMatch := req.Header.Get(HTTP_HEADER_IF_MATCH); ifMatch != "" && ifMatch != "*" && ifMatch != m.etagLocked(req.Path) {
+		writeMockJSON(w, http.StatusPreconditionFailed, mockError("ETag does not match"), nil)
+		return
+	}
+
+	if hook, ok := m.patchHooks[req.Path]; ok {
+		m.writeMockResponse(w, hook(m, req))
+		return
+	}
+
+	patch := deepCopyJSON(req.Body)
+	delete(patch, "@odata.etag")
+	mergeJSON(res, patch)
+	m.versions[req.Path]++
+
+	out := deepCopyJSON(res)
+	out["@odata.etag"] = m.etagLocked(req.Path)
+	writeMockJSON(w, http.StatusOK, out, map[string]string{HTTP_HEADER_ETAG: m.etagLocked(req.Path)})
+}
+
+func (m *mockRedfishServer) servePost(w http.ResponseWriter, req mockRequest) {
+	if handler, ok := m.actions[req.Path]; ok {
+		m.writeMockResponse(w, handler(m, req))
+		return
+	}
+
+	collection, ok := m.resources[req.Path]
+	if !ok {
+		writeMockJSON(w, http.StatusNotFound, mockError("Resource not found"), nil)
+		return
+	}
+
+	members, ok := collection["Members"].([]interface{})
+	if !ok {
+		writeMockJSON(w, http.StatusMethodNotAllowed, mockError("Resource is not a collection"), nil)
+		return
+	}
+
+	id := 1
+	for _, member := range members {
+		memberPath, _ := member.(map[string]interface{})["@odata.id"].(string)
+		if n, err := strconv.Atoi(memberPath[strings.LastIndex(memberPath, "/")+1:]); err == nil && n >= id {
+			id = n + 1
+		}
+	}
+
+	location := fmt.Sprintf("%s/%d", req.Path, id)
+	created := deepCopyJSON(req.Body)
+	if created == nil {
+		created = map[string]interface{}{}
+	}
+	delete(created, "Password")
+	created["Id"] = strconv.Itoa(id)
+	m.put(location, created)
+
+	collection["Members"] = append(members, map[string]interface{}{"@odata.id": location})
+	collection["Members@odata.count"] = len(members) + 1
+
+	writeMockJSON(w, http.StatusCreated, created, map[string]string{HTTP_HEADER_LOCATION: location})
+}
+
+func (m *mockRedfishServer) serveDelete(w http.ResponseWriter, req mockRequest) {
+	if _, ok := m.resources[req.Path]; !ok {
+		writeMockJSON(w, http.StatusNotFound, mockError("Resource not found"), nil)
+		return
+	}
+
+	if hook, ok := m.actions[req.Path]; ok {
+		m.writeMockResponse(w, hook(m, req))
+		return
+	}
+
+	m.remove(req.Path)
+	for token, path := range m.sessions {
+		if path == req.Path {
+			delete(m.sessions, token)
+		}
+	}
+	writeMockJSON(w, http.StatusNoContent, nil, nil)
+}
+
+// remove deletes resource from the tree together with reference inside of parent collection.
+func (m *mockRedfishServer) remove(path string) {
+	delete(m.resources, path)
+
+	parent := path[:strings.LastIndex(path, "/")]
+	if collection, ok := m.resources[parent]; ok {
+		if members, ok := collection["Members"].([]interface{}); ok {
+			out := []interface{}{}
+			for _, member := range members {
+				if member.(map[string]interface{})["@odata.id"] != path {
+					out = append(out, member)
+				}
+			}
+			collection["Members"] = out
+			collection["Members@odata.count"] = len(out)
+		}
+	}
+}
+
+func (m *mockRedfishServer) writeMockResponse(w http.ResponseWriter, resp mockResponse) {
+	headers := map[string]string{}
+	for key, val := range resp.Headers {
+		headers[key] = val
+	}
+	if resp.Location != "" {
+		headers[HTTP_HEADER_LOCATION] = resp.Location
+	}
+	if resp.Status == 0 {
+		resp.Status = http.StatusOK
+	}
+	writeMockJSON(w, resp.Status, resp.Body, headers)
+}
+
+func (task *mockTask) read(location string) map[string]interface{} {
+	state := task.states[task.index]
+	if task.index < len(task.states)-1 {
+		task.index++
+	}
+
+	status := "OK"
+	if state == redfish.ExceptionTaskState || state == redfish.KilledTaskState {
+		status = "Critical"
+	}
+
+	return map[string]interface{}{
+		"@odata.id":       location,
+		"Id":              location[strings.LastIndex(location, "/")+1:],
+		"Name":            "Task",
+		"TaskState":       state,
+		"TaskStatus":      status,
+		"PercentComplete": task.index * 100 / len(task.states),
+	}
+}
+
+// registerDefaultHandlers registers actions behaving like on real iRMC.
+func (m *mockRedfishServer) registerDefaultHandlers() {
+	systemReset := func(m *mockRedfishServer, req mockRequest) mockResponse {
+		resetType, _ := req.Body["ResetType"].(string)
+		if resetType == "" {
+			resetType, _ = req.Body[m.oemActionPrefix()+"ResetType"].(string)
+		}
+		if resetType == "" {
+			return mockResponse{Status: http.StatusBadRequest, Body: mockError("ResetType is missing")}
+		}
+
+		m.resetSystemLocked(redfish.ResetType(resetType))
+		return mockResponse{Status: http.StatusNoContent}
+	}
+
+	m.actions["/redfish/v1/Systems/0/Actions/ComputerSystem.Reset"] = systemReset
+	m.actions[fmt.Sprintf("/redfish/v1/Systems/0/Actions/Oem/%sComputerSystem.Reset", m.oemActionPrefix())] = systemReset
+
+	m.actions[SESSIONS_ENDPOINT] = func(m *mockRedfishServer, req mockRequest) mockResponse {
+		if req.Body["UserName"] != mockUsername || req.Body["Password"] != mockPassword {
+			return mockResponse{Status: http.StatusUnauthorized, Body: mockError("Invalid credentials")}
+		}
+
+		collection := m.resources[SESSIONS_ENDPOINT]
+		members, _ := collection["Members"].([]interface{})
+		m.sessionSeq++
+		id := strconv.Itoa(m.sessionSeq)
+		location := SESSIONS_ENDPOINT + "/" + id
+		token := "token-" + id
+
+		m.put(location, map[string]interface{}{"Id": id, "Name": "User Session", "UserName": mockUsername})
+		collection["Members"] = append(members, map[string]interface{}{"@odata.id": location})
+		collection["Members@odata.count"] = len(members) + 1
+		m.sessions[token] = location
+
+		return mockResponse{
+			Status:   http.StatusCreated,
+			Location: location,
+			Headers:  map[string]string{HTTP_HEADER_AUTH_TOKEN: token},
+		}
+	}
+
+	m.actions["/redfish/v1/Managers/iRMC/Actions/Manager.Reset"] = func(m *mockRedfishServer, req mockRequest) mockResponse {
+		return mockResponse{Status: http.StatusNoContent}
+	}
+
+	// Bios reports POST phase for the number of reads requested by system reset
+	m.getHooks["/redfish/v1/Systems/0/Bios"] = func(m *mockRedfishServer, path string) {
+		inPost := m.postReads > 0
+		if inPost {
+			m.postReads--
+		}
+		mergeJSON(m.resources[path], map[string]interface{}{
+			"Oem": map[string]interface{}{m.oemKey: map[string]interface{}{"IsBiosInPostPhase": inPost}},
+		})
+	}
+
+	// Attributes of iRMC are applied in background by task
+	m.patchHooks[fmt.Sprintf("/redfish/v1/Managers/iRMC/Oem/%s/iRMCConfiguration/Attributes", m.oemKey)] = func(m *mockRedfishServer, req mockRequest) mockResponse {
+		mergeJSON(m.resources[req.Path], req.Body)
+		m.versions[req.Path]++
+		return mockResponse{Status: http.StatusAccepted, Location: m.addTaskLocked([]string{"Attributes applied"})}
+	}
+
+	for _, id := range []string{"0", "1"} {
+		path := "/redfish/v1/Managers/iRMC/VirtualMedia/" + id
+		m.actions[path+"/Actions/VirtualMedia.InsertMedia"] = func(m *mockRedfishServer, req mockRequest) mockResponse {
+			mergeJSON(m.resources[path], map[string]interface{}{
+				"Image":                req.Body["Image"],
+				"TransferProtocolType": req.Body["TransferProtocolType"],
+				"Inserted":             true,
+				"ConnectedVia":         "URI",
+			})
+			m.versions[path]++
+			return mockResponse{Status: http.StatusNoContent}
+		}
+		m.actions[path+"/Actions/VirtualMedia.EjectMedia"] = func(m *mockRedfishServer, req mockRequest) mockResponse {
+			mergeJSON(m.resources[path], map[string]interface{}{
+				"Image":        nil,
+				"Inserted":     false,
+				"ConnectedVia": "NotConnected",
+			})
+			m.versions[path]++
+			return mockResponse{Status: http.StatusNoContent}
+		}
+	}
+
+	// Volumes are created and deleted by the controller in background tasks
+	volumes := "/redfish/v1/Systems/0/Storage/0/Volumes"
+	m.actions[volumes] = func(m *mockRedfishServer, req mockRequest) mockResponse {
+		collection := m.resources[volumes]
+		members, _ := collection["Members"].([]interface{})
+		location := fmt.Sprintf("%s/%d", volumes, len(members))
+		for _, ok := m.resources[location]; ok; _, ok = m.resources[location] {
+			location += "0"
+		}
+
+		oem := map[string]interface{}{}
+		for _, key := range []string{"Name", "InitMode", "ReadMode", "WriteMode", "DriveCacheMode"} {
+			if val, ok := req.Body[key]; ok {
+				oem[key] = val
+			}
+		}
+
+		volume := map[string]interface{}{
+			"Id":                 location[strings.LastIndex(location, "/")+1:],
+			"Name":               req.Body["Name"],
+			"RAIDType":           req.Body["RAIDType"],
+			"CapacityBytes":      req.Body["CapacityBytes"],
+			"OptimumIOSizeBytes": req.Body["OptimumIOSizeBytes"],
+			"Oem":                map[string]interface{}{m.oemKey: oem},
+		}
+		if volume["CapacityBytes"] == nil {
+			volume["CapacityBytes"] = 1073741824
+		}
+		m.put(location, volume)
+		collection["Members"] = append(members, map[string]interface{}{"@odata.id": location})
+		collection["Members@odata.count"] = len(members) + 1
+
+		return mockResponse{Status: http.StatusAccepted, Location: m.addTaskLocked([]string{"Volume created"})}
+	}
+
+	m.actions[fmt.Sprintf("/redfish/v1/Managers/iRMC/Oem/%s/iRMCConfiguration/Certificates/Actions/%sCertificates.VerifySSLCertKeyCompliance", m.oemKey, m.oemActionPrefix())] =
+		func(m *mockRedfishServer, req mockRequest) mockResponse {
+			return mockResponse{Status: http.StatusOK, Body: map[string]interface{}{"Compliant": true}}
+		}
+
+	taskAction := func(m *mockRedfishServer, req mockRequest) mockResponse {
+		return mockResponse{Status: http.StatusAccepted, Location: m.addTaskLocked(nil)}
+	}
+	okAction := func(m *mockRedfishServer, req mockRequest) mockResponse {
+		return mockResponse{Status: http.StatusNoContent}
+	}
+
+	certificates := fmt.Sprintf("/redfish/v1/Managers/iRMC/Oem/%s/iRMCConfiguration/Certificates/Actions/%sCertificates.", m.oemKey, m.oemActionPrefix())
+	m.actions[certificates+"UploadSSLCertOrKey"] = okAction
+	m.actions[certificates+"UploadCACertificate"] = okAction
+	m.actions[fmt.Sprintf("/redfish/v1/Managers/iRMC/Oem/%s/iRMCConfiguration/CertificationAuthority", m.oemKey)] = taskAction
+	m.actions[fmt.Sprintf("/redfish/v1/Managers/iRMC/Oem/%s/iRMCConfiguration/Alerting/Email/Actions/%sEmailAlerting.SendTestMail", m.oemKey, m.oemActionPrefix())] = okAction
+	m.actions["/redfish/v1/UpdateService/Actions/UpdateService.SimpleUpdate"] = taskAction
+	for _, action := range []string{"FWUpdate", "FWTFTPUpdate", "FWMemoryCardUpdate"} {
+		m.actions[fmt.Sprintf("/redfish/v1/Managers/iRMC/Actions/Oem/%sManager.%s", m.oemActionPrefix(), action)] = taskAction
+	}
+}
+
+// resetSystemLocked changes power state of the system according to resetType. Power on
+// operations are followed by BIOS POST phase which lasts for 2 reads of Bios resource.
+// Pending BIOS settings are applied during the POST phase.
+func (m *mockRedfishServer) resetSystemLocked(resetType redfish.ResetType) {
+	system := m.resources["/redfish/v1/Systems/0"]
+
+	switch resetType {
+	case redfish.ForceOffResetType, redfish.GracefulShutdownResetType, redfish.PushPowerButtonResetType:
+		system["PowerState"] = string(redfish.OffPowerState)
+	case redfish.NmiResetType:
+	default:
+		system["PowerState"] = string(redfish.OnPowerState)
+		m.postReads = 2
+		m.applyBiosSettingsLocked()
+	}
+	m.versions["/redfish/v1/Systems/0"]++
+}
+
+// applyBiosSettingsLocked moves pending BIOS settings into current BIOS attributes,
+// afterwards Bios/Settings reports again all attributes as on real iRMC.
+func (m *mockRedfishServer) applyBiosSettingsLocked() {
+	bios := m.resources["/redfish/v1/Systems/0/Bios"]
+	settings := m.resources["/redfish/v1/Systems/0/Bios/Settings"]
+
+	current, _ := bios["Attributes"].(map[string]interface{})
+	pending, _ := settings["Attributes"].(map[string]interface{})
+	for key, val := range pending {
+		current[key] = val
+	}
+
+	settings["Attributes"] = deepCopyJSON(current)
+	m.versions["/redfish/v1/Systems/0/Bios"]++
+	m.versions["/redfish/v1/Systems/0/Bios/Settings"]++
+}
+
+// seed fills the server with default resources tree.
+func (m *mockRedfishServer) seed() {
+	tree := strings.NewReplacer("{{OEM}}", m.oemKey, "{{OEM_ACTION}}", m.oemActionPrefix()).Replace(mockResourcesTree)
+
+	var resources map[string]map[string]interface{}
+	if err := json.Unmarshal([]byte(tree), &resources); err != nil {
+		m.t.Fatalf("mock resources tree could not be unmarshalled: %s", err.Error())
+	}
+
+	paths := make([]string, 0, len(resources))
+	for path := range resources {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	for _, path := range paths {
+		m.put(path, resources[path])
+	}
+}
+
+func normalizeMockPath(path string) string {
+	if len(path) > 1 {
+		return strings.TrimSuffix(path, "/")
+	}
+	return path
+}
+
+func mockError(msg string) map[string]interface{} {
+	return map[string]interface{}{
+		"error": map[string]interface{}{
+			"code":    "Base.1.0.GeneralError",
+			"message": msg,
+		},
+	}
+}
+
+func writeMockJSON(w http.ResponseWriter, status int, body interface{}, headers map[string]string) {
+	for key, val := range headers {
+		w.Header().Set(key, val)
+	}
+
+	if body == nil {
+		w.WriteHeader(status)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// mockFixedSlotLists are lists of strings with fixed number of slots. iRMC replaces only
+// slots present in PATCH request, remaining ones keep their values.
+var mockFixedSlotLists = map[string]bool{
+	"NTPServers":             true,
+	"ServiceAddresses":       true,
+	"BaseDistinguishedNames": true,
+	"StaticNameServers":      true,
+}
+
+// mergeJSON merges patch into dst following JSON merge patch rules used by Redfish PATCH.
+func mergeJSON(dst, patch map[string]interface{}) {
+	for key, val := range patch {
+		if val == nil {
+			delete(dst, key)
+			continue
+		}
+
+		if patchMap, ok := val.(map[string]interface{}); ok {
+			if dstMap, ok := dst[key].(map[string]interface{}); ok {
+				mergeJSON(dstMap, patchMap)
+				continue
+			}
+		}
+
+		// iRMC merges arrays of objects (like StorageControllers) element by element
+		if patchList, ok := val.([]interface{}); ok {
+			if dstList, ok := dst[key].([]interface{}); ok && len(dstList) == len(patchList) && mergeJSONList(dstList, patchList) {
+				continue
+			}
+
+			if dstList, ok := dst[key].([]interface{}); ok && mockFixedSlotLists[key] && len(patchList) <= len(dstList) {
+				copy(dstList, patchList)
+				continue
+			}
+		}
+
+		dst[key] = deepCopyValue(val)
+	}
+}
+
+func mergeJSONList(dst, patch []interface{}) bool {
+	for i := range patch {
+		if _, ok := patch[i].(map[string]interface{}); !ok {
+			return false
+		}
+		if _, ok := dst[i].(map[string]interface{}); !ok {
+			return false
+		}
+	}
+
+	for i := range patch {
+		mergeJSON(dst[i].(map[string]interface{}), patch[i].(map[string]interface{}))
+	}
+	return true
+}
+
+func deepCopyJSON(in map[string]interface{}) map[string]interface{} {
+	if in == nil {
+		return nil
+	}
+	return deepCopyValue(in).(map[string]interface{})
+}
+
+func deepCopyValue(in interface{}) interface{} {
+	bytes, err := json.Marshal(in)
+	if err != nil {
+		return nil
+	}
+
+	var out interface{}
+	if err := json.Unmarshal(bytes, &out); err != nil {
+		return nil
+	}
+	return out
+}
+
+// mockResourcesTree describes default state of the mock. Placeholder {{OEM}} is replaced
+// with OEM key of the flavor and {{OEM_ACTION}} with prefix of OEM actions.
+const mockResourcesTree = `{
+	"/redfish/v1": {
+		"@odata.type": "#ServiceRoot.v1_11_0.ServiceRoot",
+		"Id": "RootService",
+		"Name": "Root Service",
+		"RedfishVersion": "1.15.0",
+		"UUID": "00000000-0000-0000-0000-000000000001",
+		"Systems": {"@odata.id": "/redfish/v1/Systems"},
+		"Managers": {"@odata.id": "/redfish/v1/Managers"},
+		"Chassis": {"@odata.id": "/redfish/v1/Chassis"},
+		"AccountService": {"@odata.id": "/redfish/v1/AccountService"},
+		"SessionService": {"@odata.id": "/redfish/v1/SessionService"},
+		"TaskService": {"@odata.id": "/redfish/v1/TaskService"},
+		"UpdateService": {"@odata.id": "/redfish/v1/UpdateService"},
+		"EventService": {"@odata.id": "/redfish/v1/EventService"},
+		"Links": {"Sessions": {"@odata.id": "/redfish/v1/SessionService/Sessions"}},
+		"Oem": {"{{OEM}}": {"ServerViewRaid": {"Status": "Running"}}}
+	},
+	"/redfish/v1/Systems": {
+		"Name": "Computer System Collection",
+		"Members": [{"@odata.id": "/redfish/v1/Systems/0"}],
+		"Members@odata.count": 1
+	},
+	"/redfish/v1/Systems/0": {
+		"@odata.type": "#ComputerSystem.v1_16_0.ComputerSystem",
+		"Id": "0",
+		"Name": "RX2540M7",
+		"Manufacturer": "Fsas Technologies",
+		"Model": "PRIMERGY RX2540 M7",
+		"SKU": "S26361-K1789-V101",
+		"SerialNumber": "MOCK000001",
+		"UUID": "00000000-0000-0000-0000-000000000002",
+		"AssetTag": "MockTag",
+		"BiosVersion": "V1.0.0.0 R1.10.0 for D3988-A1x",
+		"PowerState": "Off",
+		"Status": {"State": "Enabled", "Health": "OK", "HealthRollup": "OK"},
+		"ProcessorSummary": {"Count": 2, "LogicalProcessorCount": 64, "Model": "Intel(R) Xeon(R) Gold 6430", "Status": {"HealthRollup": "OK"}},
+		"MemorySummary": {"TotalSystemMemoryGiB": 64, "Status": {"HealthRollup": "OK"}},
+		"Boot": {
+			"BootSourceOverrideEnabled": "Disabled",
+			"BootSourceOverrideMode": "UEFI",
+			"BootSourceOverrideTarget": "None"
+		},
+		"Bios": {"@odata.id": "/redfish/v1/Systems/0/Bios"},
+		"Storage": {"@odata.id": "/redfish/v1/Systems/0/Storage"},
+		"EthernetInterfaces": {"@odata.id": "/redfish/v1/Systems/0/EthernetInterfaces"},
+		"Processors": {"@odata.id": "/redfish/v1/Systems/0/Processors"},
+		"Memory": {"@odata.id": "/redfish/v1/Systems/0/Memory"},
+		"Links": {"Chassis": [{"@odata.id": "/redfish/v1/Chassis/0"}]},
+		"Actions": {
+			"#ComputerSystem.Reset": {
+				"target": "/redfish/v1/Systems/0/Actions/ComputerSystem.Reset",
+				"ResetType@Redfish.AllowableValues": ["On", "ForceOn", "ForceOff", "ForceRestart", "GracefulRestart", "GracefulShutdown", "PushPowerButton", "PowerCycle", "Nmi"]
+			},
+			"Oem": {
+				"#{{OEM_ACTION}}ComputerSystem.Reset": {
+					"target": "/redfish/v1/Systems/0/Actions/Oem/{{OEM_ACTION}}ComputerSystem.Reset"
+				}
+			}
+		},
+		"Oem": {"{{OEM}}": {"VirtualMedia": {"@odata.id": "/redfish/v1/Systems/0/Oem/{{OEM}}/VirtualMedia"}}}
+	},
+	"/redfish/v1/Systems/0/Processors": {
+		"Members": [
+			{"@odata.id": "/redfish/v1/Systems/0/Processors/0"},
+			{"@odata.id": "/redfish/v1/Systems/0/Processors/1"}
+		],
+		"Members@odata.count": 2
+	},
+	"/redfish/v1/Systems/0/Processors/0": {
+		"Id": "0",
+		"Socket": "CPU1",
+		"Manufacturer": "Intel",
+		"Model": "Intel(R) Xeon(R) Gold 6430",
+		"ProcessorType": "CPU",
+		"ProcessorArchitecture": "x86",
+		"TotalCores": 16,
+		"TotalThreads": 32,
+		"MaxSpeedMHz": 4000,
+		"OperatingSpeedMHz": 2100,
+		"Status": {"State": "Enabled", "Health": "OK"}
+	},
+	"/redfish/v1/Systems/0/Processors/1": {
+		"Id": "1",
+		"Socket": "CPU2",
+		"Manufacturer": "Intel",
+		"Model": "Intel(R) Xeon(R) Gold 6430",
+		"ProcessorType": "CPU",
+		"ProcessorArchitecture": "x86",
+		"TotalCores": 16,
+		"TotalThreads": 32,
+		"MaxSpeedMHz": 4000,
+		"OperatingSpeedMHz": 2100,
+		"Status": {"State": "Enabled", "Health": "OK"}
+	},
+	"/redfish/v1/Systems/0/Memory": {
+		"Members": [
+			{"@odata.id": "/redfish/v1/Systems/0/Memory/0"},
+			{"@odata.id": "/redfish/v1/Systems/0/Memory/1"},
+			{"@odata.id": "/redfish/v1/Systems/0/Memory/2"}
+		],
+		"Members@odata.count": 3
+	},
+	"/redfish/v1/Systems/0/Memory/0": {
+		"Id": "0",
+		"DeviceLocator": "DIMM-1A",
+		"CapacityMiB": 32768,
+		"OperatingSpeedMhz": 4800,
+		"MemoryDeviceType": "DDR5",
+		"Manufacturer": "Samsung",
+		"PartNumber": "M321R4GA3BB6-CQK",
+		"SerialNumber": "S0000001",
+		"Status": {"State": "Enabled", "Health": "OK"}
+	},
+	"/redfish/v1/Systems/0/Memory/1": {
+		"Id": "1",
+		"DeviceLocator": "DIMM-1B",
+		"Status": {"State": "Absent"}
+	},
+	"/redfish/v1/Systems/0/Memory/2": {
+		"Id": "2",
+		"DeviceLocator": "DIMM-2A",
+		"CapacityMiB": 32768,
+		"OperatingSpeedMhz": 4800,
+		"MemoryDeviceType": "DDR5",
+		"Manufacturer": "Samsung",
+		"PartNumber": "M321R4GA3BB6-CQK",
+		"SerialNumber": "S0000002",
+		"Status": {"State": "Enabled", "Health": "OK"}
+	},
+	"/redfish/v1/Systems/0/EthernetInterfaces": {
+		"Name": "Ethernet Interface Collection",
+		"Members": [
+			{"@odata.id": "/redfish/v1/Systems/0/EthernetInterfaces/0"},
+			{"@odata.id": "/redfish/v1/Systems/0/EthernetInterfaces/1"}
+		],
+		"Members@odata.count": 2
+	},
+	"/redfish/v1/Systems/0/EthernetInterfaces/0": {
+		"@odata.type": "#EthernetInterface.v1_6_0.EthernetInterface",
+		"Id": "0",
+		"Name": "Onboard LAN 1",
+		"MACAddress": "90:1b:0e:00:10:01",
+		"PermanentMACAddress": "90:1b:0e:00:10:01",
+		"LinkStatus": "LinkUp",
+		"SpeedMbps": 1000,
+		"InterfaceEnabled": true,
+		"Status": {"State": "Enabled", "Health": "OK"}
+	},
+	"/redfish/v1/Systems/0/EthernetInterfaces/1": {
+		"@odata.type": "#EthernetInterface.v1_6_0.EthernetInterface",
+		"Id": "1",
+		"Name": "Onboard LAN 2",
+		"MACAddress": "90:1b:0e:00:10:02",
+		"PermanentMACAddress": "90:1b:0e:00:10:02",
+		"LinkStatus": "NoLink",
+		"InterfaceEnabled": true,
+		"Status": {"State": "Enabled", "Health": "OK"}
+	},
+	"/redfish/v1/Systems/0/Bios": {
+		"@odata.type": "#Bios.v1_1_0.Bios",
+		"Id": "Bios",
+		"Name": "BIOS Configuration Current Settings",
+		"AttributeRegistry": "BiosAttributeRegistry",
+		"Attributes": {
+			"AssetTag": "MockTag",
+			"BIOSParameterBackup": "Disabled",
+			"LocalUsbEnabled": "Enabled",
+			"PxeBootOptionRetry": "Disabled",
+			"NumLockState": "On",
+			"PowerOnSource": "BiosControlled",
+			"OnboardVideo": "Enabled",
+			"BootRetryCount": 3,
+			"PersistentBootConfigOrder": [
+				["HD.Emb.0.5", "(UEFI) HDD: Mock Disk"],
+				["NIC.LOM.1.2.IPv4PXE", "(UEFI) PXE IPv4 LOM 1"],
+				["NIC.LOM.2.3.IPv4PXE", "(UEFI) PXE IPv4 LOM 2"]
+			]
+		},
+		"@Redfish.Settings": {
+			"SettingsObject": {"@odata.id": "/redfish/v1/Systems/0/Bios/Settings"}
+		},
+		"Oem": {"{{OEM}}": {"IsBiosInPostPhase": false}}
+	},
+	"/redfish/v1/Systems/0/Bios/Settings": {
+		"Id": "Settings",
+		"Name": "BIOS Configuration Pending Settings",
+		"Attributes": {
+			"AssetTag": "MockTag",
+			"BIOSParameterBackup": "Disabled",
+			"LocalUsbEnabled": "Enabled",
+			"PxeBootOptionRetry": "Disabled",
+			"NumLockState": "On",
+			"PowerOnSource": "BiosControlled",
+			"OnboardVideo": "Enabled",
+			"BootRetryCount": 3
+		}
+	},
+	"/redfish/v1/Systems/0/Oem/{{OEM}}/BootConfig": {
+		"Id": "BootConfig",
+		"BootDevice": "None",
+		"NextBootOnlyEnabled": true
+	},
+	"/redfish/v1/Systems/0/Oem/{{OEM}}/VirtualMedia": {
+		"Id": "VirtualMedia",
+		"RemoteMountEnabled": true,
+		"CDImage": {"MaximumNumberOfDevices": 2, "NumberOfFreeDevices": 2},
+		"HDImage": {"MaximumNumberOfDevices": 2, "NumberOfFreeDevices": 2}
+	},
+	"/redfish/v1/Systems/0/Storage": {
+		"Name": "Storage Collection",
+		"Members": [{"@odata.id": "/redfish/v1/Systems/0/Storage/0"}],
+		"Members@odata.count": 1
+	},
+	"/redfish/v1/Systems/0/Storage/0": {
+		"@odata.type": "#Storage.v1_9_0.Storage",
+		"Id": "0",
+		"Name": "PRAID EP540i",
+		"Status": {"State": "Enabled", "Health": "OK"},
+		"StorageControllers": [{
+			"@odata.id": "/redfish/v1/Systems/0/Storage/0#/StorageControllers/0",
+			"MemberId": "0",
+			"Name": "PRAID EP540i",
+			"Model": "PRAID EP540i",
+			"SerialNumber": "SKC4910421",
+			"FirmwareVersion": "5.200.02-3618",
+			"SupportedRAIDTypes": ["RAID0", "RAID1", "RAID5", "RAID10"],
+			"Status": {"State": "Enabled", "Health": "OK"},
+			"Oem": {"{{OEM}}": {
+				"BIOSContinueOnError": "StopOnErrors",
+				"BIOSStatus": true,
+				"PatrolRead": "Automatic",
+				"PatrolReadRate": 30,
+				"PatrolReadRecoverySupport": false,
+				"BGIRate": 30,
+				"MDCRate": 30,
+				"RebuildRate": 30,
+				"MigrationRate": 30,
+				"SpinupDelaySec": 2,
+				"SpindownDelayMin": 30,
+				"SpindownUnconfiguredDrive": false,
+				"SpindownHotspare": false,
+				"MDCScheduleMode": "Disabled",
+				"MDCAbortOnError": false,
+				"CoercionMode": "None",
+				"AutoRebuildSupport": true
+			}}
+		}],
+		"Drives": [
+			{"@odata.id": "/redfish/v1/Systems/0/Storage/0/Drives/0"},
+			{"@odata.id": "/redfish/v1/Systems/0/Storage/0/Drives/1"},
+			{"@odata.id": "/redfish/v1/Systems/0/Storage/0/Drives/2"}
+		],
+		"Volumes": {"@odata.id": "/redfish/v1/Systems/0/Storage/0/Volumes"},
+		"Oem": {"{{OEM}}": {"RAIDCapabilities": {"@odata.id": "/redfish/v1/Systems/0/Storage/0/Oem/{{OEM}}/RAIDCapabilities"}}}
+	},
+	"/redfish/v1/Systems/0/Storage/0/Oem/{{OEM}}/RAIDCapabilities": {
+		"RAIDLevels": [
+			{"RAIDType": "RAID0", "MinimumDriveCount": 1, "MaximumDriveCount": 32, "MinimumSpanCount": 1, "MaximumSpanCount": 1,
+			 "StripeSizes": [65536, 131072, 262144], "SupportedInitMode": ["No", "Fast"], "SupportedReadMode": ["NoReadAhead", "ReadAhead"],
+			 "SupportedWriteMode": ["WriteThrough", "WriteBack"], "SupportedDriveCacheMode": ["Enabled", "Disabled", "Unchanged"]},
+			{"RAIDType": "RAID1", "MinimumDriveCount": 2, "MaximumDriveCount": 2, "MinimumSpanCount": 1, "MaximumSpanCount": 1,
+			 "StripeSizes": [65536, 131072, 262144], "SupportedInitMode": ["No", "Fast"], "SupportedReadMode": ["NoReadAhead", "ReadAhead"],
+			 "SupportedWriteMode": ["WriteThrough", "WriteBack"], "SupportedDriveCacheMode": ["Enabled", "Disabled", "Unchanged"]}
+		]
+	},
+	"/redfish/v1/Systems/0/Storage/0/Drives/0": {
+		"@odata.type": "#Drive.v1_11_0.Drive",
+		"Id": "0",
+		"Name": "HDD 0",
+		"MediaType": "HDD",
+		"Protocol": "SAS",
+		"CapacityBytes": 600127266816,
+		"SerialNumber": "DRV000000",
+		"Model": "AL15SEB060N",
+		"Location": [{"Info": "[ 0 : 0 : 64 : 0 ]", "InfoFormat": "[ System_Id : Controller_Id : Enclosure_Id : Slot_Id ]"}],
+		"Status": {"State": "Enabled", "Health": "OK"},
+		"Links": {"Volumes": [{"@odata.id": "/redfish/v1/Systems/0/Storage/0/Volumes/0"}]}
+	},
+	"/redfish/v1/Systems/0/Storage/0/Drives/1": {
+		"@odata.type": "#Drive.v1_11_0.Drive",
+		"Id": "1",
+		"Name": "HDD 1",
+		"MediaType": "HDD",
+		"Protocol": "SAS",
+		"CapacityBytes": 600127266816,
+		"SerialNumber": "DRV000001",
+		"Model": "AL15SEB060N",
+		"Location": [{"Info": "[ 0 : 0 : 64 : 1 ]", "InfoFormat": "[ System_Id : Controller_Id : Enclosure_Id : Slot_Id ]"}],
+		"Status": {"State": "Enabled", "Health": "OK"},
+		"Links": {"Volumes": [{"@odata.id": "/redfish/v1/Systems/0/Storage/0/Volumes/0"}]}
+	},
+	"/redfish/v1/Systems/0/Storage/0/Drives/2": {
+		"@odata.type": "#Drive.v1_11_0.Drive",
+		"Id": "2",
+		"Name": "SSD 2",
+		"MediaType": "SSD",
+		"Protocol": "SATA",
+		"CapacityBytes": 480103981056,
+		"SerialNumber": "DRV000002",
+		"Model": "MZ7L3480HCHQ",
+		"Revision": "JXTC404Q",
+		"Location": [{"Info": "[ 0 : 0 : 2 ]", "InfoFormat": "[ System_Id : Controller_Id : Slot_Id ]"}],
+		"Status": {"State": "Enabled", "Health": "OK"},
+		"Links": {"Volumes": []}
+	},
+	"/redfish/v1/Systems/0/Storage/0/Volumes": {
+		"Name": "Volume Collection",
+		"Members": [{"@odata.id": "/redfish/v1/Systems/0/Storage/0/Volumes/0"}],
+		"Members@odata.count": 1
+	},
+	"/redfish/v1/Systems/0/Storage/0/Volumes/0": {
+		"@odata.type": "#Volume.v1_6_0.Volume",
+		"Id": "0",
+		"Name": "LogicalDrive_0",
+		"RAIDType": "RAID1",
+		"CapacityBytes": 599550590976,
+		"OptimumIOSizeBytes": 65536,
+		"Status": {"State": "Enabled", "Health": "OK"},
+		"Oem": {"{{OEM}}": {
+			"Name": "LogicalDrive_0",
+			"InitMode": "Fast",
+			"ReadMode": "ReadAhead",
+			"WriteMode": "WriteBack",
+			"DriveCacheMode": "Enabled"
+		}},
+		"Links": {"Drives": [
+			{"@odata.id": "/redfish/v1/Systems/0/Storage/0/Drives/0"},
+			{"@odata.id": "/redfish/v1/Systems/0/Storage/0/Drives/1"}
+		]}
+	},
+	"/redfish/v1/Chassis": {
+		"Name": "Chassis Collection",
+		"Members": [{"@odata.id": "/redfish/v1/Chassis/0"}],
+		"Members@odata.count": 1
+	},
+	"/redfish/v1/Chassis/0": {
+		"@odata.type": "#Chassis.v1_14_0.Chassis",
+		"Id": "0",
+		"Name": "PRIMERGY RX2540 M7",
+		"ChassisType": "RackMount",
+		"Status": {"State": "Enabled", "Health": "OK"},
+		"NetworkAdapters": {"@odata.id": "/redfish/v1/Chassis/0/NetworkAdapters"},
+		"Thermal": {"@odata.id": "/redfish/v1/Chassis/0/Thermal"},
+		"Power": {"@odata.id": "/redfish/v1/Chassis/0/Power"}
+	},
+	"/redfish/v1/Chassis/0/Thermal": {
+		"Temperatures": [
+			{"MemberId": "0", "Name": "Ambient", "PhysicalContext": "Intake", "ReadingCelsius": 24,
+			 "UpperThresholdNonCritical": 37, "UpperThresholdCritical": 42, "Status": {"State": "Enabled", "Health": "OK"}},
+			{"MemberId": "1", "Name": "CPU1", "PhysicalContext": "CPU", "ReadingCelsius": 51,
+			 "UpperThresholdCritical": 96, "UpperThresholdFatal": 100, "Status": {"State": "Enabled", "Health": "OK"}}
+		],
+		"Fans": [
+			{"MemberId": "0", "Name": "FAN1 SYS", "Reading": 5640, "ReadingUnits": "RPM",
+			 "LowerThresholdCritical": 600, "Status": {"State": "Enabled", "Health": "OK"}}
+		],
+		"Redundancy": [{"MemberId": "0", "Name": "Fan Redundancy", "Mode": "N+m", "Status": {"State": "Enabled", "Health": "OK"}}]
+	},
+	"/redfish/v1/Chassis/0/Power": {
+		"PowerControl": [{"MemberId": "0", "Name": "System Power Control", "PowerConsumedWatts": 212}],
+		"PowerSupplies": [
+			{"MemberId": "0", "Name": "PSU1", "Model": "S26113-E627-V50", "SerialNumber": "PSU000001", "PowerCapacityWatts": 900,
+			 "PowerInputWatts": 110, "LastPowerOutputWatts": 102, "LineInputVoltage": 230, "Status": {"State": "Enabled", "Health": "OK"}},
+			{"MemberId": "1", "Name": "PSU2", "Model": "S26113-E627-V50", "SerialNumber": "PSU000002", "PowerCapacityWatts": 900,
+			 "PowerInputWatts": 104, "LastPowerOutputWatts": 96, "LineInputVoltage": 230, "Status": {"State": "Enabled", "Health": "OK"}}
+		],
+		"Redundancy": [{"MemberId": "0", "Name": "PSU Redundancy", "Mode": "N+m", "Status": {"State": "Enabled", "Health": "OK"}}]
+	},
+	"/redfish/v1/Chassis/0/NetworkAdapters": {
+		"Name": "Network Adapter Collection",
+		"Members": [{"@odata.id": "/redfish/v1/Chassis/0/NetworkAdapters/0"}],
+		"Members@odata.count": 1
+	},
+	"/redfish/v1/Chassis/0/NetworkAdapters/0": {
+		"@odata.type": "#NetworkAdapter.v1_9_0.NetworkAdapter",
+		"Id": "0",
+		"Name": "PCI Slot 2",
+		"Manufacturer": "Intel",
+		"Model": "Intel(R) Ethernet Controller X710 for 10GbE SFP+",
+		"Location": {"PartLocation": {"LocationType": "Slot", "LocationOrdinalValue": 2, "ServiceLabel": "PCI Slot 2"}},
+		"NetworkDeviceFunctions": {"@odata.id": "/redfish/v1/Chassis/0/NetworkAdapters/0/NetworkDeviceFunctions"},
+		"NetworkPorts": {"@odata.id": "/redfish/v1/Chassis/0/NetworkAdapters/0/NetworkPorts"}
+	},
+	"/redfish/v1/Chassis/0/NetworkAdapters/0/NetworkDeviceFunctions": {
+		"Name": "Network Device Function Collection",
+		"Members": [
+			{"@odata.id": "/redfish/v1/Chassis/0/NetworkAdapters/0/NetworkDeviceFunctions/0"},
+			{"@odata.id": "/redfish/v1/Chassis/0/NetworkAdapters/0/NetworkDeviceFunctions/1"}
+		],
+		"Members@odata.count": 2
+	},
+	"/redfish/v1/Chassis/0/NetworkAdapters/0/NetworkDeviceFunctions/0": {
+		"@odata.type": "#NetworkDeviceFunction.v1_3_0.NetworkDeviceFunction",
+		"Id": "0",
+		"NetDevFuncType": "Ethernet",
+		"Ethernet": {"MACAddress": "3c:fd:fe:00:00:01", "PermanentMACAddress": "3c:fd:fe:00:00:01"},
+		"Status": {"State": "Enabled", "Health": "OK"},
+		"AssignablePhysicalPorts": [{"@odata.id": "/redfish/v1/Chassis/0/NetworkAdapters/0/NetworkPorts/0"}],
+		"PhysicalPortAssignment": {"@odata.id": "/redfish/v1/Chassis/0/NetworkAdapters/0/NetworkPorts/0"}
+	},
+	"/redfish/v1/Chassis/0/NetworkAdapters/0/NetworkDeviceFunctions/1": {
+		"@odata.type": "#NetworkDeviceFunction.v1_3_0.NetworkDeviceFunction",
+		"Id": "1",
+		"NetDevFuncType": "Ethernet",
+		"Ethernet": {"MACAddress": "3c:fd:fe:00:00:02", "PermanentMACAddress": "3c:fd:fe:00:00:02"},
+		"Status": {"State": "Enabled", "Health": "OK"},
+		"AssignablePhysicalPorts": [{"@odata.id": "/redfish/v1/Chassis/0/NetworkAdapters/0/NetworkPorts/1"}]
+	},
+	"/redfish/v1/Chassis/0/NetworkAdapters/0/NetworkPorts/0": {
+		"@odata.type": "#NetworkPort.v1_2_0.NetworkPort",
+		"Id": "0",
+		"PhysicalPortNumber": "1",
+		"LinkStatus": "Up",
+		"CurrentLinkSpeedMbps": 10000
+	},
+	"/redfish/v1/Chassis/0/NetworkAdapters/0/NetworkPorts/1": {
+		"@odata.type": "#NetworkPort.v1_2_0.NetworkPort",
+		"Id": "1",
+		"PhysicalPortNumber": "2",
+		"LinkStatus": "Down",
+		"CurrentLinkSpeedMbps": 0
+	},
+	"/redfish/v1/Managers": {
+		"Name": "Manager Collection",
+		"Members": [{"@odata.id": "/redfish/v1/Managers/iRMC"}],
+		"Members@odata.count": 1
+	},
+	"/redfish/v1/Managers/iRMC": {
+		"@odata.type": "#Manager.v1_10_0.Manager",
+		"Id": "iRMC",
+		"Name": "Manager",
+		"ManagerType": "BMC",
+		"FirmwareVersion": "3.10P",
+		"Status": {"State": "Enabled", "Health": "OK"},
+		"VirtualMedia": {"@odata.id": "/redfish/v1/Managers/iRMC/VirtualMedia"},
+		"NetworkProtocol": {"@odata.id": "/redfish/v1/Managers/iRMC/NetworkProtocol"},
+		"EthernetInterfaces": {"@odata.id": "/redfish/v1/Managers/iRMC/EthernetInterfaces"},
+		"Actions": {
+			"#Manager.Reset": {
+				"target": "/redfish/v1/Managers/iRMC/Actions/Manager.Reset",
+				"ResetType@Redfish.AllowableValues": ["ForceRestart", "GracefulRestart"]
+			}
+		}
+	},
+	"/redfish/v1/Managers/iRMC/VirtualMedia": {
+		"Name": "Virtual Media Collection",
+		"Members": [
+			{"@odata.id": "/redfish/v1/Managers/iRMC/VirtualMedia/0"},
+			{"@odata.id": "/redfish/v1/Managers/iRMC/VirtualMedia/1"}
+		],
+		"Members@odata.count": 2
+	},
+	"/redfish/v1/Managers/iRMC/VirtualMedia/0": {
+		"@odata.type": "#VirtualMedia.v1_3_0.VirtualMedia",
+		"Id": "0",
+		"Name": "Virtual CD",
+		"MediaTypes": ["CD", "DVD"],
+		"ConnectedVia": "NotConnected",
+		"Inserted": false,
+		"WriteProtected": true,
+		"Actions": {
+			"#VirtualMedia.InsertMedia": {"target": "/redfish/v1/Managers/iRMC/VirtualMedia/0/Actions/VirtualMedia.InsertMedia"},
+			"#VirtualMedia.EjectMedia": {"target": "/redfish/v1/Managers/iRMC/VirtualMedia/0/Actions/VirtualMedia.EjectMedia"}
+		}
+	},
+	"/redfish/v1/Managers/iRMC/VirtualMedia/1": {
+		"@odata.type": "#VirtualMedia.v1_3_0.VirtualMedia",
+		"Id": "1",
+		"Name": "Virtual HD",
+		"MediaTypes": ["USBStick"],
+		"ConnectedVia": "NotConnected",
+		"Inserted": false,
+		"WriteProtected": true,
+		"Actions": {
+			"#VirtualMedia.InsertMedia": {"target": "/redfish/v1/Managers/iRMC/VirtualMedia/1/Actions/VirtualMedia.InsertMedia"},
+			"#VirtualMedia.EjectMedia": {"target": "/redfish/v1/Managers/iRMC/VirtualMedia/1/Actions/VirtualMedia.EjectMedia"}
+		}
+	},
+	"/redfish/v1/Managers/iRMC/EthernetInterfaces": {
+		"@odata.type": "#EthernetInterfaceCollection.EthernetInterfaceCollection",
+		"Name": "Ethernet Interface Collection",
+		"Members": [{"@odata.id": "/redfish/v1/Managers/iRMC/EthernetInterfaces/0"}],
+		"Members@odata.count": 1
+	},
+	"/redfish/v1/Managers/iRMC/EthernetInterfaces/0": {
+		"@odata.type": "#EthernetInterface.v1_6_0.EthernetInterface",
+		"Id": "0",
+		"Name": "iRMC Ethernet Interface",
+		"HostName": "irmc-mock",
+		"MACAddress": "90:1b:0e:00:00:01",
+		"DHCPv4": {"DHCPEnabled": false},
+		"DHCPv6": {"OperatingMode": "Disabled"},
+		"IPv4Addresses": [{"Address": "192.0.2.100", "SubnetMask": "255.255.255.0", "Gateway": "192.0.2.1", "AddressOrigin": "Static"}],
+		"IPv6StaticAddresses": [{"Address": "2001:db8::100", "PrefixLength": 64}],
+		"IPv6StaticDefaultGateways": [{"Address": "2001:db8::1"}],
+		"VLAN": {"VLANEnable": false, "VLANId": 1},
+		"StaticNameServers": ["192.0.2.53", "", ""]
+	},
+	"/redfish/v1/Managers/iRMC/NetworkProtocol": {
+		"@odata.type": "#ManagerNetworkProtocol.v1_5_0.ManagerNetworkProtocol",
+		"Id": "NetworkProtocol",
+		"Name": "Manager Network Protocol",
+		"HTTP": {"ProtocolEnabled": true, "Port": 80},
+		"HTTPS": {"ProtocolEnabled": true, "Port": 443},
+		"SSH": {"ProtocolEnabled": true, "Port": 22},
+		"Telnet": {"ProtocolEnabled": true, "Port": 23},
+		"IPMI": {"ProtocolEnabled": true, "Port": 623},
+		"SNMP": {
+			"ProtocolEnabled": false,
+			"Port": 161,
+			"EnableSNMPv1": false,
+			"EnableSNMPv2c": true,
+			"EnableSNMPv3": true,
+			"CommunityStrings": [{"Name": "public", "CommunityString": null, "AccessMode": "Limited"}]
+		},
+		"KVMIP": {"ProtocolEnabled": true, "Port": 5900},
+		"NTP": {"ProtocolEnabled": false, "NTPServers": ["", ""]}
+	},
+	"/redfish/v1/Managers/iRMC/Oem/{{OEM}}/iRMCConfiguration/Time": {
+		"Id": "Time",
+		"SyncSource": "RTC",
+		"TimeZoneLocation": "UTC",
+		"RtcMode": "LocalTime"
+	},
+	"/redfish/v1/Managers/iRMC/Oem/{{OEM}}/iRMCConfiguration/Alerting/SNMP": {
+		"Id": "SNMP",
+		"V3Users": [],
+		"TrapDestinations": []
+	},
+	"/redfish/v1/Managers/iRMC/Oem/{{OEM}}/iRMCConfiguration/Alerting/Syslog": {
+		"Id": "Syslog",
+		"Enabled": false,
+		"Servers": []
+	},
+	"/redfish/v1/Managers/iRMC/Oem/{{OEM}}/iRMCConfiguration/Alerting/Email": {
+		"Id": "Email",
+		"Enabled": false,
+		"SmtpServer": {"Address": "", "Port": 25, "ConnectionSecurity": "None", "UserName": "", "Password": null},
+		"Sender": "",
+		"Recipients": []
+	},
+	"/redfish/v1/Managers/iRMC/Oem/{{OEM}}/iRMCConfiguration/Attributes": {
+		"Id": "Attributes",
+		"Attributes": {
+			"BmcNetworkProtocolHttpEnabled": "True",
+			"BmcNetworkProtocolHttpPort": 80,
+			"BmcNetworkProtocolHttpsPort": 443,
+			"BmcTimeZone": "UTC"
+		}
+	},
+	"/redfish/v1/Managers/iRMC/Oem/{{OEM}}/iRMCConfiguration/FWUpdate": {
+		"Id": "FWUpdate",
+		"ServerName": "",
+		"iRMCFileName": "",
+		"iRMCBootSelector": "Auto",
+		"iRMCFlashSelector": "Auto"
+	},
+	"/redfish/v1/Managers/iRMC/Oem/{{OEM}}/iRMCConfiguration/Certificates": {
+		"Id": "Certificates"
+	},
+	"/redfish/v1/Managers/iRMC/Oem/{{OEM}}/iRMCConfiguration/CertificationAuthority": {
+		"Id": "CertificationAuthority"
+	},
+	"/redfish/v1/AccountService": {
+		"@odata.type": "#AccountService.v1_10_0.AccountService",
+		"Id": "AccountService",
+		"Name": "Account Service",
+		"Accounts": {"@odata.id": "/redfish/v1/AccountService/Accounts"},
+		"Roles": {"@odata.id": "/redfish/v1/AccountService/Roles"},
+		"MinPasswordLength": 12,
+		"MaxPasswordLength": 20,
+		"PasswordExpirationDays": null,
+		"AccountLockoutThreshold": 3,
+		"AccountLockoutDuration": 600,
+		"AccountLockoutCounterResetAfter": 300,
+		"Oem": {"{{OEM}}": {"PasswordComplexityEnabled": true}},
+		"LDAP": {
+			"AccountProviderType": "LDAPService",
+			"ServiceEnabled": false,
+			"ServiceAddresses": ["", ""],
+			"Authentication": {"AuthenticationType": "UsernameAndPassword", "Username": "", "Password": null},
+			"LDAPService": {"SearchSettings": {"BaseDistinguishedNames": [""], "UsernameAttribute": "uid", "GroupsAttribute": "memberOf"}},
+			"RemoteRoleMapping": [],
+			"Oem": {"{{OEM}}": {"VerifyCertificate": false}}
+		},
+		"ActiveDirectory": {
+			"AccountProviderType": "ActiveDirectoryService",
+			"ServiceEnabled": false,
+			"ServiceAddresses": ["", ""],
+			"Authentication": {"AuthenticationType": "UsernameAndPassword", "Username": "", "Password": null},
+			"LDAPService": {"SearchSettings": {"BaseDistinguishedNames": [""], "UsernameAttribute": "sAMAccountName", "GroupsAttribute": "memberOf"}},
+			"RemoteRoleMapping": [],
+			"Oem": {"{{OEM}}": {"VerifyCertificate": false}}
+		}
+	},
+	"/redfish/v1/AccountService/Accounts": {
+		"Name": "Accounts Collection",
+		"Members": [{"@odata.id": "/redfish/v1/AccountService/Accounts/2"}],
+		"Members@odata.count": 1
+	},
+	"/redfish/v1/AccountService/Accounts/2": {
+		"@odata.type": "#ManagerAccount.v1_7_0.ManagerAccount",
+		"Id": "2",
+		"Name": "User Account",
+		"UserName": "admin",
+		"RoleId": "Administrator",
+		"Enabled": true,
+		"Oem": {"{{OEM}}": {
+			"BaseValues": {"Enabled": true, "Shell": "RemoteManager"},
+			"Permissions": {
+				"Standard": {"Lan": "Administrator", "Serial": "Administrator"},
+				"Extended": {"ConfigureUsers": true, "ConfigureIrmc": true, "UseVideoRedirection": true, "UseRemoteStorage": true}
+			},
+			"Email": {"AlertChassisEventsUser": false}
+		}}
+	},
+	"/redfish/v1/SessionService": {
+		"Id": "SessionService",
+		"Name": "Session Service",
+		"Sessions": {"@odata.id": "/redfish/v1/SessionService/Sessions"}
+	},
+	"/redfish/v1/SessionService/Sessions": {
+		"Name": "Session Collection",
+		"Members": [],
+		"Members@odata.count": 0
+	},
+	"/redfish/v1/TaskService": {
+		"Id": "TaskService",
+		"Name": "Task Service",
+		"Tasks": {"@odata.id": "/redfish/v1/TaskService/Tasks"}
+	},
+	"/redfish/v1/TaskService/Tasks": {
+		"Name": "Task Collection",
+		"Members": [],
+		"Members@odata.count": 0
+	},
+	"/redfish/v1/EventService": {
+		"Id": "EventService",
+		"Name": "Event Service",
+		"ServiceEnabled": true,
+		"ServerSentEventUri": "/redfish/v1/EventService/SSE",
+		"Subscriptions": {"@odata.id": "/redfish/v1/EventService/Subscriptions"},
+		"Actions": {
+			"#EventService.SubmitTestEvent": {"target": "/redfish/v1/EventService/Actions/EventService.SubmitTestEvent"}
+		}
+	},
+	"/redfish/v1/EventService/Subscriptions": {
+		"Name": "Event Subscriptions Collection",
+		"Members": [],
+		"Members@odata.count": 0
+	},
+	"/redfish/v1/UpdateService": {
+		"Id": "UpdateService",
+		"Name": "Update Service",
+		"FirmwareInventory": {"@odata.id": "/redfish/v1/UpdateService/FirmwareInventory"},
+		"Actions": {
+			"#UpdateService.SimpleUpdate": {"target": "/redfish/v1/UpdateService/Actions/UpdateService.SimpleUpdate"}
+		},
+		"Oem": {"{{OEM}}": {"SimpleUpdateOfflineToolsDirName": "UME"}}
+	},
+	"/redfish/v1/UpdateService/FirmwareInventory": {
+		"Name": "Firmware Inventory Collection",
+		"Members": [
+			{"@odata.id": "/redfish/v1/UpdateService/FirmwareInventory/BMC"},
+			{"@odata.id": "/redfish/v1/UpdateService/FirmwareInventory/BIOS"}
+		],
+		"Members@odata.count": 2
+	},
+	"/redfish/v1/UpdateService/FirmwareInventory/BMC": {
+		"Id": "BMC",
+		"Name": "iRMC firmware",
+		"SoftwareId": "iRMC",
+		"Updateable": true,
+		"Version": "3.10P",
+		"Status": {"State": "Enabled", "Health": "OK"}
+	},
+	"/redfish/v1/UpdateService/FirmwareInventory/BIOS": {
+		"Id": "BIOS",
+		"Name": "BIOS",
+		"SoftwareId": "BIOS",
+		"Updateable": true,
+		"Version": "R1.10.0",
+		"Status": {"State": "Enabled", "Health": "OK"}
+	}
+}`
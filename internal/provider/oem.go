@@ -0,0 +1,148 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/stmcginnis/gofish"
+)
+
+const SERVICE_ROOT_ENDPOINT = "/redfish/v1/"
+
+// OemVendor describes dialect of Redfish OEM extensions implemented by iRMC firmware.
+// Firmware released by Fsas Technologies reports OEM properties under "Fsas" key and
+// uses the same prefix of OEM actions, while older firmware uses "ts_fujitsu" key and "FTS" prefix.
+type OemVendor struct {
+	// Key of OEM object in resources and OEM segment of resource paths.
+	Key string
+	// Prefix of OEM action names and of OEM specific payload fields.
+	ActionPrefix string
+}
+
+var (
+	oemVendorFsas    = &OemVendor{Key: FSAS, ActionPrefix: FSAS}
+	oemVendorFujitsu = &OemVendor{Key: TS_FUJITSU, ActionPrefix: FTS}
+)
+
+// oemVendors lists known dialects in order of detection. If service root does not report
+// any of them, the last one is assumed, since it's used by the oldest supported firmware.
+var oemVendors = []*OemVendor{oemVendorFsas, oemVendorFujitsu}
+
+// lookupOemVendor returns dialect identified by OEM key or nil if the key is not known.
+func lookupOemVendor(key string) *OemVendor {
+	for _, vendor := range oemVendors {
+		if vendor.Key == key {
+			return vendor
+		}
+	}
+	return nil
+}
+
+// OemPath returns path of OEM extension of resource (e.g. <manager>/Oem/Fsas/iRMCConfiguration/Attributes).
+func (v *OemVendor) OemPath(resource string, path string) string {
+	return fmt.Sprintf("%s/Oem/%s/%s", resource, v.Key, path)
+}
+
+// ActionName returns name of OEM action or OEM payload field (e.g. FsasManager.FWUpdate, FsasResetType).
+func (v *OemVendor) ActionName(name string) string {
+	return v.ActionPrefix + name
+}
+
+// ActionPath returns target of OEM action of standard resource (e.g. <manager>/Actions/Oem/FsasManager.FWUpdate).
+func (v *OemVendor) ActionPath(resource string, action string) string {
+	return fmt.Sprintf("%s/Actions/Oem/%s", resource, v.ActionName(action))
+}
+
+// OemResourceActionPath returns target of action of OEM resource
+// (e.g. <certificates>/Actions/FsasCertificates.UploadCACertificate).
+func (v *OemVendor) OemResourceActionPath(resource string, action string) string {
+	return fmt.Sprintf("%s/Actions/%s", resource, v.ActionName(action))
+}
+
+// Properties returns OEM properties of the vendor from decoded Oem object of resource.
+func (v *OemVendor) Properties(oem interface{}) (map[string]interface{}, bool) {
+	if object, ok := oem.(map[string]interface{}); ok {
+		properties, ok := object[v.Key].(map[string]interface{})
+		return properties, ok
+	}
+	return nil, false
+}
+
+// Oem returns Oem object of request payload containing properties under key of the vendor.
+func (v *OemVendor) Oem(properties interface{}) map[string]interface{} {
+	return map[string]interface{}{v.Key: properties}
+}
+
+// OemObject represents Oem object of resource with properties of type T reported under vendor key.
+// It allows to use the same structure for every dialect, both to read and to send OEM properties.
+type OemObject[T any] map[string]*T
+
+// NewOemObject returns Oem object containing properties under key of vendor.
+func NewOemObject[T any](vendor *OemVendor, properties *T) OemObject[T] {
+	return OemObject[T]{vendor.Key: properties}
+}
+
+// Get returns properties reported under key of any known vendor or nil if there are none.
+func (o OemObject[T]) Get() *T {
+	for _, vendor := range oemVendors {
+		if properties, ok := o[vendor.Key]; ok && properties != nil {
+			return properties
+		}
+	}
+	return nil
+}
+
+// GetOemVendor detects dialect of OEM extensions implemented by the system based on OEM keys
+// reported by service root. If client is cached, detection is done only once.
+func GetOemVendor(ctx context.Context, api *gofish.APIClient) (*OemVendor, error) {
+	cached := lookupCachedClient(api)
+	if cached != nil {
+		if vendor := cached.getOemVendor(); vendor != nil {
+			return vendor, nil
+		}
+	}
+
+	res, err := api.Get(SERVICE_ROOT_ENDPOINT)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve %s endpoint: %w", SERVICE_ROOT_ENDPOINT, err)
+	}
+
+	defer CloseResource(res.Body)
+
+	var serviceRoot map[string]interface{}
+	if err := json.NewDecoder(res.Body).Decode(&serviceRoot); err != nil {
+		return nil, fmt.Errorf("failed to parse response from %s: %w", SERVICE_ROOT_ENDPOINT, err)
+	}
+
+	vendor := oemVendors[len(oemVendors)-1]
+	for _, candidate := range oemVendors {
+		if _, ok := candidate.Properties(serviceRoot["Oem"]); ok {
+			vendor = candidate
+			break
+		}
+	}
+
+	if cached != nil {
+		cached.setOemVendor(vendor)
+	}
+
+	return vendor, nil
+}
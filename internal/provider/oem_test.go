@@ -0,0 +1,114 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+)
+
+func TestGetOemVendor(t *testing.T) {
+	forEachMockFlavor(t, func(t *testing.T, m *mockRedfishServer) {
+		vendor, err := GetOemVendor(context.Background(), m.connect())
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+
+		if vendor.Key != m.oemKey {
+			t.Errorf("Got vendor %s for flavor %s", vendor.Key, m.oemKey)
+		}
+	})
+
+	t.Run("NoOemObject", func(t *testing.T) {
+		m := newMockRedfishServer(t, FSAS)
+		root := m.get(SERVICE_ROOT_ENDPOINT)
+		delete(root, "Oem")
+		m.set(SERVICE_ROOT_ENDPOINT, root)
+
+		vendor, err := GetOemVendor(context.Background(), m.connect())
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+
+		if vendor != oemVendorFujitsu {
+			t.Errorf("Got vendor %s, expected %s", vendor.Key, TS_FUJITSU)
+		}
+	})
+}
+
+func TestOemVendorPaths(t *testing.T) {
+	tests := []struct {
+		vendor   *OemVendor
+		got      string
+		expected string
+	}{
+		{oemVendorFsas, oemVendorFsas.OemPath(mockManagerEndpoint, "iRMCConfiguration/Attributes"),
+			"/redfish/v1/Managers/iRMC/Oem/Fsas/iRMCConfiguration/Attributes"},
+		{oemVendorFujitsu, oemVendorFujitsu.OemPath(mockManagerEndpoint, "iRMCConfiguration/Attributes"),
+			"/redfish/v1/Managers/iRMC/Oem/ts_fujitsu/iRMCConfiguration/Attributes"},
+		{oemVendorFsas, oemVendorFsas.ActionPath(mockManagerEndpoint, "Manager.FWUpdate"),
+			"/redfish/v1/Managers/iRMC/Actions/Oem/FsasManager.FWUpdate"},
+		{oemVendorFujitsu, oemVendorFujitsu.ActionPath(mockManagerEndpoint, "Manager.FWUpdate"),
+			"/redfish/v1/Managers/iRMC/Actions/Oem/FTSManager.FWUpdate"},
+		{oemVendorFujitsu, oemVendorFujitsu.OemResourceActionPath("/Certificates", "Certificates.UploadCACertificate"),
+			"/Certificates/Actions/FTSCertificates.UploadCACertificate"},
+		{oemVendorFsas, oemVendorFsas.ActionName("ResetType"), "FsasResetType"},
+	}
+
+	for _, test := range tests {
+		if test.got != test.expected {
+			t.Errorf("Got '%s' for vendor %s, expected '%s'", test.got, test.vendor.Key, test.expected)
+		}
+	}
+}
+
+func TestOemObject(t *testing.T) {
+	type properties struct {
+		Name string `json:"Name,omitempty"`
+	}
+
+	for _, vendor := range oemVendors {
+		payload, err := json.Marshal(NewOemObject(vendor, &properties{Name: "volume"}))
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+
+		if expected := `{"` + vendor.Key + `":{"Name":"volume"}}`; string(payload) != expected {
+			t.Errorf("Got payload %s, expected %s", string(payload), expected)
+		}
+
+		var oem OemObject[properties]
+		if err := json.Unmarshal(payload, &oem); err != nil {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+
+		if read := oem.Get(); read == nil || read.Name != "volume" {
+			t.Errorf("Got properties %v for vendor %s", read, vendor.Key)
+		}
+	}
+
+	var oem OemObject[properties]
+	if err := json.Unmarshal([]byte(`{"Other":{"Name":"volume"}}`), &oem); err != nil {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+
+	if oem.Get() != nil {
+		t.Errorf("Expected no properties for unknown vendor")
+	}
+}
@@ -19,11 +19,19 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -40,16 +48,52 @@ type IrmcProvider struct {
 	// testing.
 	version string
 
-	Username string
-	Password string
+	Username      string
+	Password      string
+	Endpoint      string
+	SslInsecure   bool
+	CaCertificate string
+	Timeout       int64
+	AuthMethod    string
+	Retry         RetryPolicy
+	SystemId      string
+	ManagerId     string
+	EventStream   bool
+
+	// clients keeps connections to managed systems for the lifetime of provider process.
+	clients *ClientCache
 }
 
 // IrmcProviderModel describes the provider data model.
 type IrmcProviderModel struct {
-	Username types.String `tfsdk:"username"`
-	Password types.String `tfsdk:"password"`
+	Username      types.String       `tfsdk:"username"`
+	Password      types.String       `tfsdk:"password"`
+	Endpoint      types.String       `tfsdk:"endpoint"`
+	SslInsecure   types.Bool         `tfsdk:"ssl_insecure"`
+	CaCertificate types.String       `tfsdk:"ca_certificate"`
+	Timeout       types.Int64        `tfsdk:"timeout"`
+	AuthMethod    types.String       `tfsdk:"auth_method"`
+	SystemId      types.String       `tfsdk:"system_id"`
+	ManagerId     types.String       `tfsdk:"manager_id"`
+	EventStream   types.Bool         `tfsdk:"event_stream"`
+	Retry         []RetryPolicyModel `tfsdk:"retry"`
+}
+
+// RetryPolicyModel describes retry block of provider.
+type RetryPolicyModel struct {
+	MaxAttempts   types.Int64   `tfsdk:"max_attempts"`
+	MinBackoff    types.Int64   `tfsdk:"min_backoff"`
+	MaxBackoff    types.Int64   `tfsdk:"max_backoff"`
+	RetryOnStatus []types.Int64 `tfsdk:"retry_on_status"`
 }
 
+const (
+	ENV_IRMC_USERNAME = "IRMC_USERNAME"
+	ENV_IRMC_PASSWORD = "IRMC_PASSWORD"
+	ENV_IRMC_ENDPOINT = "IRMC_ENDPOINT"
+	ENV_IRMC_INSECURE = "IRMC_INSECURE"
+)
+
 func (p *IrmcProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
 	// Here is provider name -------------------
 	resp.TypeName = "irmc-redfish_"
@@ -62,14 +106,117 @@ func (p *IrmcProvider) Schema(ctx context.Context, req provider.SchemaRequest, r
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"username": schema.StringAttribute{
-				MarkdownDescription: "Username accessing Redfish API",
-				Description:         "Username accessing Redfish API",
+				MarkdownDescription: "Username accessing Redfish API. Can be also set with `IRMC_USERNAME` environment variable.",
+				Description:         "Username accessing Redfish API. Can be also set with IRMC_USERNAME environment variable.",
 				Optional:            true,
 			},
 			"password": schema.StringAttribute{
-				MarkdownDescription: "Password related to given user name accessing Redfish API",
-				Description:         "Password related to given user name accessing Redfish API",
+				MarkdownDescription: "Password related to given user name accessing Redfish API. Can be also set with `IRMC_PASSWORD` environment variable.",
+				Description:         "Password related to given user name accessing Redfish API. Can be also set with IRMC_PASSWORD environment variable.",
 				Optional:            true,
+				Sensitive:           true,
+			},
+			"endpoint": schema.StringAttribute{
+				MarkdownDescription: "Default server BMC address (e.g. https://10.172.201.205) used by resources without endpoint in `server` block. " +
+					"Can be also set with `IRMC_ENDPOINT` environment variable.",
+				Description: "Default server BMC address (e.g. https://10.172.201.205) used by resources without endpoint in server block. " +
+					"Can be also set with IRMC_ENDPOINT environment variable.",
+				Optional: true,
+			},
+			"ssl_insecure": schema.BoolAttribute{
+				MarkdownDescription: "Default value indicating whether the SSL/TLS certificate must be verified or not. " +
+					"Can be also set with `IRMC_INSECURE` environment variable.",
+				Description: "Default value indicating whether the SSL/TLS certificate must be verified or not. " +
+					"Can be also set with IRMC_INSECURE environment variable.",
+				Optional: true,
+			},
+			"ca_certificate": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded CA bundle (or path to file containing it) used to verify certificates presented by BMCs.",
+				Description:         "PEM encoded CA bundle (or path to file containing it) used to verify certificates presented by BMCs.",
+				Optional:            true,
+			},
+			"timeout": schema.Int64Attribute{
+				MarkdownDescription: "Timeout in seconds of a single request sent to Redfish API. No timeout is used if not set.",
+				Description:         "Timeout in seconds of a single request sent to Redfish API. No timeout is used if not set.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"auth_method": schema.StringAttribute{
+				MarkdownDescription: "Default authentication method used to access Redfish API: `basic` (default) or `session`. " +
+					"With `session`, Redfish session is created once per endpoint, shared between all resources and deleted when provider exits.",
+				Description: "Default authentication method used to access Redfish API: 'basic' (default) or 'session'. " +
+					"With 'session', Redfish session is created once per endpoint, shared between all resources and deleted when provider exits.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(AUTH_METHOD_BASIC, AUTH_METHOD_SESSION),
+				},
+			},
+			"system_id": schema.StringAttribute{
+				MarkdownDescription: "Default Id of managed member of Systems collection (e.g. on multi-node platforms). If not set, first system reported by BMC is used.",
+				Description:         "Default Id of managed member of Systems collection (e.g. on multi-node platforms). If not set, first system reported by BMC is used.",
+				Optional:            true,
+			},
+			"manager_id": schema.StringAttribute{
+				MarkdownDescription: "Default Id of managed member of Managers collection. If not set, first manager reported by BMC is used.",
+				Description:         "Default Id of managed member of Managers collection. If not set, first manager reported by BMC is used.",
+				Optional:            true,
+			},
+			"event_stream": schema.BoolAttribute{
+				MarkdownDescription: "If set, completion of tasks and changes of host power state are awaited using Server-Sent Events stream of Redfish EventService " +
+					"instead of periodic polling. Polling is used if the system does not support Server-Sent Events.",
+				Description: "If set, completion of tasks and changes of host power state are awaited using Server-Sent Events stream of Redfish EventService " +
+					"instead of periodic polling. Polling is used if the system does not support Server-Sent Events.",
+				Optional: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"retry": schema.ListNestedBlock{
+				MarkdownDescription: "Policy of repeating requests failed because of transient errors (connection problems or configured HTTP status codes). " +
+					"Backoff settings are also used while waiting for iRMC to become available again after its reset.",
+				Description: "Policy of repeating requests failed because of transient errors (connection problems or configured HTTP status codes). " +
+					"Backoff settings are also used while waiting for iRMC to become available again after its reset.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"max_attempts": schema.Int64Attribute{
+							MarkdownDescription: fmt.Sprintf("Maximum number of attempts of single request (including the first one). Defaults to %d.", DEFAULT_RETRY_MAX_ATTEMPTS),
+							Description:         fmt.Sprintf("Maximum number of attempts of single request (including the first one). Defaults to %d.", DEFAULT_RETRY_MAX_ATTEMPTS),
+							Optional:            true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+						"min_backoff": schema.Int64Attribute{
+							MarkdownDescription: fmt.Sprintf("Time in seconds to wait before first repetition. It's doubled with every next attempt. Defaults to %d.", DEFAULT_RETRY_MIN_BACKOFF),
+							Description:         fmt.Sprintf("Time in seconds to wait before first repetition. It's doubled with every next attempt. Defaults to %d.", DEFAULT_RETRY_MIN_BACKOFF),
+							Optional:            true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+						"max_backoff": schema.Int64Attribute{
+							MarkdownDescription: fmt.Sprintf("Maximum time in seconds to wait between attempts, also when longer time is requested with `Retry-After` header. Defaults to %d.", DEFAULT_RETRY_MAX_BACKOFF),
+							Description:         fmt.Sprintf("Maximum time in seconds to wait between attempts, also when longer time is requested with Retry-After header. Defaults to %d.", DEFAULT_RETRY_MAX_BACKOFF),
+							Optional:            true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+						"retry_on_status": schema.ListAttribute{
+							MarkdownDescription: "HTTP status codes for which request is repeated. Defaults to `[429, 502, 503, 504]`.",
+							Description:         "HTTP status codes for which request is repeated. Defaults to [429, 502, 503, 504].",
+							ElementType:         types.Int64Type,
+							Optional:            true,
+							Validators: []validator.List{
+								listvalidator.ValueInt64sAre(int64validator.Between(400, 599)),
+							},
+						},
+					},
+				},
 			},
 		},
 	}
@@ -99,8 +246,29 @@ func (p *IrmcProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 		)
 	}
 
-	p.Username = data.Username.ValueString()
-	p.Password = data.Password.ValueString()
+	p.Username = stringValueOrEnv(data.Username, ENV_IRMC_USERNAME)
+	p.Password = stringValueOrEnv(data.Password, ENV_IRMC_PASSWORD)
+	p.Endpoint = stringValueOrEnv(data.Endpoint, ENV_IRMC_ENDPOINT)
+	p.CaCertificate = data.CaCertificate.ValueString()
+	p.Timeout = data.Timeout.ValueInt64()
+	p.AuthMethod = data.AuthMethod.ValueString()
+	p.SystemId = data.SystemId.ValueString()
+	p.ManagerId = data.ManagerId.ValueString()
+	p.EventStream = data.EventStream.ValueBool()
+
+	insecure, err := boolValueOrEnv(data.SslInsecure, ENV_IRMC_INSECURE)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid value of "+ENV_IRMC_INSECURE+" environment variable", err.Error())
+		return
+	}
+	p.SslInsecure = insecure
+
+	retry, err := retryPolicyFromModel(data.Retry)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid retry configuration", err.Error())
+		return
+	}
+	p.Retry = retry
 
 	resp.ResourceData = p
 	resp.DataSourceData = p
@@ -132,6 +300,15 @@ func (p *IrmcProvider) Resources(ctx context.Context) []func() resource.Resource
 		NewIrmcCertificateCaUpdDeployResource,
 		NewIrmcCertificateWebServerResource,
 		NewIrmcCertificateCaCasSmtpResource,
+		NewNtpResource,
+		NewNetworkProtocolResource,
+		NewManagerNetworkResource,
+		NewEventSubscriptionResource,
+		NewSnmpResource,
+		NewSyslogResource,
+		NewEmailAlertingResource,
+		NewLdapResource,
+		NewAccountPolicyResource,
 	}
 }
 
@@ -143,13 +320,81 @@ func (p *IrmcProvider) DataSources(ctx context.Context) []func() datasource.Data
 		NewStorageDataSource,
 		NewSystemBootDataSource,
 		NewIrmcAttributesDataSource,
+		NewHostEthernetInterfacesDataSource,
+		NewSystemDataSource,
+		NewProcessorsDataSource,
+		NewMemoryDataSource,
+		NewDrivesDataSource,
+		NewStorageVolumesDataSource,
+		NewStorageControllersDataSource,
+		NewSensorsDataSource,
 	}
 }
 
+// stringValueOrEnv returns value configured in provider block or value of environment
+// variable env if attribute has not been set.
+func stringValueOrEnv(value types.String, env string) string {
+	if !value.IsNull() && !value.IsUnknown() {
+		return value.ValueString()
+	}
+	return os.Getenv(env)
+}
+
+// boolValueOrEnv returns value configured in provider block or value of environment
+// variable env if attribute has not been set. Not set variable is treated as false.
+func boolValueOrEnv(value types.Bool, env string) (bool, error) {
+	if !value.IsNull() && !value.IsUnknown() {
+		return value.ValueBool(), nil
+	}
+
+	envValue, ok := os.LookupEnv(env)
+	if !ok || len(envValue) == 0 {
+		return false, nil
+	}
+
+	return strconv.ParseBool(envValue)
+}
+
+// retryPolicyFromModel returns retry policy defined in provider block with default
+// values used for attributes which have not been set.
+func retryPolicyFromModel(blocks []RetryPolicyModel) (RetryPolicy, error) {
+	policy := DefaultRetryPolicy()
+	if len(blocks) == 0 {
+		return policy, nil
+	}
+
+	model := blocks[0]
+	if !model.MaxAttempts.IsNull() && !model.MaxAttempts.IsUnknown() {
+		policy.MaxAttempts = model.MaxAttempts.ValueInt64()
+	}
+
+	if !model.MinBackoff.IsNull() && !model.MinBackoff.IsUnknown() {
+		policy.MinBackoff = time.Duration(model.MinBackoff.ValueInt64()) * time.Second
+	}
+
+	if !model.MaxBackoff.IsNull() && !model.MaxBackoff.IsUnknown() {
+		policy.MaxBackoff = time.Duration(model.MaxBackoff.ValueInt64()) * time.Second
+	}
+
+	if model.RetryOnStatus != nil {
+		policy.RetryOnStatus = make([]int64, 0, len(model.RetryOnStatus))
+		for _, status := range model.RetryOnStatus {
+			policy.RetryOnStatus = append(policy.RetryOnStatus, status.ValueInt64())
+		}
+	}
+
+	if policy.MinBackoff > policy.MaxBackoff {
+		return policy, fmt.Errorf("min_backoff (%s) must not be greater than max_backoff (%s)", policy.MinBackoff, policy.MaxBackoff)
+	}
+
+	return policy, nil
+}
+
 func New(version string) func() provider.Provider {
 	return func() provider.Provider {
 		return &IrmcProvider{
 			version: version,
+			clients: InitClientCacheInstance(),
 		}
 	}
 }
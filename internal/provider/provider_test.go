@@ -29,6 +29,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/joho/godotenv"
 	"github.com/stmcginnis/gofish"
@@ -87,20 +88,13 @@ func testAccPrepareVMediaSlots(creds TestingServerCredentials) {
 		return
 	}
 
-	isFsas, err := IsFsasCheck(context.Background(), api)
+	vendor, err := GetOemVendor(context.Background(), api)
 	if err != nil {
 		log.Printf("Vendor check reported error %s", err.Error())
 		return
 	}
 
-	var oemKey string
-	if isFsas {
-		oemKey = FSAS
-	} else {
-		oemKey = TS_FUJITSU
-	}
-
-	path := fmt.Sprintf("/redfish/v1/Systems/0/Oem/%s/VirtualMedia", oemKey)
+	path := fmt.Sprintf("/redfish/v1/Systems/0/Oem/%s/VirtualMedia", vendor.Key)
 
 	resp, err := api.Get(path)
 	if err != nil {
@@ -196,11 +190,37 @@ func testChangePowerHostState(creds TestingServerCredentials, poweredOn bool) {
 		log.Printf("Connect to %s reported error %s", clientConfig.Endpoint, err.Error())
 		return
 	}
-	if err = changePowerState(api.Service, poweredOn, 100); err != nil {
+	if err = changePowerState(context.Background(), api.Service, poweredOn, 100); err != nil {
 		log.Printf("Could not change power state %s", err.Error())
 	}
 }
 
+func TestProviderEnvironmentFallback(t *testing.T) {
+	t.Setenv(ENV_IRMC_USERNAME, "env-user")
+	t.Setenv(ENV_IRMC_INSECURE, "true")
+
+	if val := stringValueOrEnv(types.StringNull(), ENV_IRMC_USERNAME); val != "env-user" {
+		t.Errorf("Got %s, expected value from environment", val)
+	}
+
+	if val := stringValueOrEnv(types.StringValue("admin"), ENV_IRMC_USERNAME); val != "admin" {
+		t.Errorf("Got %s, expected value from configuration", val)
+	}
+
+	if val, err := boolValueOrEnv(types.BoolNull(), ENV_IRMC_INSECURE); err != nil || !val {
+		t.Errorf("Got %t (%v), expected value from environment", val, err)
+	}
+
+	if val, err := boolValueOrEnv(types.BoolValue(false), ENV_IRMC_INSECURE); err != nil || val {
+		t.Errorf("Got %t (%v), expected value from configuration", val, err)
+	}
+
+	t.Setenv(ENV_IRMC_INSECURE, "maybe")
+	if _, err := boolValueOrEnv(types.BoolNull(), ENV_IRMC_INSECURE); err == nil {
+		t.Errorf("Expected error for invalid boolean value")
+	}
+}
+
 func init() {
 	err := godotenv.Load("redfish_test.env")
 	if err != nil {
@@ -0,0 +1,446 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	tkpath "github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/stmcginnis/gofish"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AccountPolicyResource{}
+var _ resource.ResourceWithImportState = &AccountPolicyResource{}
+var _ resource.ResourceWithValidateConfig = &AccountPolicyResource{}
+
+func NewAccountPolicyResource() resource.Resource {
+	return &AccountPolicyResource{}
+}
+
+// AccountPolicyResource defines the resource implementation.
+type AccountPolicyResource struct {
+	p *IrmcProvider
+}
+
+func (r *AccountPolicyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + accountPolicyName
+}
+
+func AccountPolicySchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "ID of account service resource on iRMC.",
+			Description:         "ID of account service resource on iRMC.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"min_password_length": schema.Int64Attribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Minimum length of password of iRMC user. If not set, current value is kept.",
+			Description:         "Minimum length of password of iRMC user. If not set, current value is kept.",
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"max_password_length": schema.Int64Attribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Maximum length of password of iRMC user. If not set, current value is kept.",
+			Description:         "Maximum length of password of iRMC user. If not set, current value is kept.",
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"password_complexity_enabled": schema.BoolAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Indicates whether password must contain characters of at least 3 of 4 classes: lowercase letter, uppercase letter, digit and special character. If not set, current value is kept.",
+			Description:         "Indicates whether password must contain characters of at least 3 of 4 classes: lowercase letter, uppercase letter, digit and special character. If not set, current value is kept.",
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"password_expiration_days": schema.Int64Attribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Number of days after which password of iRMC user expires. Value 0 means passwords never expire. If not set, current value is kept.",
+			Description:         "Number of days after which password of iRMC user expires. Value 0 means passwords never expire. If not set, current value is kept.",
+			Validators: []validator.Int64{
+				int64validator.AtLeast(0),
+			},
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"account_lockout_threshold": schema.Int64Attribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Number of failed login attempts after which account is locked. Value 0 disables lockout. If not set, current value is kept.",
+			Description:         "Number of failed login attempts after which account is locked. Value 0 disables lockout. If not set, current value is kept.",
+			Validators: []validator.Int64{
+				int64validator.AtLeast(0),
+			},
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"account_lockout_duration": schema.Int64Attribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Time in seconds for which locked account stays locked. If not set, current value is kept.",
+			Description:         "Time in seconds for which locked account stays locked. If not set, current value is kept.",
+			Validators: []validator.Int64{
+				int64validator.AtLeast(0),
+			},
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"account_lockout_counter_reset_after": schema.Int64Attribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Time in seconds after last failed login attempt when counter of failed attempts is reset. Must not exceed lockout duration. If not set, current value is kept.",
+			Description:         "Time in seconds after last failed login attempt when counter of failed attempts is reset. Must not exceed lockout duration. If not set, current value is kept.",
+			Validators: []validator.Int64{
+				int64validator.AtLeast(0),
+			},
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+	}
+}
+
+func (r *AccountPolicyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The resource is used to control (read, modify or import) password and account lockout policy of iRMC users on Fujitsu server equipped with iRMC controller.",
+		Description:         "The resource is used to control (read, modify or import) password and account lockout policy of iRMC users on Fujitsu server equipped with iRMC controller.",
+		Attributes:          AccountPolicySchema(),
+		Blocks:              RedfishServerResourceBlockMap(),
+	}
+}
+
+func (r *AccountPolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	p, ok := req.ProviderData.(*IrmcProvider)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *IrmcProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.p = p
+}
+
+func (r *AccountPolicyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config models.AccountPolicyResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateAccountPolicyConfig(config)...)
+}
+
+func (r *AccountPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "resource-account_policy: create starts")
+
+	// Read Terraform plan data into the model
+	var plan models.AccountPolicyResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	tflog.Info(ctx, "resource-account_policy: create ends")
+}
+
+func (r *AccountPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "resource-account_policy: read starts")
+
+	// Read Terraform prior state data into the model
+	var state models.AccountPolicyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, err := ConnectTargetSystem(r.p, &state.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError("service error: ", err.Error())
+		return
+	}
+
+	defer api.Logout()
+
+	if err = readAccountPolicyToModel(api, &state); err != nil {
+		resp.Diagnostics.AddError("Error while reading account policy", err.Error())
+		return
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	tflog.Info(ctx, "resource-account_policy: read ends")
+}
+
+func (r *AccountPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "resource-account_policy: update starts")
+
+	// Read Terraform plan data into the model
+	var plan models.AccountPolicyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	tflog.Info(ctx, "resource-account_policy: update ends")
+}
+
+func (r *AccountPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "resource-account_policy: delete starts")
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "resource-account_policy: delete ends")
+}
+
+func (r *AccountPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Info(ctx, "resource-account_policy: import starts")
+
+	var config CommonImportConfig
+	err := json.Unmarshal([]byte(req.ID), &config)
+	if err != nil {
+		resp.Diagnostics.AddError("Error while unmarshalling import config", err.Error())
+		return
+	}
+
+	creds := importServerBlock(config.ServerConfig)
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, tkpath.Root("server"), creds)...)
+
+	tflog.Info(ctx, "resource-account_policy: import ends")
+}
+
+func (r *AccountPolicyResource) apply(ctx context.Context, plan *models.AccountPolicyResourceModel) (diags diag.Diagnostics) {
+	// Provide synchronization
+	var endpoint = GetServerEndpoint(r.p, plan.RedfishServer)
+	var resource_name = "resource-account_policy"
+	mutexPool.Lock(ctx, endpoint, resource_name)
+	defer mutexPool.Unlock(ctx, endpoint, resource_name)
+
+	// Connect to service
+	api, err := ConnectTargetSystem(r.p, &plan.RedfishServer)
+	if err != nil {
+		diags.AddError("service error: ", err.Error())
+		return diags
+	}
+
+	defer api.Logout()
+
+	vendor, err := GetOemVendor(ctx, api)
+	if err != nil {
+		diags.AddError("Vendor Detection Failed", err.Error())
+		return diags
+	}
+
+	if err = applyAccountPolicy(ctx, api, vendor, plan); err != nil {
+		diags.AddError("Error while applying account policy", err.Error())
+		return diags
+	}
+
+	if err = readAccountPolicyToModel(api, plan); err != nil {
+		diags.AddError("Error while reading account policy", err.Error())
+	}
+
+	return diags
+}
+
+// validateAccountPolicyConfig verifies at plan time that limits of account policy do not contradict each other.
+func validateAccountPolicyConfig(config models.AccountPolicyResourceModel) (diags diag.Diagnostics) {
+	const summary = "Invalid account policy configuration"
+
+	if isInt64Known(config.MinPasswordLength) && isInt64Known(config.MaxPasswordLength) &&
+		config.MinPasswordLength.ValueInt64() > config.MaxPasswordLength.ValueInt64() {
+		diags.AddAttributeError(tkpath.Root("min_password_length"), summary,
+			"Minimum password length must not exceed maximum password length")
+	}
+
+	if isInt64Known(config.AccountLockoutDuration) && isInt64Known(config.AccountLockoutCounterResetAfter) &&
+		config.AccountLockoutCounterResetAfter.ValueInt64() > config.AccountLockoutDuration.ValueInt64() {
+		diags.AddAttributeError(tkpath.Root("account_lockout_counter_reset_after"), summary,
+			"Counter of failed login attempts must be reset before lockout expires")
+	}
+
+	return diags
+}
+
+// isInt64Known returns true if value is neither null nor unknown.
+func isInt64Known(value types.Int64) bool {
+	return !value.IsNull() && !value.IsUnknown()
+}
+
+type accountPolicyOem struct {
+	PasswordComplexityEnabled *bool `json:"PasswordComplexityEnabled,omitempty"`
+}
+
+type accountPolicySettings struct {
+	MinPasswordLength               *int64                      `json:"MinPasswordLength"`
+	MaxPasswordLength               *int64                      `json:"MaxPasswordLength"`
+	PasswordExpirationDays          *int64                      `json:"PasswordExpirationDays"`
+	AccountLockoutThreshold         *int64                      `json:"AccountLockoutThreshold"`
+	AccountLockoutDuration          *int64                      `json:"AccountLockoutDuration"`
+	AccountLockoutCounterResetAfter *int64                      `json:"AccountLockoutCounterResetAfter"`
+	Oem                             OemObject[accountPolicyOem] `json:"Oem"`
+}
+
+// applyAccountPolicy sends to iRMC only these settings from plan, which are known and differ from current ones.
+func applyAccountPolicy(ctx context.Context, api *gofish.APIClient, vendor *OemVendor, plan *models.AccountPolicyResourceModel) error {
+	var current accountPolicySettings
+	etag, err := getRedfishResource(api, ACCOUNT_SERVICE_ENDPOINT, &current)
+	if err != nil {
+		return err
+	}
+
+	payload := map[string]interface{}{}
+	setIfChanged := func(name string, planned types.Int64, current *int64) {
+		if isInt64Known(planned) && (current == nil || *current != planned.ValueInt64()) {
+			payload[name] = planned.ValueInt64()
+		}
+	}
+
+	setIfChanged("MinPasswordLength", plan.MinPasswordLength, current.MinPasswordLength)
+	setIfChanged("MaxPasswordLength", plan.MaxPasswordLength, current.MaxPasswordLength)
+	setIfChanged("AccountLockoutThreshold", plan.AccountLockoutThreshold, current.AccountLockoutThreshold)
+	setIfChanged("AccountLockoutDuration", plan.AccountLockoutDuration, current.AccountLockoutDuration)
+	setIfChanged("AccountLockoutCounterResetAfter", plan.AccountLockoutCounterResetAfter, current.AccountLockoutCounterResetAfter)
+
+	// Redfish reports passwords which never expire as null
+	if isInt64Known(plan.PasswordExpirationDays) && plan.PasswordExpirationDays.ValueInt64() != valueOrZero(current.PasswordExpirationDays) {
+		if plan.PasswordExpirationDays.ValueInt64() == 0 {
+			payload["PasswordExpirationDays"] = nil
+		} else {
+			payload["PasswordExpirationDays"] = plan.PasswordExpirationDays.ValueInt64()
+		}
+	}
+
+	if !plan.PasswordComplexityEnabled.IsNull() && !plan.PasswordComplexityEnabled.IsUnknown() {
+		oem := current.Oem.Get()
+		if oem == nil || oem.PasswordComplexityEnabled == nil || *oem.PasswordComplexityEnabled != plan.PasswordComplexityEnabled.ValueBool() {
+			payload["Oem"] = NewOemObject(vendor, &accountPolicyOem{PasswordComplexityEnabled: plan.PasswordComplexityEnabled.ValueBoolPointer()})
+		}
+	}
+
+	if len(payload) == 0 {
+		return nil
+	}
+
+	tflog.Info(ctx, "Changing account policy", map[string]interface{}{
+		"payload": payload,
+	})
+	return patchRedfishResource(api, ACCOUNT_SERVICE_ENDPOINT, etag, payload)
+}
+
+// valueOrZero returns value pointed by ptr or 0 if ptr is nil.
+func valueOrZero(ptr *int64) int64 {
+	if ptr == nil {
+		return 0
+	}
+	return *ptr
+}
+
+// int64ValueOrNull converts value which might not be reported by iRMC into Terraform value.
+func int64ValueOrNull(ptr *int64) types.Int64 {
+	if ptr == nil {
+		return types.Int64Null()
+	}
+	return types.Int64Value(*ptr)
+}
+
+// readAccountPolicyToModel reads current account policy of iRMC into model, so changes done outside
+// of Terraform are detected.
+func readAccountPolicyToModel(api *gofish.APIClient, model *models.AccountPolicyResourceModel) error {
+	var settings accountPolicySettings
+	if _, err := getRedfishResource(api, ACCOUNT_SERVICE_ENDPOINT, &settings); err != nil {
+		return err
+	}
+
+	model.Id = types.StringValue(ACCOUNT_SERVICE_ENDPOINT)
+	model.MinPasswordLength = int64ValueOrNull(settings.MinPasswordLength)
+	model.MaxPasswordLength = int64ValueOrNull(settings.MaxPasswordLength)
+	model.PasswordExpirationDays = types.Int64Value(valueOrZero(settings.PasswordExpirationDays))
+	model.AccountLockoutThreshold = int64ValueOrNull(settings.AccountLockoutThreshold)
+	model.AccountLockoutDuration = int64ValueOrNull(settings.AccountLockoutDuration)
+	model.AccountLockoutCounterResetAfter = int64ValueOrNull(settings.AccountLockoutCounterResetAfter)
+
+	model.PasswordComplexityEnabled = types.BoolNull()
+	if oem := settings.Oem.Get(); oem != nil && oem.PasswordComplexityEnabled != nil {
+		model.PasswordComplexityEnabled = types.BoolValue(*oem.PasswordComplexityEnabled)
+	}
+
+	return nil
+}
@@ -0,0 +1,213 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+const resource_account_policy_name = "irmc-redfish_account_policy.policy"
+
+func TestAccRedfishAccountPolicy_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishResourceAccountPolicyConfig(creds, 12),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resource_account_policy_name, "min_password_length", "12"),
+					resource.TestCheckResourceAttr(resource_account_policy_name, "account_lockout_threshold", "5"),
+					resource.TestCheckResourceAttrSet(resource_account_policy_name, "max_password_length"),
+				),
+			},
+			{
+				Config: testAccRedfishResourceAccountPolicyConfig(creds, 14),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resource_account_policy_name, "min_password_length", "14"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRedfishAccountPolicy_import(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:       `resource "irmc-redfish_account_policy" "policy" {}`,
+				ResourceName: resource_account_policy_name,
+				ImportState:  true,
+				ImportStateIdFunc: func(d *terraform.State) (string, error) {
+					return fmt.Sprintf("{\"username\":\"%s\", \"password\":\"%s\", \"endpoint\":\"https://%s\", \"ssl_insecure\":true}",
+						creds.Username, creds.Password, creds.Endpoint), nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccRedfishAccountPolicy_negative(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRedfishResourceAccountPolicyConfig(creds, 0),
+				ExpectError: regexp.MustCompile("Invalid Attribute Value"),
+			},
+		},
+	})
+}
+
+func testAccRedfishResourceAccountPolicyConfig(testingInfo TestingServerCredentials, minLength int) string {
+	return fmt.Sprintf(`
+	resource "irmc-redfish_account_policy" "policy" {
+
+		server {
+		  username     = "%s"
+		  password     = "%s"
+		  endpoint     = "https://%s"
+		  ssl_insecure = true
+		}
+
+		min_password_length       = %d
+		account_lockout_threshold = 5
+	  }
+	`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		minLength,
+	)
+}
+
+func TestValidateAccountPolicyConfig(t *testing.T) {
+	config := models.AccountPolicyResourceModel{
+		MinPasswordLength:               types.Int64Value(16),
+		MaxPasswordLength:               types.Int64Value(12),
+		AccountLockoutDuration:          types.Int64Value(60),
+		AccountLockoutCounterResetAfter: types.Int64Value(120),
+	}
+
+	diags := validateAccountPolicyConfig(config)
+	if diags.ErrorsCount() != 2 {
+		t.Errorf("Expected errors for password length and lockout reset, got %v", diags)
+	}
+
+	config.MaxPasswordLength = types.Int64Unknown()
+	config.AccountLockoutCounterResetAfter = types.Int64Null()
+	if diags = validateAccountPolicyConfig(config); diags.HasError() {
+		t.Errorf("Unexpected errors %v", diags)
+	}
+}
+
+func TestApplyAccountPolicy(t *testing.T) {
+	forEachMockFlavor(t, func(t *testing.T, m *mockRedfishServer) {
+		api := m.connect()
+		ctx := context.Background()
+
+		plan := models.AccountPolicyResourceModel{
+			MinPasswordLength:               types.Int64Value(14),
+			MaxPasswordLength:               types.Int64Unknown(),
+			PasswordComplexityEnabled:       types.BoolValue(false),
+			PasswordExpirationDays:          types.Int64Value(90),
+			AccountLockoutThreshold:         types.Int64Value(5),
+			AccountLockoutDuration:          types.Int64Null(),
+			AccountLockoutCounterResetAfter: types.Int64Null(),
+		}
+
+		if err := applyAccountPolicy(ctx, api, m.vendor(), &plan); err != nil {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+
+		settings := m.get(ACCOUNT_SERVICE_ENDPOINT)
+		if fmt.Sprint(settings["MinPasswordLength"]) != "14" || fmt.Sprint(settings["MaxPasswordLength"]) != "20" ||
+			fmt.Sprint(settings["PasswordExpirationDays"]) != "90" {
+			t.Errorf("Unexpected account policy %v", settings)
+		}
+		if oem := m.oem(ACCOUNT_SERVICE_ENDPOINT); oem["PasswordComplexityEnabled"] != false {
+			t.Errorf("Unexpected OEM account policy %v", oem)
+		}
+
+		// Settings equal to current ones must not be sent again
+		if err := applyAccountPolicy(ctx, api, m.vendor(), &plan); err != nil {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+		if count := len(m.requestsTo(http.MethodPatch, ACCOUNT_SERVICE_ENDPOINT)); count != 1 {
+			t.Errorf("Got %d PATCH requests for unchanged settings, expected none", count-1)
+		}
+
+		// Expiration is disabled by null value
+		plan.PasswordExpirationDays = types.Int64Value(0)
+		if err := applyAccountPolicy(ctx, api, m.vendor(), &plan); err != nil {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+		if days, ok := m.get(ACCOUNT_SERVICE_ENDPOINT)["PasswordExpirationDays"]; ok && days != nil {
+			t.Errorf("Expected passwords which never expire, got %v", days)
+		}
+
+		// Policy applied by resource is used to validate passwords of users
+		policy, _ := GetPasswordPolicy(api)
+		if policy.MinLength != 14 || policy.ComplexityEnabled {
+			t.Errorf("Unexpected password policy %v", policy)
+		}
+	})
+}
+
+func TestReadAccountPolicyToModel(t *testing.T) {
+	m := newMockRedfishServer(t, FSAS)
+	api := m.connect()
+
+	// Change done outside of Terraform must be visible in state
+	m.update(ACCOUNT_SERVICE_ENDPOINT, map[string]interface{}{
+		"AccountLockoutThreshold": 0,
+		"PasswordExpirationDays":  180,
+	})
+
+	var model models.AccountPolicyResourceModel
+	if err := readAccountPolicyToModel(api, &model); err != nil {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+
+	if model.Id.ValueString() != ACCOUNT_SERVICE_ENDPOINT || model.MinPasswordLength.ValueInt64() != 12 || model.MaxPasswordLength.ValueInt64() != 20 {
+		t.Errorf("Unexpected model %v", model)
+	}
+
+	if model.AccountLockoutThreshold.ValueInt64() != 0 || model.PasswordExpirationDays.ValueInt64() != 180 || !model.PasswordComplexityEnabled.ValueBool() {
+		t.Errorf("Unexpected account policy in model %v", model)
+	}
+
+	// Passwords which never expire are reported as null
+	m.update(ACCOUNT_SERVICE_ENDPOINT, map[string]interface{}{"PasswordExpirationDays": nil})
+	if err := readAccountPolicyToModel(api, &model); err != nil {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+	if model.PasswordExpirationDays.IsNull() || model.PasswordExpirationDays.ValueInt64() != 0 {
+		t.Errorf("Expected 0 expiration days, got %v", model.PasswordExpirationDays)
+	}
+}
@@ -141,7 +141,7 @@ func (r *BiosResource) Create(ctx context.Context, req resource.CreateRequest, r
 	}
 
 	// Provide synchronization
-	var endpoint = plan.RedfishServer[0].Endpoint.ValueString()
+	var endpoint = GetServerEndpoint(r.p, plan.RedfishServer)
 	var resource_name = "resource-bios"
 	mutexPool.Lock(ctx, endpoint, resource_name)
 	defer mutexPool.Unlock(ctx, endpoint, resource_name)
@@ -182,7 +182,12 @@ func (r *BiosResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
-	plan.Id = types.StringValue(BIOS_SETTINGS_ENDPOINT)
+	biosSettingsEndpoint, err := getBiosSettingsEndpoint(api.Service)
+	if err != nil {
+		resp.Diagnostics.AddError("Could not determine location of BIOS settings", err.Error())
+		return
+	}
+	plan.Id = types.StringValue(biosSettingsEndpoint)
 
 	diags = resp.State.Set(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -243,13 +248,18 @@ func (r *BiosResource) Update(ctx context.Context, req resource.UpdateRequest, r
 
 	defer api.Logout()
 
-	isFsas, err := IsFsasCheck(ctx, api)
+	vendor, err := GetOemVendor(ctx, api)
 
 	if err != nil {
 		resp.Diagnostics.AddError("Vendor Detection Failed", err.Error())
 		return
 	}
-	endp := getIrmcAttributesEndpoints(isFsas)
+	manager, err := GetManagerEndpoint(api.Service)
+	if err != nil {
+		resp.Diagnostics.AddError("Manager Resource Detection Failed", err.Error())
+		return
+	}
+	endp := getIrmcAttributesEndpoints(vendor, manager)
 	var plannedAttributes map[string]string
 	diags = plan.Attributes.ElementsAs(ctx, &plannedAttributes, true)
 	resp.Diagnostics.Append(diags...)
@@ -277,7 +287,12 @@ func (r *BiosResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
-	plan.Id = types.StringValue(BIOS_SETTINGS_ENDPOINT)
+	biosSettingsEndpoint, err := getBiosSettingsEndpoint(api.Service)
+	if err != nil {
+		resp.Diagnostics.AddError("Could not determine location of BIOS settings", err.Error())
+		return
+	}
+	plan.Id = types.StringValue(biosSettingsEndpoint)
 
 	diags = resp.State.Set(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -304,14 +319,7 @@ func (r *BiosResource) ImportState(ctx context.Context, req resource.ImportState
 		return
 	}
 
-	server := models.RedfishServer{
-		User:        types.StringValue(config.Username),
-		Password:    types.StringValue(config.Password),
-		Endpoint:    types.StringValue(config.Endpoint),
-		SslInsecure: types.BoolValue(config.SslInsecure),
-	}
-
-	creds := []models.RedfishServer{server}
+	creds := importServerBlock(config.ServerConfig)
 
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, tkpath.Root("server"), creds)...)
 
@@ -320,9 +328,15 @@ func (r *BiosResource) ImportState(ctx context.Context, req resource.ImportState
 
 func applyBiosAttributes(service *gofish.Service, adjustedAttributes map[string]interface{}) (diags diag.Diagnostics) {
 	client := service.GetClient()
-	res, err := client.Get(BIOS_SETTINGS_ENDPOINT)
+	endpoint, err := getBiosSettingsEndpoint(service)
+	if err != nil {
+		diags.AddError("Could not determine location of BIOS settings", err.Error())
+		return diags
+	}
+
+	res, err := client.Get(endpoint)
 	if err != nil {
-		diags.AddError("Reading /redfish/v1/Systems/0/Bios/Settings failed", err.Error())
+		diags.AddError(fmt.Sprintf("Reading %s failed", endpoint), err.Error())
 		return diags
 	}
 
@@ -332,11 +346,11 @@ func applyBiosAttributes(service *gofish.Service, adjustedAttributes map[string]
 		"Attributes": adjustedAttributes,
 	}
 
-	_, err = client.PatchWithHeaders(BIOS_SETTINGS_ENDPOINT, payload,
+	_, err = client.PatchWithHeaders(endpoint, payload,
 		map[string]string{HTTP_HEADER_IF_MATCH: res.Header.Get(HTTP_HEADER_ETAG)})
 
 	if err != nil {
-		diags.AddError("Changing /redfish/v1/Systems/0/Bios/Settings failed", err.Error())
+		diags.AddError(fmt.Sprintf("Changing %s failed", endpoint), err.Error())
 		return diags
 	}
 
@@ -348,13 +362,13 @@ func applyBiosAttributes(service *gofish.Service, adjustedAttributes map[string]
 func validateAndAdjustPlannedAttributes(ctx context.Context, service *gofish.Service, plannedAttributes map[string]string) (adjustedAttributes map[string]interface{}, diags diag.Diagnostics) {
 	system, err := GetSystemResource(service)
 	if err != nil {
-		diags.AddError("Error while reading /Systems/0", err.Error())
+		diags.AddError("Error while reading System resource", err.Error())
 		return adjustedAttributes, diags
 	}
 
 	rBios, err := system.Bios()
 	if err != nil {
-		diags.AddError("Error while reading /Systems/0/Bios", err.Error())
+		diags.AddError("Error while reading Bios resource", err.Error())
 		return adjustedAttributes, diags
 	}
 
@@ -447,13 +461,13 @@ func isAttributeSupported(key string) bool {
 func readBiosAttributesSettingsToModel(ctx context.Context, service *gofish.Service, attrMap *types.Map, updateAll bool) (diags diag.Diagnostics) {
 	system, err := GetSystemResource(service)
 	if err != nil {
-		diags.AddError("Error while reading /Systems/0", err.Error())
+		diags.AddError("Error while reading System resource", err.Error())
 		return diags
 	}
 
 	rBios, err := system.Bios()
 	if err != nil {
-		diags.AddError("Error while reading /Systems/0/Bios", err.Error())
+		diags.AddError("Error while reading Bios resource", err.Error())
 		return diags
 	}
 
@@ -18,11 +18,17 @@ limitations under the License.
 package provider
 
 import (
+	"context"
 	"fmt"
+	"net/http"
 	"regexp"
+	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stmcginnis/gofish/redfish"
 )
 
 const bios_name = "irmc-redfish_bios.bios"
@@ -132,3 +138,119 @@ func testAccRedfishResourceBiosConfig_notSupportedBootSources(testingInfo Testin
 		reset_type,
 	)
 }
+
+func TestValidateAndAdjustPlannedAttributes(t *testing.T) {
+	forEachMockFlavor(t, func(t *testing.T, m *mockRedfishServer) {
+		api := m.connect()
+		ctx := context.Background()
+
+		attributes, diags := validateAndAdjustPlannedAttributes(ctx, api.Service, map[string]string{
+			"AssetTag":       "NewTag",
+			"BootRetryCount": "5",
+			"NumLockState":   "On",
+		})
+		if diags.HasError() {
+			t.Fatalf("Unexpected error: %v", diags)
+		}
+
+		if len(attributes) != 2 || attributes["AssetTag"] != "NewTag" || attributes["BootRetryCount"] != 5 {
+			t.Errorf("Unexpected adjusted attributes: %v", attributes)
+		}
+
+		negativeCases := map[string]map[string]string{
+			"not supported by the system":   {"XXX": "1"},
+			"not supported by the resource": {PERSISTENT_BOOT_ORDER_KEY: "x"},
+			"conversion failed":             {"BootRetryCount": "many"},
+			"List of attributes is empty":   {"NumLockState": "On"},
+		}
+
+		for expected, planned := range negativeCases {
+			_, diags := validateAndAdjustPlannedAttributes(ctx, api.Service, planned)
+			if !diags.HasError() || !strings.Contains(fmt.Sprint(diags), expected) {
+				t.Errorf("Expected error containing '%s', got %v", expected, diags)
+			}
+		}
+	})
+}
+
+func TestApplyBiosAttributes(t *testing.T) {
+	forEachMockFlavor(t, func(t *testing.T, m *mockRedfishServer) {
+		api := m.connect()
+		etag := m.etag(mockSystemEndpoint + BIOS_SETTINGS_ENDPOINT_SUFFIX)
+
+		diags := applyBiosAttributes(api.Service, map[string]interface{}{"AssetTag": "NewTag"})
+		if diags.HasError() {
+			t.Fatalf("Unexpected error: %v", diags)
+		}
+
+		patches := m.requestsTo(http.MethodPatch, mockSystemEndpoint+BIOS_SETTINGS_ENDPOINT_SUFFIX)
+		if len(patches) != 1 || patches[0].Header.Get(HTTP_HEADER_IF_MATCH) != etag {
+			t.Errorf("Expected single PATCH with If-Match '%s', got %v", etag, patches)
+		}
+
+		settings := m.get(mockSystemEndpoint + BIOS_SETTINGS_ENDPOINT_SUFFIX)["Attributes"].(map[string]interface{})
+		if settings["AssetTag"] != "NewTag" {
+			t.Errorf("Attribute has not been applied to settings: %v", settings)
+		}
+	})
+}
+
+func TestReadBiosAttributesSettingsToModel(t *testing.T) {
+	forEachMockFlavor(t, func(t *testing.T, m *mockRedfishServer) {
+		api := m.connect()
+		ctx := context.Background()
+
+		attrMap := types.MapValueMust(types.StringType, map[string]attr.Value{
+			"AssetTag": types.StringValue("Other"),
+		})
+
+		diags := readBiosAttributesSettingsToModel(ctx, api.Service, &attrMap, false)
+		if diags.HasError() {
+			t.Fatalf("Unexpected error: %v", diags)
+		}
+
+		elements := attrMap.Elements()
+		if len(elements) != 1 || elements["AssetTag"] != types.StringValue("MockTag") {
+			t.Errorf("Unexpected attributes in model: %v", elements)
+		}
+
+		diags = readBiosAttributesSettingsToModel(ctx, api.Service, &attrMap, true)
+		if diags.HasError() {
+			t.Fatalf("Unexpected error: %v", diags)
+		}
+
+		elements = attrMap.Elements()
+		if _, ok := elements[PERSISTENT_BOOT_ORDER_KEY]; ok {
+			t.Errorf("Not supported attribute has been read into model")
+		}
+
+		if elements["BootRetryCount"] != types.StringValue("3") {
+			t.Errorf("Integer attribute has not been converted, got %v", elements["BootRetryCount"])
+		}
+	})
+}
+
+func TestWaitTillBiosSettingsApplied(t *testing.T) {
+	forEachMockFlavor(t, func(t *testing.T, m *mockRedfishServer) {
+		api := m.connect()
+
+		// Pending settings contain only attributes planned to be applied
+		m.set(mockSystemEndpoint+BIOS_SETTINGS_ENDPOINT_SUFFIX, map[string]interface{}{
+			"Attributes": map[string]interface{}{"AssetTag": "NewTag"},
+		})
+
+		diags := waitTillBiosSettingsApplied(context.Background(), api.Service, 60, redfish.ForceRestartResetType)
+		if diags.HasError() {
+			t.Fatalf("Unexpected error: %v", diags)
+		}
+
+		if poweredOn, _ := isPoweredOn(api.Service); !poweredOn {
+			t.Errorf("Host has not been powered on to apply settings")
+		}
+
+		attributes := m.get(mockSystemEndpoint + BIOS_ENDPOINT_SUFFIX)["Attributes"].(map[string]interface{})
+		if attributes["AssetTag"] != "NewTag" {
+			t.Errorf("Pending settings have not been applied: %v", attributes)
+		}
+	})
+}
@@ -153,7 +153,7 @@ func (r *BootOrderResource) Create(ctx context.Context, req resource.CreateReque
 	}
 
 	// Provide synchronization
-	var endpoint = plan.RedfishServer[0].Endpoint.ValueString()
+	var endpoint = GetServerEndpoint(r.p, plan.RedfishServer)
 	var resource_name = "resource-boot_order"
 	mutexPool.Lock(ctx, endpoint, resource_name)
 	defer mutexPool.Unlock(ctx, endpoint, resource_name)
@@ -198,7 +198,12 @@ func (r *BootOrderResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
-	plan.Id = types.StringValue(BIOS_SETTINGS_ENDPOINT)
+	biosSettingsEndpoint, err := getBiosSettingsEndpoint(api.Service)
+	if err != nil {
+		resp.Diagnostics.AddError("Could not determine location of BIOS settings", err.Error())
+		return
+	}
+	plan.Id = types.StringValue(biosSettingsEndpoint)
 
 	diags = resp.State.Set(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -236,7 +241,12 @@ func (r *BootOrderResource) Read(ctx context.Context, req resource.ReadRequest,
 	newState.JobTimeout = currState.JobTimeout
 	newState.RedfishServer = currState.RedfishServer
 	newState.SystemResetType = currState.SystemResetType
-	newState.Id = types.StringValue(BIOS_SETTINGS_ENDPOINT)
+	biosSettingsEndpoint, err := getBiosSettingsEndpoint(api.Service)
+	if err != nil {
+		resp.Diagnostics.AddError("Could not determine location of BIOS settings", err.Error())
+		return
+	}
+	newState.Id = types.StringValue(biosSettingsEndpoint)
 
 	diags = resp.State.Set(ctx, &newState)
 	resp.Diagnostics.Append(diags...)
@@ -293,7 +303,12 @@ func (r *BootOrderResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
-	plan.Id = types.StringValue("/redfish/v1/Systems/0/Bios/Settings")
+	biosSettingsEndpoint, err := getBiosSettingsEndpoint(api.Service)
+	if err != nil {
+		resp.Diagnostics.AddError("Could not determine location of BIOS settings", err.Error())
+		return
+	}
+	plan.Id = types.StringValue(biosSettingsEndpoint)
 
 	diags = resp.State.Set(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -320,14 +335,7 @@ func (r *BootOrderResource) ImportState(ctx context.Context, req resource.Import
 		return
 	}
 
-	server := models.RedfishServer{
-		User:        types.StringValue(config.Username),
-		Password:    types.StringValue(config.Password),
-		Endpoint:    types.StringValue(config.Endpoint),
-		SslInsecure: types.BoolValue(config.SslInsecure),
-	}
-
-	creds := []models.RedfishServer{server}
+	creds := importServerBlock(config.ServerConfig)
 
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, tkpath.Root("server"), creds)...)
 
@@ -349,9 +357,15 @@ func getDeviceNameFromStructureBootString(currentBootOrder []BootOrderEntry, str
 // pointed by service.
 func applyBootOrderPlan(service *gofish.Service, currentBootOrder []BootOrderEntry, plannedBootOrder BootOrder) (diags diag.Diagnostics) {
 	client := service.GetClient()
-	res, err := client.Get(BIOS_SETTINGS_ENDPOINT)
+	endpoint, err := getBiosSettingsEndpoint(service)
 	if err != nil {
-		diags.AddError("Reading /redfish/v1/Systems/0/Bios/Settings failed", err.Error())
+		diags.AddError("Could not determine location of BIOS settings", err.Error())
+		return diags
+	}
+
+	res, err := client.Get(endpoint)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Reading %s failed", endpoint), err.Error())
 		return diags
 	}
 
@@ -375,11 +389,11 @@ func applyBootOrderPlan(service *gofish.Service, currentBootOrder []BootOrderEnt
 		},
 	}
 
-	res, err = client.PatchWithHeaders(BIOS_SETTINGS_ENDPOINT, payload,
+	res, err = client.PatchWithHeaders(endpoint, payload,
 		map[string]string{HTTP_HEADER_IF_MATCH: res.Header.Get(HTTP_HEADER_ETAG)})
 
 	if err != nil {
-		diags.AddError("Changing /redfish/v1/Systems/0/Bios/Settings failed", err.Error())
+		diags.AddError(fmt.Sprintf("Changing %s failed", endpoint), err.Error())
 		return diags
 	}
 
@@ -392,9 +406,15 @@ func applyBootOrderPlan(service *gofish.Service, currentBootOrder []BootOrderEnt
 // over diags.
 func getBiosSettingsFutureAttributesNumber(service *gofish.Service) (length int, diags diag.Diagnostics) {
 	client := service.GetClient()
-	res, err := client.Get(BIOS_SETTINGS_ENDPOINT)
+	endpoint, err := getBiosSettingsEndpoint(service)
+	if err != nil {
+		diags.AddError("Could not determine location of BIOS settings", err.Error())
+		return 0, diags
+	}
+
+	res, err := client.Get(endpoint)
 	if err != nil {
-		diags.AddError("Reading /redfish/v1/Systems/0/Bios/Settings failed", err.Error())
+		diags.AddError(fmt.Sprintf("Reading %s failed", endpoint), err.Error())
 		return 0, diags
 	}
 
@@ -403,13 +423,13 @@ func getBiosSettingsFutureAttributesNumber(service *gofish.Service) (length int,
 	var config BiosSettings
 	bodyBytes, err := io.ReadAll(res.Body)
 	if err != nil {
-		diags.AddError("Reading body of /redfish/v1/Systems/0/Bios/Settings failed", err.Error())
+		diags.AddError(fmt.Sprintf("Reading body of %s failed", endpoint), err.Error())
 		return 0, diags
 	}
 
 	err = json.Unmarshal(bodyBytes, &config)
 	if err != nil {
-		diags.AddError("Failed to unmarshal /redfish/v1/Systems/0/Bios/Settings response body", err.Error())
+		diags.AddError(fmt.Sprintf("Failed to unmarshal %s response body", endpoint), err.Error())
 		return 0, diags
 	}
 
@@ -432,10 +452,10 @@ func waitTillBootOrderApplied(ctx context.Context, service *gofish.Service, plan
 	startTime := time.Now().Unix()
 
 	if !poweredOn {
-		err = changePowerState(service, true, timeout)
+		err = changePowerState(ctx, service, true, timeout)
 	} else {
 		resetType := (redfish.ResetType)(plan.SystemResetType.ValueString())
-		err = resetHost(service, resetType, timeout)
+		err = resetHost(ctx, service, resetType, timeout)
 	}
 
 	// Due to BIOS setting change it might happen that host will be powered off after
@@ -468,7 +488,10 @@ func waitTillBootOrderApplied(ctx context.Context, service *gofish.Service, plan
 			break
 		}
 
-		time.Sleep(2 * time.Second)
+		if err := sleepWithContext(ctx, 2*time.Second); err != nil {
+			diags.AddError("Waiting for BIOS settings to be applied has been cancelled", err.Error())
+			return diags
+		}
 		if time.Now().Unix()-startTime > timeout {
 			diags.AddError("Job timeout exceeded while operation has not finished", "Terminate")
 			return diags
@@ -528,13 +551,13 @@ func findAvailableAndNotPlannedBootEntries(currentBootOrder []BootOrderEntry, pl
 func validateBootOrderPlan(service *gofish.Service, plannedBootOrder BootOrder) (currentBootOrder []BootOrderEntry, diags diag.Diagnostics) {
 	system, err := GetSystemResource(service)
 	if err != nil {
-		diags.AddError("Error while reading /Systems/0", err.Error())
+		diags.AddError("Error while reading System resource", err.Error())
 		return currentBootOrder, diags
 	}
 
 	rBios, err := system.Bios()
 	if err != nil {
-		diags.AddError("Error while reading /Systems/0/Bios", err.Error())
+		diags.AddError("Error while reading Bios resource", err.Error())
 		return currentBootOrder, diags
 	}
 
@@ -597,13 +620,13 @@ func validateBootOrderPlan(service *gofish.Service, plannedBootOrder BootOrder)
 func readCurrentBootOrder(service *gofish.Service, state *models.BootOrderResourceModel) (diags diag.Diagnostics) {
 	system, err := GetSystemResource(service)
 	if err != nil {
-		diags.AddError("Error while reading /Systems/0", err.Error())
+		diags.AddError("Error while reading System resource", err.Error())
 		return diags
 	}
 
 	rBios, err := system.Bios()
 	if err != nil {
-		diags.AddError("Error while reading /Systems/0/Bios", err.Error())
+		diags.AddError("Error while reading Bios resource", err.Error())
 		return diags
 	}
 
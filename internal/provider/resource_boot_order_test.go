@@ -21,8 +21,12 @@ import (
 	"fmt"
 	"os"
 	"regexp"
+	"strings"
+	"terraform-provider-irmc-redfish/internal/models"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -105,3 +109,80 @@ func testAccRedfishResourceBootOrderConfig(testingInfo TestingServerCredentials,
 		boot_order,
 	)
 }
+
+func TestValidateBootOrderPlan(t *testing.T) {
+	forEachMockFlavor(t, func(t *testing.T, m *mockRedfishServer) {
+		api := m.connect()
+
+		current, diags := validateBootOrderPlan(api.Service, BootOrder{"NIC.LOM.2.3.IPv4PXE", "NIC.LOM.1.2.IPv4PXE", "HD.Emb.0.5"})
+		if diags.HasError() {
+			t.Fatalf("Unexpected error: %v", diags)
+		}
+
+		if len(current) != 3 || current[0].StructuredBootString != "HD.Emb.0.5" || current[0].DeviceName != "(UEFI) HDD: Mock Disk" {
+			t.Errorf("Unexpected current boot order: %v", current)
+		}
+
+		negativeCases := map[string]BootOrder{
+			"is not on the list of supported boot entries": {"HD.Emb.0.5", "NIC.LOM.1.2.IPv4PXE", "NIC.LOM.2.3.IPv4PXEEEE"},
+			"different length": {"HD.Emb.0.5"},
+			"does not contain all available boot options": {"HD.Emb.0.5", "HD.Emb.0.5", "NIC.LOM.1.2.IPv4PXE"},
+		}
+
+		for expected, planned := range negativeCases {
+			_, diags := validateBootOrderPlan(api.Service, planned)
+			if !diags.HasError() || !strings.Contains(fmt.Sprint(diags), expected) {
+				t.Errorf("Expected error containing '%s', got %v", expected, diags)
+			}
+		}
+	})
+}
+
+func TestApplyBootOrderPlan(t *testing.T) {
+	forEachMockFlavor(t, func(t *testing.T, m *mockRedfishServer) {
+		api := m.connect()
+		planned := BootOrder{"NIC.LOM.2.3.IPv4PXE", "NIC.LOM.1.2.IPv4PXE", "HD.Emb.0.5"}
+
+		current, diags := validateBootOrderPlan(api.Service, planned)
+		if diags.HasError() {
+			t.Fatalf("Unexpected error: %v", diags)
+		}
+
+		diags = applyBootOrderPlan(api.Service, current, planned)
+		if diags.HasError() {
+			t.Fatalf("Unexpected error: %v", diags)
+		}
+
+		settings := m.get(mockSystemEndpoint + BIOS_SETTINGS_ENDPOINT_SUFFIX)["Attributes"].(map[string]interface{})
+		order, ok := settings[PERSISTENT_BOOT_ORDER_KEY].([]interface{})
+		if !ok || len(order) != 3 {
+			t.Fatalf("Boot order has not been applied to settings: %v", settings)
+		}
+
+		first := order[0].([]interface{})
+		if first[0] != "NIC.LOM.2.3.IPv4PXE" || first[1] != "(UEFI) PXE IPv4 LOM 2" {
+			t.Errorf("Unexpected first boot entry %v", first)
+		}
+	})
+}
+
+func TestReadCurrentBootOrder(t *testing.T) {
+	forEachMockFlavor(t, func(t *testing.T, m *mockRedfishServer) {
+		api := m.connect()
+
+		var state models.BootOrderResourceModel
+		diags := readCurrentBootOrder(api.Service, &state)
+		if diags.HasError() {
+			t.Fatalf("Unexpected error: %v", diags)
+		}
+
+		expected := types.ListValueMust(types.StringType, []attr.Value{
+			types.StringValue("HD.Emb.0.5"),
+			types.StringValue("NIC.LOM.1.2.IPv4PXE"),
+			types.StringValue("NIC.LOM.2.3.IPv4PXE"),
+		})
+		if !state.BootOrder.Equal(expected) {
+			t.Errorf("Got boot order %v, expected %v", state.BootOrder, expected)
+		}
+	})
+}
@@ -168,7 +168,7 @@ func (r *BootSourceOverrideResource) Create(ctx context.Context, req resource.Cr
 	}
 
 	// Provide synchronization
-	var endpoint = plan.RedfishServer[0].Endpoint.ValueString()
+	var endpoint = GetServerEndpoint(r.p, plan.RedfishServer)
 	var resource_name = "resource-boot_source_override"
 	mutexPool.Lock(ctx, endpoint, resource_name)
 	defer mutexPool.Unlock(ctx, endpoint, resource_name)
@@ -182,13 +182,18 @@ func (r *BootSourceOverrideResource) Create(ctx context.Context, req resource.Cr
 
 	defer api.Logout()
 
-	isFsas, err := IsFsasCheck(ctx, api)
+	vendor, err := GetOemVendor(ctx, api)
 	if err != nil {
 		resp.Diagnostics.AddError("Vendor Detection Failed", err.Error())
 		return
 	}
 
-	endp := getBootSourceOverrideEndpoints(isFsas)
+	system, err := GetSystemEndpoint(api.Service)
+	if err != nil {
+		resp.Diagnostics.AddError("System Resource Detection Failed", err.Error())
+		return
+	}
+	endp := getBootSourceOverrideEndpoints(vendor, system)
 
 	err = bootSourceOverrideApply(api, &plan, endp.bootConfigOemEndpoint)
 	if err != nil {
@@ -198,7 +203,7 @@ func (r *BootSourceOverrideResource) Create(ctx context.Context, req resource.Cr
 
 	resetType := (redfish.ResetType)(plan.SystemResetType.ValueString())
 	timeout := plan.JobTimeout.ValueInt64()
-	err = resetOrPowerOnHostWithPostCheck(api.Service, resetType, timeout)
+	err = resetOrPowerOnHostWithPostCheck(ctx, api.Service, resetType, timeout)
 	if err != nil {
 		resp.Diagnostics.AddError("Error reported by reset procedure %s", err.Error())
 		return
@@ -276,14 +281,8 @@ func bootSourceOverrideApply(api *gofish.APIClient, plan *models.BootSourceOverr
 	return nil
 }
 
-func getBootSourceOverrideEndpoints(isFsas bool) bootSourceOverrideEndpoints {
-	if isFsas {
-		return bootSourceOverrideEndpoints{
-			bootConfigOemEndpoint: fmt.Sprintf("/redfish/v1/Systems/0/Oem/%s/BootConfig", FSAS),
-		}
-	} else {
-		return bootSourceOverrideEndpoints{
-			bootConfigOemEndpoint: fmt.Sprintf("/redfish/v1/Systems/0/Oem/%s/BootConfig", TS_FUJITSU),
-		}
+func getBootSourceOverrideEndpoints(vendor *OemVendor, system string) bootSourceOverrideEndpoints {
+	return bootSourceOverrideEndpoints{
+		bootConfigOemEndpoint: vendor.OemPath(system, "BootConfig"),
 	}
 }
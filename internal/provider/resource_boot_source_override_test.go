@@ -19,8 +19,10 @@ package provider
 
 import (
 	"fmt"
+	"terraform-provider-irmc-redfish/internal/models"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -78,3 +80,33 @@ func testAccRedfishResourceBootSourceOverrideConfig(testingInfo TestingServerCre
 		resetType,
 	)
 }
+
+func TestBootSourceOverrideApply(t *testing.T) {
+	forEachMockFlavor(t, func(t *testing.T, m *mockRedfishServer) {
+		api := m.connect()
+		endpoint := getBootSourceOverrideEndpoints(m.vendor(), mockSystemEndpoint).bootConfigOemEndpoint
+
+		plan := models.BootSourceOverrideResourceModel{
+			BootSourceOverrideTarget:  types.StringValue("Pxe"),
+			BootSourceOverrideEnabled: types.StringValue("Continuous"),
+		}
+
+		if err := bootSourceOverrideApply(api, &plan, endpoint); err != nil {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+
+		config := m.get(endpoint)
+		if config["BootDevice"] != "Pxe" || config["NextBootOnlyEnabled"] != false {
+			t.Errorf("Unexpected boot config %v", config)
+		}
+
+		plan.BootSourceOverrideEnabled = types.StringValue("Once")
+		if err := bootSourceOverrideApply(api, &plan, endpoint); err != nil {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+
+		if m.get(endpoint)["NextBootOnlyEnabled"] != true {
+			t.Errorf("Boot override has not been set for next boot only")
+		}
+	})
+}
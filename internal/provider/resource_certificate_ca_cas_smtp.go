@@ -112,7 +112,7 @@ func (r *IrmcCertificateCaCasSmtpResource) Create(ctx context.Context, req resou
 	}
 
 	// Provide synchronization
-	var endpoint = plan.RedfishServer[0].Endpoint.ValueString()
+	var endpoint = GetServerEndpoint(r.p, plan.RedfishServer)
 	var resource_name = "certificate_ca_cas_smtp"
 	mutexPool.Lock(ctx, endpoint, resource_name)
 	defer mutexPool.Unlock(ctx, endpoint, resource_name)
@@ -124,13 +124,18 @@ func (r *IrmcCertificateCaCasSmtpResource) Create(ctx context.Context, req resou
 	}
 	defer api.Logout()
 
-	isFsas, err := IsFsasCheck(ctx, api)
+	vendor, err := GetOemVendor(ctx, api)
 	if err != nil {
 		resp.Diagnostics.AddError("Vendor Detection Failed", err.Error())
 		return
 	}
 
-	certsEndp := getCertificateEndpoints(isFsas)
+	manager, err := GetManagerEndpoint(api.Service)
+	if err != nil {
+		resp.Diagnostics.AddError("Manager Resource Detection Failed", err.Error())
+		return
+	}
+	certsEndp := getCertificateEndpoints(vendor, manager)
 
 	err = caCertificateUpload(api, &plan, certsEndp.certificateCaCasCmtpEndpoint, certsEndp.certificateCaCasCmtpUploadEndpoint)
 	if err != nil {
@@ -210,18 +215,11 @@ func caCertificateUpload(api *gofish.APIClient, plan *models.CertificateCaCasSmt
 	return nil
 }
 
-func getCertificateEndpoints(isFsas bool) certificateEndpoints {
-	if isFsas {
-		return certificateEndpoints{
-			certificateCaCasCmtpEndpoint:       fmt.Sprintf("/redfish/v1/Managers/iRMC/Oem/%s/iRMCConfiguration/Certificates", FSAS),
-			certificateCaCasCmtpUploadEndpoint: fmt.Sprintf("/redfish/v1/Managers/iRMC/Oem/%s/iRMCConfiguration/Certificates/Actions/%sCertificates.UploadCACertificate", FSAS, FSAS),
-			certEndpoint:                       fmt.Sprintf("/redfish/v1/Managers/iRMC/Oem/%s/iRMCConfiguration/Certificates", FSAS),
-		}
-	} else {
-		return certificateEndpoints{
-			certificateCaCasCmtpEndpoint:       fmt.Sprintf("/redfish/v1/Managers/iRMC/Oem/%s/iRMCConfiguration/Certificates", TS_FUJITSU),
-			certificateCaCasCmtpUploadEndpoint: fmt.Sprintf("/redfish/v1/Managers/iRMC/Oem/%s/iRMCConfiguration/Certificates/Actions/%sCertificates.UploadCACertificate", TS_FUJITSU, FTS),
-			certEndpoint:                       fmt.Sprintf("/redfish/v1/Managers/iRMC/Oem/%s/iRMCConfiguration/Certificates", TS_FUJITSU),
-		}
+func getCertificateEndpoints(vendor *OemVendor, manager string) certificateEndpoints {
+	certificates := vendor.OemPath(manager, "iRMCConfiguration/Certificates")
+	return certificateEndpoints{
+		certificateCaCasCmtpEndpoint:       certificates,
+		certificateCaCasCmtpUploadEndpoint: vendor.OemResourceActionPath(certificates, "Certificates.UploadCACertificate"),
+		certEndpoint:                       certificates,
 	}
 }
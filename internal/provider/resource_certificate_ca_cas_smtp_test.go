@@ -19,9 +19,13 @@ package provider
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
 	"regexp"
+	"terraform-provider-irmc-redfish/internal/models"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -70,3 +74,24 @@ func testAccCertificateCaCasSmtpConfig(testingInfo TestingServerCredentials, cer
 		certificateCaFile,
 	)
 }
+
+func TestCaCertificateUpload(t *testing.T) {
+	forEachMockFlavor(t, func(t *testing.T, m *mockRedfishServer) {
+		api := m.connect()
+		endpoints := getCertificateEndpoints(m.vendor(), mockManagerEndpoint)
+
+		certFile := filepath.Join(t.TempDir(), "cert.pem")
+		if err := os.WriteFile(certFile, []byte("-----BEGIN CERTIFICATE-----"), 0o600); err != nil {
+			t.Fatalf("Could not create certificate file: %s", err.Error())
+		}
+
+		plan := models.CertificateCaCasSmtpResourceModel{CertificateCaFile: types.StringValue(certFile)}
+		if err := caCertificateUpload(api, &plan, endpoints.certificateCaCasCmtpEndpoint, endpoints.certificateCaCasCmtpUploadEndpoint); err != nil {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+
+		if plan.Id.ValueString() != endpoints.certificateCaCasCmtpEndpoint {
+			t.Errorf("Got id %s", plan.Id.ValueString())
+		}
+	})
+}
@@ -145,7 +145,7 @@ func (r *IrmcCertificateCaUpdDeployResource) Create(ctx context.Context, req res
 	}
 
 	// Provide synchronization
-	var endpoint = plan.RedfishServer[0].Endpoint.ValueString()
+	var endpoint = GetServerEndpoint(r.p, plan.RedfishServer)
 	var resource_name = "certificate_ca_upd_deploy"
 	mutexPool.Lock(ctx, endpoint, resource_name)
 	defer mutexPool.Unlock(ctx, endpoint, resource_name)
@@ -157,13 +157,18 @@ func (r *IrmcCertificateCaUpdDeployResource) Create(ctx context.Context, req res
 	}
 	defer api.Logout()
 
-	isFsas, err := IsFsasCheck(ctx, api)
+	vendor, err := GetOemVendor(ctx, api)
 	if err != nil {
 		resp.Diagnostics.AddError("Vendor Detection Failed", err.Error())
 		return
 	}
 
-	endp := getCertCaUpdDeployEndpoints(isFsas)
+	manager, err := GetManagerEndpoint(api.Service)
+	if err != nil {
+		resp.Diagnostics.AddError("Manager Resource Detection Failed", err.Error())
+		return
+	}
+	endp := getCertCaUpdDeployEndpoints(vendor, manager)
 
 	switch plan.CertificateUploadType.ValueString() {
 	case CERTIFICATE_UPLOAD_TYPE_FILE:
@@ -311,14 +316,8 @@ func handleTextCertificate(api *gofish.APIClient, plan *models.CertificateCaUpdD
 	return nil
 }
 
-func getCertCaUpdDeployEndpoints(isFsas bool) certCaUpdDeployEndpoints {
-	if isFsas {
-		return certCaUpdDeployEndpoints{
-			certificateEndpoint: fmt.Sprintf("/redfish/v1/Managers/iRMC/Oem/%s/iRMCConfiguration/CertificationAuthority", FSAS),
-		}
-	} else {
-		return certCaUpdDeployEndpoints{
-			certificateEndpoint: fmt.Sprintf("/redfish/v1/Managers/iRMC/Oem/%s/iRMCConfiguration/CertificationAuthority", TS_FUJITSU),
-		}
+func getCertCaUpdDeployEndpoints(vendor *OemVendor, manager string) certCaUpdDeployEndpoints {
+	return certCaUpdDeployEndpoints{
+		certificateEndpoint: vendor.OemPath(manager, "iRMCConfiguration/CertificationAuthority"),
 	}
 }
@@ -19,9 +19,14 @@ package provider
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
 	"regexp"
+	"strings"
+	"terraform-provider-irmc-redfish/internal/models"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -106,3 +111,34 @@ EOT
 		certificateText,
 	)
 }
+
+func TestCertificateCaUpdDeployHelpers(t *testing.T) {
+	forEachMockFlavor(t, func(t *testing.T, m *mockRedfishServer) {
+		api := m.connect()
+		endpoints := getCertCaUpdDeployEndpoints(m.vendor(), mockManagerEndpoint)
+
+		plan := models.CertificateCaUpdDeployResourceModel{CertificateText: types.StringValue("")}
+		if err := handleTextCertificate(api, &plan, endpoints.certificateEndpoint); err == nil {
+			t.Errorf("Expected error for empty certificate text")
+		}
+
+		plan.CertificateText = types.StringValue(CERT_TEXT)
+		if err := handleTextCertificate(api, &plan, endpoints.certificateEndpoint); err != nil {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+
+		if !strings.HasPrefix(plan.Id.ValueString(), "/redfish/v1/TaskService/Tasks/") {
+			t.Errorf("Got id %s, expected task location", plan.Id.ValueString())
+		}
+
+		certFile := filepath.Join(t.TempDir(), "cert.pem")
+		if err := os.WriteFile(certFile, []byte(CERT_TEXT), 0o600); err != nil {
+			t.Fatalf("Could not create certificate file: %s", err.Error())
+		}
+
+		plan.CertificateFile = types.StringValue(certFile)
+		if err := handleFileCertificate(api, &plan, endpoints.certificateEndpoint); err != nil {
+			t.Errorf("Unexpected error: %s", err.Error())
+		}
+	})
+}
@@ -121,7 +121,7 @@ func (r *IrmcCertificateWebServerResource) Create(ctx context.Context, req resou
 	}
 
 	// Provide synchronization
-	var endpoint = plan.RedfishServer[0].Endpoint.ValueString()
+	var endpoint = GetServerEndpoint(r.p, plan.RedfishServer)
 	var resource_name = "certificate_web_server"
 	mutexPool.Lock(ctx, endpoint, resource_name)
 	defer mutexPool.Unlock(ctx, endpoint, resource_name)
@@ -133,13 +133,18 @@ func (r *IrmcCertificateWebServerResource) Create(ctx context.Context, req resou
 	}
 	defer api.Logout()
 
-	isFsas, err := IsFsasCheck(ctx, api)
+	vendor, err := GetOemVendor(ctx, api)
 	if err != nil {
 		resp.Diagnostics.AddError("Vendor Detection Failed", err.Error())
 		return
 	}
 
-	certWebServerEndp := getCertificateWebServerEndpoints(isFsas)
+	manager, err := GetManagerEndpoint(api.Service)
+	if err != nil {
+		resp.Diagnostics.AddError("Manager Resource Detection Failed", err.Error())
+		return
+	}
+	certWebServerEndp := getCertificateWebServerEndpoints(vendor, manager)
 
 	err = sendCertificateUpdate(api, plan.CertPublicKey.ValueString(), certWebServerEndp.uploadCertEndpoint)
 	if err != nil {
@@ -265,18 +270,11 @@ func verifyCertificateCompliance(api *gofish.APIClient, verifyCertEndpoint strin
 	return nil
 }
 
-func getCertificateWebServerEndpoints(isFsas bool) certificateWebServerEndpoints {
-	if isFsas {
-		return certificateWebServerEndpoints{
-			certEndpoint:       fmt.Sprintf("/redfish/v1/Managers/iRMC/Oem/%s/iRMCConfiguration/Certificates", FSAS),
-			uploadCertEndpoint: fmt.Sprintf("/redfish/v1/Managers/iRMC/Oem/%s/iRMCConfiguration/Certificates/Actions/%sCertificates.UploadSSLCertOrKey", FSAS, FSAS),
-			verifyCertEndpoint: fmt.Sprintf("/redfish/v1/Managers/iRMC/Oem/%s/iRMCConfiguration/Certificates/Actions/%sCertificates.VerifySSLCertKeyCompliance", FSAS, FSAS),
-		}
-	} else {
-		return certificateWebServerEndpoints{
-			certEndpoint:       fmt.Sprintf("/redfish/v1/Managers/iRMC/Oem/%s/iRMCConfiguration/Certificates", TS_FUJITSU),
-			uploadCertEndpoint: fmt.Sprintf("/redfish/v1/Managers/iRMC/Oem/%s/iRMCConfiguration/Certificates/Actions/%sCertificates.UploadSSLCertOrKey", TS_FUJITSU, FTS),
-			verifyCertEndpoint: fmt.Sprintf("/redfish/v1/Managers/iRMC/Oem/%s/iRMCConfiguration/Certificates/Actions/%sCertificates.VerifySSLCertKeyCompliance", TS_FUJITSU, FTS),
-		}
+func getCertificateWebServerEndpoints(vendor *OemVendor, manager string) certificateWebServerEndpoints {
+	certificates := vendor.OemPath(manager, "iRMCConfiguration/Certificates")
+	return certificateWebServerEndpoints{
+		certEndpoint:       certificates,
+		uploadCertEndpoint: vendor.OemResourceActionPath(certificates, "Certificates.UploadSSLCertOrKey"),
+		verifyCertEndpoint: vendor.OemResourceActionPath(certificates, "Certificates.VerifySSLCertKeyCompliance"),
 	}
 }
@@ -19,6 +19,9 @@ package provider
 
 import (
 	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
 	"regexp"
 	"testing"
 
@@ -85,3 +88,35 @@ func testAccCertificateWebServerConfig(testingInfo TestingServerCredentials, cer
 		certificateTextPrivateKey,
 	)
 }
+
+func TestCertificateWebServerHelpers(t *testing.T) {
+	forEachMockFlavor(t, func(t *testing.T, m *mockRedfishServer) {
+		api := m.connect()
+		endpoints := getCertificateWebServerEndpoints(m.vendor(), mockManagerEndpoint)
+
+		if err := sendCertificateUpdate(api, filepath.Join(t.TempDir(), "missing.pem"), endpoints.uploadCertEndpoint); err == nil {
+			t.Errorf("Expected error for missing certificate file")
+		}
+
+		certFile := filepath.Join(t.TempDir(), "cert.pem")
+		if err := os.WriteFile(certFile, []byte("-----BEGIN CERTIFICATE-----"), 0o600); err != nil {
+			t.Fatalf("Could not create certificate file: %s", err.Error())
+		}
+
+		if err := sendCertificateUpdate(api, certFile, endpoints.uploadCertEndpoint); err != nil {
+			t.Errorf("Unexpected error: %s", err.Error())
+		}
+
+		if err := verifyCertificateCompliance(api, endpoints.verifyCertEndpoint); err != nil {
+			t.Errorf("Unexpected error: %s", err.Error())
+		}
+
+		m.handle(endpoints.verifyCertEndpoint, func(m *mockRedfishServer, req mockRequest) mockResponse {
+			return mockResponse{Status: http.StatusOK, Body: map[string]interface{}{"Compliant": false}}
+		})
+
+		if err := verifyCertificateCompliance(api, endpoints.verifyCertEndpoint); err == nil {
+			t.Errorf("Expected error for non-compliant certificate")
+		}
+	})
+}
@@ -0,0 +1,557 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+
+	"terraform-provider-irmc-redfish/internal/models"
+	"terraform-provider-irmc-redfish/internal/validators"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	tkpath "github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/stmcginnis/gofish"
+)
+
+const (
+	EMAIL_ALERTING_OEM_PATH = "iRMCConfiguration/Alerting/Email"
+
+	SMTP_CONNECTION_SECURITY_NONE = "None"
+)
+
+var emailAddressRegex = regexp.MustCompile(`^[^@\s]+@[^@\s]+\.[^@\s]+$`)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &EmailAlertingResource{}
+var _ resource.ResourceWithImportState = &EmailAlertingResource{}
+var _ resource.ResourceWithValidateConfig = &EmailAlertingResource{}
+
+func NewEmailAlertingResource() resource.Resource {
+	return &EmailAlertingResource{}
+}
+
+// EmailAlertingResource defines the resource implementation.
+type EmailAlertingResource struct {
+	p *IrmcProvider
+}
+
+func (r *EmailAlertingResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + emailAlertingName
+}
+
+func EmailAlertingSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "ID of e-mail alerting settings resource on iRMC.",
+			Description:         "ID of e-mail alerting settings resource on iRMC.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"enabled": schema.BoolAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Indicates whether alert e-mails are sent. If not set, current value is kept.",
+			Description:         "Indicates whether alert e-mails are sent. If not set, current value is kept.",
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"smtp_server": schema.StringAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "IP address or host name of SMTP server. If not set, current value is kept.",
+			Description:         "IP address or host name of SMTP server. If not set, current value is kept.",
+			Validators: []validator.String{
+				validators.IsHostAddress(),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"smtp_port": schema.Int64Attribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Port of SMTP server. If not set, current value is kept.",
+			Description:         "Port of SMTP server. If not set, current value is kept.",
+			Validators: []validator.Int64{
+				int64validator.Between(1, 65535),
+			},
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"connection_security": schema.StringAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Security of connection to SMTP server: 'None', 'StartTLS' or 'SSL'. Certificate of SMTP server can be verified with CA certificate uploaded by irmc-redfish_certificate_ca_cas_smtp. If not set, current value is kept.",
+			Description:         "Security of connection to SMTP server: 'None', 'StartTLS' or 'SSL'. Certificate of SMTP server can be verified with CA certificate uploaded by irmc-redfish_certificate_ca_cas_smtp. If not set, current value is kept.",
+			Validators: []validator.String{
+				stringvalidator.OneOf([]string{
+					SMTP_CONNECTION_SECURITY_NONE,
+					"StartTLS",
+					"SSL",
+				}...),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"smtp_username": schema.StringAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "User name used to authenticate on SMTP server. Empty value disables authentication. If not set, current value is kept.",
+			Description:         "User name used to authenticate on SMTP server. Empty value disables authentication. If not set, current value is kept.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"smtp_password": schema.StringAttribute{
+			Optional:            true,
+			Sensitive:           true,
+			WriteOnly:           true,
+			MarkdownDescription: "Password used to authenticate on SMTP server. It's never stored in state and is sent to iRMC only when the resource is created or `smtp_password_version` changes.",
+			Description:         "Password used to authenticate on SMTP server. It's never stored in state and is sent to iRMC only when the resource is created or smtp_password_version changes.",
+		},
+		"smtp_password_version": schema.Int64Attribute{
+			Optional:            true,
+			MarkdownDescription: "Version of SMTP password. Since password is write-only, change of the value (e.g. after rotation) is the only way to send it to iRMC again.",
+			Description:         "Version of SMTP password. Since password is write-only, change of the value (e.g. after rotation) is the only way to send it to iRMC again.",
+		},
+		"sender": schema.StringAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "E-mail address alerts are sent from. If not set, current value is kept.",
+			Description:         "E-mail address alerts are sent from. If not set, current value is kept.",
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(emailAddressRegex, "must be e-mail address"),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"recipients": schema.ListNestedAttribute{
+			Optional:            true,
+			MarkdownDescription: "Recipients of alert e-mails. If not set, recipients are not managed.",
+			Description:         "Recipients of alert e-mails. If not set, recipients are not managed.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"address": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "E-mail address of recipient.",
+						Description:         "E-mail address of recipient.",
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(emailAddressRegex, "must be e-mail address"),
+						},
+					},
+					"minimum_severity": alertSeveritySchema(),
+				},
+			},
+		},
+		"send_test_mail": schema.BoolAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "If set to true, test e-mail is sent to all recipients every time settings have been applied, to verify e-mails are delivered.",
+			Description:         "If set to true, test e-mail is sent to all recipients every time settings have been applied, to verify e-mails are delivered.",
+			Default:             booldefault.StaticBool(false),
+		},
+	}
+}
+
+func (r *EmailAlertingResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The resource is used to control (read, modify or import) alerting by e-mail (SMTP server, sender, recipients and their severity filters) of iRMC on Fujitsu server equipped with iRMC controller. SMTP password is write-only, so Terraform 1.11 or later is required.",
+		Description:         "The resource is used to control (read, modify or import) alerting by e-mail (SMTP server, sender, recipients and their severity filters) of iRMC on Fujitsu server equipped with iRMC controller. SMTP password is write-only, so Terraform 1.11 or later is required.",
+		Attributes:          EmailAlertingSchema(),
+		Blocks:              RedfishServerResourceBlockMap(),
+	}
+}
+
+func (r *EmailAlertingResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	p, ok := req.ProviderData.(*IrmcProvider)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *IrmcProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.p = p
+}
+
+func (r *EmailAlertingResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config models.EmailAlertingResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateEmailAlertingConfig(config)...)
+}
+
+func (r *EmailAlertingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "resource-email_alerting: create starts")
+
+	// Read Terraform plan data into the model, write-only SMTP password is available only in config
+	var plan, config models.EmailAlertingResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan, &config, nil)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	tflog.Info(ctx, "resource-email_alerting: create ends")
+}
+
+func (r *EmailAlertingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "resource-email_alerting: read starts")
+
+	// Read Terraform prior state data into the model
+	var state models.EmailAlertingResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, err := ConnectTargetSystem(r.p, &state.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError("service error: ", err.Error())
+		return
+	}
+
+	defer api.Logout()
+
+	endpoint, err := getAlertingEndpoint(ctx, api, EMAIL_ALERTING_OEM_PATH)
+	if err != nil {
+		resp.Diagnostics.AddError("Manager Resource Detection Failed", err.Error())
+		return
+	}
+
+	// Just imported resource does not have recipients in state yet, so they are read as well
+	if err = readEmailAlertingSettingsToModel(api, &state, endpoint, state.Id.IsNull()); err != nil {
+		resp.Diagnostics.AddError("Error while reading e-mail alerting settings", err.Error())
+		return
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	tflog.Info(ctx, "resource-email_alerting: read ends")
+}
+
+func (r *EmailAlertingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "resource-email_alerting: update starts")
+
+	// Read Terraform plan, config and state data into the models, write-only SMTP password is available only in config
+	var plan, config, state models.EmailAlertingResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan, &config, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	tflog.Info(ctx, "resource-email_alerting: update ends")
+}
+
+func (r *EmailAlertingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "resource-email_alerting: delete starts")
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "resource-email_alerting: delete ends")
+}
+
+func (r *EmailAlertingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Info(ctx, "resource-email_alerting: import starts")
+
+	var config CommonImportConfig
+	err := json.Unmarshal([]byte(req.ID), &config)
+	if err != nil {
+		resp.Diagnostics.AddError("Error while unmarshalling import config", err.Error())
+		return
+	}
+
+	creds := importServerBlock(config.ServerConfig)
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, tkpath.Root("server"), creds)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, tkpath.Root("send_test_mail"), false)...)
+
+	tflog.Info(ctx, "resource-email_alerting: import ends")
+}
+
+// apply configures e-mail alerting requested by plan and reads settings back into plan. Write-only SMTP password
+// is taken from config. State is nil when resource is created, so configured SMTP password is always sent to iRMC.
+// If requested, test e-mail is sent once settings have been applied.
+func (r *EmailAlertingResource) apply(ctx context.Context, plan *models.EmailAlertingResourceModel, config *models.EmailAlertingResourceModel,
+	state *models.EmailAlertingResourceModel) (diags diag.Diagnostics) {
+	// Provide synchronization
+	var endpoint = GetServerEndpoint(r.p, plan.RedfishServer)
+	var resource_name = "resource-email_alerting"
+	mutexPool.Lock(ctx, endpoint, resource_name)
+	defer mutexPool.Unlock(ctx, endpoint, resource_name)
+
+	// Connect to service
+	api, err := ConnectTargetSystem(r.p, &plan.RedfishServer)
+	if err != nil {
+		diags.AddError("service error: ", err.Error())
+		return diags
+	}
+
+	defer api.Logout()
+
+	vendor, err := GetOemVendor(ctx, api)
+	if err != nil {
+		diags.AddError("Vendor Detection Failed", err.Error())
+		return diags
+	}
+	manager, err := GetManagerEndpoint(api.Service)
+	if err != nil {
+		diags.AddError("Manager Resource Detection Failed", err.Error())
+		return diags
+	}
+	emailEndpoint := vendor.OemPath(manager, EMAIL_ALERTING_OEM_PATH)
+
+	if err = applyEmailAlertingSettings(ctx, api, plan, config, state, emailEndpoint); err != nil {
+		diags.AddError("Error while applying e-mail alerting settings", err.Error())
+		return diags
+	}
+
+	if err = readEmailAlertingSettingsToModel(api, plan, emailEndpoint, false); err != nil {
+		diags.AddError("Error while reading e-mail alerting settings", err.Error())
+		return diags
+	}
+
+	if plan.SendTestMail.ValueBool() {
+		if err = sendTestMail(ctx, api, vendor.OemResourceActionPath(emailEndpoint, "EmailAlerting.SendTestMail")); err != nil {
+			diags.AddWarning("Test e-mail could not be sent",
+				fmt.Sprintf("Settings have been applied, but test e-mail was rejected: %s", err.Error()))
+		}
+	}
+
+	return diags
+}
+
+type smtpServerSettings struct {
+	Address            string `json:"Address"`
+	Port               int64  `json:"Port"`
+	ConnectionSecurity string `json:"ConnectionSecurity"`
+	UserName           string `json:"UserName"`
+}
+
+type emailRecipientSettings struct {
+	Address         string `json:"Address"`
+	MinimumSeverity string `json:"MinimumSeverity"`
+}
+
+type emailAlertingSettings struct {
+	Enabled    bool                     `json:"Enabled"`
+	SmtpServer smtpServerSettings       `json:"SmtpServer"`
+	Sender     string                   `json:"Sender"`
+	Recipients []emailRecipientSettings `json:"Recipients"`
+}
+
+// validateEmailAlertingConfig verifies at plan time that settings needed to deliver e-mails are not missing.
+func validateEmailAlertingConfig(config models.EmailAlertingResourceModel) (diags diag.Diagnostics) {
+	const summary = "Invalid e-mail alerting configuration"
+
+	if !config.SmtpPassword.IsNull() && !config.SmtpUsername.IsUnknown() && config.SmtpUsername.ValueString() == "" {
+		diags.AddAttributeError(tkpath.Root("smtp_password"), summary,
+			"SMTP password requires SMTP user name")
+	}
+
+	if isBoolConfigured(config.SendTestMail, true) {
+		if isBoolConfigured(config.Enabled, false) {
+			diags.AddAttributeError(tkpath.Root("send_test_mail"), summary,
+				"Test e-mail cannot be sent while e-mail alerting is disabled")
+		}
+		if config.Recipients != nil && len(config.Recipients) == 0 {
+			diags.AddAttributeError(tkpath.Root("send_test_mail"), summary,
+				"Test e-mail requires at least one recipient")
+		}
+	}
+
+	recipients := map[string]bool{}
+	for i, recipient := range config.Recipients {
+		if recipient.Address.IsUnknown() {
+			continue
+		}
+		if recipients[recipient.Address.ValueString()] {
+			diags.AddAttributeError(tkpath.Root("recipients").AtListIndex(i).AtName("address"), summary,
+				fmt.Sprintf("Recipient %s is defined more than once", recipient.Address.ValueString()))
+		}
+		recipients[recipient.Address.ValueString()] = true
+	}
+
+	return diags
+}
+
+// applyEmailAlertingSettings sends to iRMC only these settings from plan, which are known and differ from current ones.
+// SMTP password is write-only and not reported by iRMC, so it's sent (taken from config) only on create or if its version
+// differs from state.
+func applyEmailAlertingSettings(ctx context.Context, api *gofish.APIClient, plan *models.EmailAlertingResourceModel,
+	config *models.EmailAlertingResourceModel, state *models.EmailAlertingResourceModel, endpoint string) error {
+	var current emailAlertingSettings
+	etag, err := getRedfishResource(api, endpoint, &current)
+	if err != nil {
+		return err
+	}
+
+	smtp := map[string]interface{}{}
+	if !plan.SmtpServer.IsNull() && !plan.SmtpServer.IsUnknown() && plan.SmtpServer.ValueString() != current.SmtpServer.Address {
+		smtp["Address"] = plan.SmtpServer.ValueString()
+	}
+	if !plan.SmtpPort.IsNull() && !plan.SmtpPort.IsUnknown() && plan.SmtpPort.ValueInt64() != current.SmtpServer.Port {
+		smtp["Port"] = plan.SmtpPort.ValueInt64()
+	}
+	if !plan.ConnectionSecurity.IsNull() && !plan.ConnectionSecurity.IsUnknown() && plan.ConnectionSecurity.ValueString() != current.SmtpServer.ConnectionSecurity {
+		smtp["ConnectionSecurity"] = plan.ConnectionSecurity.ValueString()
+	}
+	if !plan.SmtpUsername.IsNull() && !plan.SmtpUsername.IsUnknown() && plan.SmtpUsername.ValueString() != current.SmtpServer.UserName {
+		smtp["UserName"] = plan.SmtpUsername.ValueString()
+	}
+	if !config.SmtpPassword.IsNull() && (state == nil || !plan.SmtpPasswordVersion.Equal(state.SmtpPasswordVersion)) {
+		smtp["Password"] = config.SmtpPassword.ValueString()
+	}
+
+	settings := map[string]interface{}{}
+	if len(smtp) > 0 {
+		settings["SmtpServer"] = smtp
+	}
+	if !plan.Sender.IsNull() && !plan.Sender.IsUnknown() && plan.Sender.ValueString() != current.Sender {
+		settings["Sender"] = plan.Sender.ValueString()
+	}
+
+	if plan.Recipients != nil {
+		recipients := []emailRecipientSettings{}
+		for _, recipient := range plan.Recipients {
+			recipients = append(recipients, emailRecipientSettings{
+				Address:         recipient.Address.ValueString(),
+				MinimumSeverity: recipient.MinimumSeverity.ValueString(),
+			})
+		}
+
+		if !slices.Equal(recipients, current.Recipients) {
+			settings["Recipients"] = recipients
+		}
+	}
+
+	if !plan.Enabled.IsNull() && !plan.Enabled.IsUnknown() && plan.Enabled.ValueBool() != current.Enabled {
+		settings["Enabled"] = plan.Enabled.ValueBool()
+	}
+
+	if len(settings) > 0 {
+		tflog.Info(ctx, "Changing e-mail alerting settings", map[string]interface{}{
+			"smtp_server_changed": len(smtp) > 0,
+			"recipients_changed":  settings["Recipients"] != nil,
+		})
+		if err = patchRedfishResource(api, endpoint, etag, settings); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// readEmailAlertingSettingsToModel reads current e-mail alerting settings of iRMC into model, so changes done
+// outside of Terraform are detected. Recipients are read only if they are managed (not null in model), unless all
+// is set. SMTP password is write-only, so it's never kept in model, while its version is kept as it is.
+func readEmailAlertingSettingsToModel(api *gofish.APIClient, model *models.EmailAlertingResourceModel, endpoint string, all bool) error {
+	var settings emailAlertingSettings
+	if _, err := getRedfishResource(api, endpoint, &settings); err != nil {
+		return err
+	}
+
+	model.Id = types.StringValue(endpoint)
+	model.Enabled = types.BoolValue(settings.Enabled)
+	model.SmtpServer = types.StringValue(settings.SmtpServer.Address)
+	model.SmtpPort = types.Int64Value(settings.SmtpServer.Port)
+	model.ConnectionSecurity = types.StringValue(settings.SmtpServer.ConnectionSecurity)
+	model.SmtpUsername = types.StringValue(settings.SmtpServer.UserName)
+	model.SmtpPassword = types.StringNull()
+	model.Sender = types.StringValue(settings.Sender)
+
+	if model.Recipients != nil || all {
+		recipients := []models.EmailRecipient{}
+		for _, recipient := range settings.Recipients {
+			recipients = append(recipients, models.EmailRecipient{
+				Address:         types.StringValue(recipient.Address),
+				MinimumSeverity: types.StringValue(recipient.MinimumSeverity),
+			})
+		}
+		model.Recipients = recipients
+	}
+
+	return nil
+}
+
+// sendTestMail asks iRMC to send test e-mail to all configured recipients.
+func sendTestMail(ctx context.Context, api *gofish.APIClient, target string) error {
+	tflog.Info(ctx, "Sending test e-mail", map[string]interface{}{
+		"target": target,
+	})
+
+	res, err := api.Post(target, map[string]interface{}{})
+	if err != nil {
+		return err
+	}
+
+	CloseResource(res.Body)
+	return nil
+}
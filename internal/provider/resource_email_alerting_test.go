@@ -0,0 +1,281 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+const resource_email_alerting_name = "irmc-redfish_email_alerting.email"
+
+func TestAccRedfishEmailAlerting_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishResourceEmailAlertingConfig(creds, "Minor"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resource_email_alerting_name, "enabled", "true"),
+					resource.TestCheckResourceAttr(resource_email_alerting_name, "smtp_server", "192.0.2.25"),
+					resource.TestCheckResourceAttr(resource_email_alerting_name, "recipients.#", "1"),
+				),
+			},
+			{
+				Config: testAccRedfishResourceEmailAlertingConfig(creds, "Critical"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resource_email_alerting_name, "recipients.0.minimum_severity", "Critical"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRedfishEmailAlerting_import(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:       `resource "irmc-redfish_email_alerting" "email" {}`,
+				ResourceName: resource_email_alerting_name,
+				ImportState:  true,
+				ImportStateIdFunc: func(d *terraform.State) (string, error) {
+					return fmt.Sprintf("{\"username\":\"%s\", \"password\":\"%s\", \"endpoint\":\"https://%s\", \"ssl_insecure\":true}",
+						creds.Username, creds.Password, creds.Endpoint), nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccRedfishEmailAlerting_negative(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				resource "irmc-redfish_email_alerting" "email" {
+					server {
+					  username     = "%s"
+					  password     = "%s"
+					  endpoint     = "https://%s"
+					  ssl_insecure = true
+					}
+
+					enabled        = false
+					send_test_mail = true
+				}
+				`, creds.Username, creds.Password, creds.Endpoint),
+				ExpectError: regexp.MustCompile("Test e-mail cannot be sent while e-mail alerting is disabled"),
+			},
+		},
+	})
+}
+
+func testAccRedfishResourceEmailAlertingConfig(testingInfo TestingServerCredentials, severity string) string {
+	return fmt.Sprintf(`
+	resource "irmc-redfish_email_alerting" "email" {
+
+		server {
+		  username     = "%s"
+		  password     = "%s"
+		  endpoint     = "https://%s"
+		  ssl_insecure = true
+		}
+
+		enabled             = true
+		smtp_server         = "192.0.2.25"
+		smtp_port           = 587
+		connection_security = "StartTLS"
+		sender              = "irmc@example.com"
+		recipients = [{
+		  address          = "operations@example.com"
+		  minimum_severity = "%s"
+		}]
+		send_test_mail = true
+	  }
+	`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		severity,
+	)
+}
+
+func TestApplyEmailAlertingSettings(t *testing.T) {
+	forEachMockFlavor(t, func(t *testing.T, m *mockRedfishServer) {
+		api := m.connect()
+		ctx := context.Background()
+		endpoint, err := getAlertingEndpoint(ctx, api, EMAIL_ALERTING_OEM_PATH)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+
+		config := models.EmailAlertingResourceModel{
+			Enabled:             types.BoolValue(true),
+			SmtpServer:          types.StringValue("smtp.example.com"),
+			SmtpPort:            types.Int64Value(587),
+			ConnectionSecurity:  types.StringUnknown(),
+			SmtpUsername:        types.StringValue("irmc"),
+			SmtpPassword:        types.StringValue("secret"),
+			SmtpPasswordVersion: types.Int64Value(1),
+			Sender:              types.StringValue("irmc@example.com"),
+			Recipients: []models.EmailRecipient{{
+				Address:         types.StringValue("operations@example.com"),
+				MinimumSeverity: types.StringValue("Major"),
+			}},
+		}
+		// Write-only password is never part of plan
+		plan := config
+		plan.SmtpPassword = types.StringNull()
+
+		if err = applyEmailAlertingSettings(ctx, api, &plan, &config, nil, endpoint); err != nil {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+
+		settings := m.get(endpoint)
+		smtp, _ := settings["SmtpServer"].(map[string]interface{})
+		recipients, _ := settings["Recipients"].([]interface{})
+		if settings["Enabled"] != true || settings["Sender"] != "irmc@example.com" || len(recipients) != 1 {
+			t.Errorf("Unexpected e-mail alerting settings %v", settings)
+		}
+		if smtp["Address"] != "smtp.example.com" || smtp["Password"] != "secret" || smtp["ConnectionSecurity"] != SMTP_CONNECTION_SECURITY_NONE {
+			t.Errorf("Unexpected SMTP server settings %v", smtp)
+		}
+
+		// Password is not sent again without change of its version, since it cannot be compared with iRMC
+		state := plan
+		patches := len(m.requestsTo(http.MethodPatch, endpoint))
+		config.SmtpPassword = types.StringValue("changed")
+		if err = applyEmailAlertingSettings(ctx, api, &plan, &config, &state, endpoint); err != nil {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+		if count := len(m.requestsTo(http.MethodPatch, endpoint)); count != patches {
+			t.Errorf("Got %d PATCH requests for unchanged settings, expected none", count-patches)
+		}
+
+		plan.SmtpPasswordVersion = types.Int64Value(2)
+		if err = applyEmailAlertingSettings(ctx, api, &plan, &config, &state, endpoint); err != nil {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+		requests := m.requestsTo(http.MethodPatch, endpoint)
+		if body := requests[len(requests)-1].Body; fmt.Sprint(body) != "map[SmtpServer:map[Password:changed]]" {
+			t.Errorf("Only password of changed version must be sent, got %v", body)
+		}
+	})
+}
+
+func TestReadEmailAlertingSettingsToModel(t *testing.T) {
+	m := newMockRedfishServer(t, FSAS)
+	api := m.connect()
+	endpoint, _ := getAlertingEndpoint(context.Background(), api, EMAIL_ALERTING_OEM_PATH)
+
+	// Change done outside of Terraform must be visible in state
+	m.update(endpoint, map[string]interface{}{
+		"Sender":     "bmc@example.com",
+		"SmtpServer": map[string]interface{}{"Address": "192.0.2.26", "UserName": "mailer"},
+		"Recipients": []interface{}{map[string]interface{}{"Address": "admin@example.com", "MinimumSeverity": "Critical"}},
+	})
+
+	model := models.EmailAlertingResourceModel{
+		SmtpPasswordVersion: types.Int64Value(3),
+		Recipients:          []models.EmailRecipient{},
+	}
+	if err := readEmailAlertingSettingsToModel(api, &model, endpoint, false); err != nil {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+
+	if model.Sender.ValueString() != "bmc@example.com" || model.SmtpServer.ValueString() != "192.0.2.26" || model.SmtpPort.ValueInt64() != 25 {
+		t.Errorf("Unexpected model %v", model)
+	}
+	if model.SmtpUsername.ValueString() != "mailer" || !model.SmtpPassword.IsNull() || model.SmtpPasswordVersion.ValueInt64() != 3 {
+		t.Errorf("Unexpected SMTP credentials in model %v", model)
+	}
+	if len(model.Recipients) != 1 || model.Recipients[0].MinimumSeverity.ValueString() != "Critical" {
+		t.Errorf("Unexpected recipients %v", model.Recipients)
+	}
+	// Recipients not managed by Terraform stay unmanaged, unless resource is imported
+	model = models.EmailAlertingResourceModel{}
+	if err := readEmailAlertingSettingsToModel(api, &model, endpoint, false); err != nil {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+	if model.Recipients != nil {
+		t.Errorf("Unmanaged recipients must stay null, got %v", model.Recipients)
+	}
+
+	if err := readEmailAlertingSettingsToModel(api, &model, endpoint, true); err != nil {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+	if len(model.Recipients) != 1 || model.Recipients[0].Address.ValueString() != "admin@example.com" {
+		t.Errorf("Unexpected recipients after import %v", model.Recipients)
+	}
+}
+
+func TestSendTestMail(t *testing.T) {
+	forEachMockFlavor(t, func(t *testing.T, m *mockRedfishServer) {
+		api := m.connect()
+		endpoint, _ := getAlertingEndpoint(context.Background(), api, EMAIL_ALERTING_OEM_PATH)
+		target := m.vendor().OemResourceActionPath(endpoint, "EmailAlerting.SendTestMail")
+
+		if err := sendTestMail(context.Background(), api, target); err != nil {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+		if requests := m.requestsTo(http.MethodPost, target); len(requests) != 1 {
+			t.Errorf("Got %d test e-mail requests, expected 1", len(requests))
+		}
+
+		m.failNext(http.MethodPost, target, mockResponse{Status: http.StatusBadRequest})
+		if err := sendTestMail(context.Background(), api, target); err == nil {
+			t.Errorf("Expected error when test e-mail is rejected")
+		}
+	})
+}
+
+func TestValidateEmailAlertingConfig(t *testing.T) {
+	config := models.EmailAlertingResourceModel{
+		Enabled:      types.BoolValue(false),
+		SmtpUsername: types.StringNull(),
+		SmtpPassword: types.StringValue("secret"),
+		SendTestMail: types.BoolValue(true),
+		Recipients: []models.EmailRecipient{
+			{Address: types.StringValue("admin@example.com")},
+			{Address: types.StringValue("admin@example.com")},
+		},
+	}
+
+	diags := validateEmailAlertingConfig(config)
+	if diags.ErrorsCount() != 3 {
+		t.Errorf("Expected errors for password, test e-mail and duplicated recipient, got %v", diags)
+	}
+
+	config.Enabled = types.BoolUnknown()
+	config.SmtpUsername = types.StringValue("mailer")
+	config.Recipients = config.Recipients[:1]
+	if diags = validateEmailAlertingConfig(config); diags.HasError() {
+		t.Errorf("Unexpected errors %v", diags)
+	}
+}
@@ -0,0 +1,606 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"time"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	tkpath "github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stmcginnis/gofish"
+	"github.com/stmcginnis/gofish/common"
+)
+
+const (
+	EVENT_SUBSCRIPTIONS_ENDPOINT = "/redfish/v1/EventService/Subscriptions"
+
+	EVENT_DESTINATION_PROTOCOL_REDFISH = "Redfish"
+
+	TEST_EVENT_MESSAGE_ID = "Base.1.0.Success"
+	TEST_EVENT_MESSAGE    = "Test event sent by terraform-provider-irmc-redfish"
+)
+
+var destinationUrlRegex = regexp.MustCompile(`^https?://[^\s/]+`)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &EventSubscriptionResource{}
+var _ resource.ResourceWithImportState = &EventSubscriptionResource{}
+
+func NewEventSubscriptionResource() resource.Resource {
+	return &EventSubscriptionResource{}
+}
+
+// EventSubscriptionResource defines the resource implementation.
+type EventSubscriptionResource struct {
+	p *IrmcProvider
+}
+
+func (r *EventSubscriptionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + eventSubscriptionName
+}
+
+// eventSubscriptionFilter returns schema of list attribute used to filter events sent to subscriber.
+// Filters cannot be changed on existing subscription, so the subscription is recreated.
+func eventSubscriptionFilter(description string, validators ...validator.String) schema.ListAttribute {
+	return schema.ListAttribute{
+		Optional:            true,
+		Computed:            true,
+		ElementType:         types.StringType,
+		MarkdownDescription: description,
+		Description:         description,
+		Validators: []validator.List{
+			listvalidator.UniqueValues(),
+			listvalidator.ValueStringsAre(validators...),
+		},
+		PlanModifiers: []planmodifier.List{
+			listplanmodifier.UseStateForUnknown(),
+			listplanmodifier.RequiresReplace(),
+		},
+	}
+}
+
+func EventSubscriptionSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "ID of event subscription resource on iRMC.",
+			Description:         "ID of event subscription resource on iRMC.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"destination": schema.StringAttribute{
+			Required:            true,
+			MarkdownDescription: "URL of event collector the events are sent to (e.g. 'https://collector.example.com:8443/events'). Change of destination recreates the subscription.",
+			Description:         "URL of event collector the events are sent to (e.g. 'https://collector.example.com:8443/events'). Change of destination recreates the subscription.",
+			Validators: []validator.String{
+				stringvalidator.RegexMatches(destinationUrlRegex, "must be http or https URL"),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"protocol": schema.StringAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Protocol used to send events to destination. Default value is 'Redfish'. Change of protocol recreates the subscription.",
+			Description:         "Protocol used to send events to destination. Default value is 'Redfish'. Change of protocol recreates the subscription.",
+			Default:             stringdefault.StaticString(EVENT_DESTINATION_PROTOCOL_REDFISH),
+			Validators: []validator.String{
+				stringvalidator.OneOf([]string{
+					EVENT_DESTINATION_PROTOCOL_REDFISH,
+				}...),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"context": schema.StringAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Client supplied string sent with every event to destination.",
+			Description:         "Client supplied string sent with every event to destination.",
+			Validators: []validator.String{
+				stringvalidator.LengthAtMost(256),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"event_types": eventSubscriptionFilter(
+			"Types of events sent to destination (e.g. 'Alert', 'StatusChange'). If not set, events of all types are sent.",
+			stringvalidator.OneOf([]string{
+				"Alert",
+				"StatusChange",
+				"ResourceAdded",
+				"ResourceRemoved",
+				"ResourceUpdated",
+				"MetricReport",
+				"Other",
+			}...),
+		),
+		"registry_prefixes": eventSubscriptionFilter(
+			"Prefixes of message registries (e.g. 'Base', 'iRMC') which messages are sent to destination. If not set, messages of all registries are sent.",
+			stringvalidator.LengthAtLeast(1),
+		),
+		"resource_types": eventSubscriptionFilter(
+			"Types of resources (e.g. 'Systems', 'Chassis') which events are sent to destination. If not set, events of all resources are sent.",
+			stringvalidator.LengthAtLeast(1),
+		),
+		"message_ids": eventSubscriptionFilter(
+			"IDs of messages (e.g. 'Base.1.0.Success') sent to destination. If not set, all messages are sent.",
+			stringvalidator.LengthAtLeast(1),
+		),
+		"http_headers": schema.MapAttribute{
+			Optional:            true,
+			Sensitive:           true,
+			ElementType:         types.StringType,
+			MarkdownDescription: "HTTP headers (e.g. authorization token) included in every event sent to destination. iRMC does not report headers back, so changes made outside of Terraform are not detected.",
+			Description:         "HTTP headers (e.g. authorization token) included in every event sent to destination. iRMC does not report headers back, so changes made outside of Terraform are not detected.",
+		},
+		"send_test_event": schema.BoolAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "If set to true, test event is submitted to event service after subscription has been created, to verify events reach destination. Test event is sent to all subscribers matching it.",
+			Description:         "If set to true, test event is submitted to event service after subscription has been created, to verify events reach destination. Test event is sent to all subscribers matching it.",
+			Default:             booldefault.StaticBool(false),
+		},
+	}
+}
+
+func (r *EventSubscriptionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The resource is used to control (create, read, modify, delete or import) event subscriptions of Redfish event service on Fujitsu server equipped with iRMC controller.",
+		Description:         "The resource is used to control (create, read, modify, delete or import) event subscriptions of Redfish event service on Fujitsu server equipped with iRMC controller.",
+		Attributes:          EventSubscriptionSchema(),
+		Blocks:              RedfishServerResourceBlockMap(),
+	}
+}
+
+func (r *EventSubscriptionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	p, ok := req.ProviderData.(*IrmcProvider)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *IrmcProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.p = p
+}
+
+func (r *EventSubscriptionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "resource-event-subscription: create starts")
+
+	// Read Terraform plan data into the model
+	var plan models.EventSubscriptionResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Provide synchronization
+	var endpoint = GetServerEndpoint(r.p, plan.RedfishServer)
+	var resource_name = "resource-event-subscription"
+	mutexPool.Lock(ctx, endpoint, resource_name)
+	defer mutexPool.Unlock(ctx, endpoint, resource_name)
+
+	// Connect to service
+	api, err := ConnectTargetSystem(r.p, &plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError("service error: ", err.Error())
+		return
+	}
+
+	defer api.Logout()
+
+	id, err := createEventSubscription(ctx, api, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Error while creating event subscription", err.Error())
+		return
+	}
+
+	plan.Id = types.StringValue(id)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, tkpath.Root("id"), plan.Id)...)
+
+	if _, err = readEventSubscriptionToModel(ctx, api, &plan); err != nil {
+		resp.Diagnostics.AddError("Error while reading event subscription", err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.SendTestEvent.ValueBool() {
+		if err = submitTestEvent(ctx, api.Service); err != nil {
+			resp.Diagnostics.AddWarning("Test event could not be submitted",
+				fmt.Sprintf("Subscription has been created, but test event was rejected: %s", err.Error()))
+		}
+	}
+
+	tflog.Info(ctx, "resource-event-subscription: create ends")
+}
+
+func (r *EventSubscriptionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "resource-event-subscription: read starts")
+
+	// Read Terraform prior state data into the model
+	var state models.EventSubscriptionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, err := ConnectTargetSystem(r.p, &state.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError("service error: ", err.Error())
+		return
+	}
+
+	defer api.Logout()
+
+	found, err := readEventSubscriptionToModel(ctx, api, &state)
+	if err != nil {
+		resp.Diagnostics.AddError("Error while reading event subscription", err.Error())
+		return
+	}
+
+	if !found {
+		tflog.Warn(ctx, "Event subscription does not exist anymore, removing from state", map[string]interface{}{
+			"id": state.Id.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	tflog.Info(ctx, "resource-event-subscription: read ends")
+}
+
+func (r *EventSubscriptionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "resource-event-subscription: update starts")
+
+	// Read Terraform plan and state data into the models
+	var plan, state models.EventSubscriptionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Provide synchronization
+	var endpoint = GetServerEndpoint(r.p, plan.RedfishServer)
+	var resource_name = "resource-event-subscription"
+	mutexPool.Lock(ctx, endpoint, resource_name)
+	defer mutexPool.Unlock(ctx, endpoint, resource_name)
+
+	// Connect to service
+	api, err := ConnectTargetSystem(r.p, &plan.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError("service error: ", err.Error())
+		return
+	}
+
+	defer api.Logout()
+
+	if err = updateEventSubscription(ctx, api, &plan, &state); err != nil {
+		resp.Diagnostics.AddError("Error while updating event subscription", err.Error())
+		return
+	}
+
+	found, err := readEventSubscriptionToModel(ctx, api, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Error while reading event subscription", err.Error())
+		return
+	}
+
+	if !found {
+		resp.Diagnostics.AddError("Error while reading event subscription", "Event subscription does not exist anymore")
+		return
+	}
+
+	diags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	tflog.Info(ctx, "resource-event-subscription: update ends")
+}
+
+func (r *EventSubscriptionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "resource-event-subscription: delete starts")
+
+	var state models.EventSubscriptionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Provide synchronization
+	var endpoint = GetServerEndpoint(r.p, state.RedfishServer)
+	var resource_name = "resource-event-subscription"
+	mutexPool.Lock(ctx, endpoint, resource_name)
+	defer mutexPool.Unlock(ctx, endpoint, resource_name)
+
+	api, err := ConnectTargetSystem(r.p, &state.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError("service error: ", err.Error())
+		return
+	}
+
+	defer api.Logout()
+
+	res, err := api.Delete(state.Id.ValueString())
+	if err != nil && !isNotFoundError(err) {
+		resp.Diagnostics.AddError("Error while deleting event subscription", err.Error())
+		return
+	}
+
+	if res != nil {
+		CloseResource(res.Body)
+	}
+
+	resp.State.RemoveResource(ctx)
+
+	tflog.Info(ctx, "resource-event-subscription: delete ends")
+}
+
+func (r *EventSubscriptionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Info(ctx, "resource-event-subscription: import starts")
+
+	var config CommonImportConfig
+	err := json.Unmarshal([]byte(req.ID), &config)
+	if err != nil {
+		resp.Diagnostics.AddError("Error while unmarshalling import config", err.Error())
+		return
+	}
+
+	creds := importServerBlock(config.ServerConfig)
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, tkpath.Root("id"), config.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, tkpath.Root("server"), creds)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, tkpath.Root("send_test_event"), false)...)
+
+	tflog.Info(ctx, "resource-event-subscription: import ends")
+}
+
+type eventSubscriptionSettings struct {
+	ODataID          string   `json:"@odata.id"`
+	Destination      string   `json:"Destination"`
+	Protocol         string   `json:"Protocol"`
+	Context          string   `json:"Context"`
+	EventTypes       []string `json:"EventTypes"`
+	RegistryPrefixes []string `json:"RegistryPrefixes"`
+	ResourceTypes    []string `json:"ResourceTypes"`
+	MessageIds       []string `json:"MessageIds"`
+}
+
+// isNotFoundError reports whether request failed since requested resource does not exist.
+func isNotFoundError(err error) bool {
+	var err_detailed *common.Error
+	return errors.As(err, &err_detailed) && err_detailed.HTTPReturnedStatusCode == http.StatusNotFound
+}
+
+// eventSubscriptionHeaders converts planned HTTP headers into payload of subscription.
+func eventSubscriptionHeaders(ctx context.Context, headers types.Map) (map[string]string, error) {
+	out := map[string]string{}
+	if headers.IsNull() || headers.IsUnknown() {
+		return out, nil
+	}
+
+	if diags := headers.ElementsAs(ctx, &out, false); diags.HasError() {
+		return nil, fmt.Errorf("could not read planned HTTP headers")
+	}
+	return out, nil
+}
+
+// createEventSubscription creates new subscription based on plan and returns its ID.
+func createEventSubscription(ctx context.Context, api *gofish.APIClient, plan *models.EventSubscriptionResourceModel) (string, error) {
+	payload := map[string]interface{}{
+		"Destination": plan.Destination.ValueString(),
+		"Protocol":    plan.Protocol.ValueString(),
+	}
+
+	if !plan.Context.IsNull() && !plan.Context.IsUnknown() {
+		payload["Context"] = plan.Context.ValueString()
+	}
+
+	filters := map[string]types.List{
+		"EventTypes":       plan.EventTypes,
+		"RegistryPrefixes": plan.RegistryPrefixes,
+		"ResourceTypes":    plan.ResourceTypes,
+		"MessageIds":       plan.MessageIds,
+	}
+	for name, filter := range filters {
+		if filter.IsNull() || filter.IsUnknown() {
+			continue
+		}
+
+		var values []string
+		if diags := filter.ElementsAs(ctx, &values, false); diags.HasError() {
+			return "", fmt.Errorf("could not read planned %s", name)
+		}
+		payload[name] = values
+	}
+
+	headers, err := eventSubscriptionHeaders(ctx, plan.HttpHeaders)
+	if err != nil {
+		return "", err
+	}
+	if len(headers) > 0 {
+		payload["HttpHeaders"] = headers
+	}
+
+	tflog.Info(ctx, "Creating event subscription", map[string]interface{}{
+		"destination": plan.Destination.ValueString(),
+	})
+
+	res, err := api.Post(EVENT_SUBSCRIPTIONS_ENDPOINT, payload)
+	if err != nil {
+		return "", fmt.Errorf("POST on %s finished with error '%w'", EVENT_SUBSCRIPTIONS_ENDPOINT, err)
+	}
+
+	defer CloseResource(res.Body)
+
+	if location := res.Header.Get(HTTP_HEADER_LOCATION); len(location) > 0 {
+		return location, nil
+	}
+
+	// Subscription reported in response body, if service does not set Location header
+	var created eventSubscriptionSettings
+	if err = json.NewDecoder(res.Body).Decode(&created); err != nil || len(created.ODataID) == 0 {
+		return "", fmt.Errorf("service did not report location of created subscription")
+	}
+	return created.ODataID, nil
+}
+
+// readEventSubscriptionToModel reads subscription pointed by ID of model into the model.
+// HTTP headers are not reported by service, so they are kept as they are.
+// False is returned if subscription does not exist.
+func readEventSubscriptionToModel(ctx context.Context, api *gofish.APIClient, model *models.EventSubscriptionResourceModel) (bool, error) {
+	var subscription eventSubscriptionSettings
+	if _, err := getRedfishResource(api, model.Id.ValueString(), &subscription); err != nil {
+		if isNotFoundError(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	model.Destination = types.StringValue(subscription.Destination)
+	model.Protocol = types.StringValue(subscription.Protocol)
+	model.Context = types.StringValue(subscription.Context)
+
+	lists := map[*types.List][]string{
+		&model.EventTypes:       subscription.EventTypes,
+		&model.RegistryPrefixes: subscription.RegistryPrefixes,
+		&model.ResourceTypes:    subscription.ResourceTypes,
+		&model.MessageIds:       subscription.MessageIds,
+	}
+	for list, values := range lists {
+		if values == nil {
+			values = []string{}
+		}
+
+		value, diags := types.ListValueFrom(ctx, types.StringType, values)
+		if diags.HasError() {
+			return false, fmt.Errorf("could not convert subscription filter")
+		}
+		*list = value
+	}
+
+	return true, nil
+}
+
+// updateEventSubscription changes these settings of subscription which can be modified in place.
+func updateEventSubscription(ctx context.Context, api *gofish.APIClient, plan *models.EventSubscriptionResourceModel,
+	state *models.EventSubscriptionResourceModel) error {
+	plan.Id = state.Id
+
+	payload := map[string]interface{}{}
+	if !plan.Context.IsUnknown() && !plan.Context.Equal(state.Context) {
+		payload["Context"] = plan.Context.ValueString()
+	}
+
+	if !plan.HttpHeaders.Equal(state.HttpHeaders) {
+		headers, err := eventSubscriptionHeaders(ctx, plan.HttpHeaders)
+		if err != nil {
+			return err
+		}
+		payload["HttpHeaders"] = headers
+	}
+
+	if len(payload) == 0 {
+		return nil
+	}
+
+	var subscription eventSubscriptionSettings
+	etag, err := getRedfishResource(api, plan.Id.ValueString(), &subscription)
+	if err != nil {
+		return err
+	}
+
+	tflog.Info(ctx, "Changing event subscription", map[string]interface{}{
+		"id": plan.Id.ValueString(),
+	})
+	return patchRedfishResource(api, plan.Id.ValueString(), etag, payload)
+}
+
+// submitTestEvent asks event service to send test event to its subscribers.
+func submitTestEvent(ctx context.Context, service *gofish.Service) error {
+	eventService, err := service.EventService()
+	if err != nil {
+		return err
+	}
+
+	if len(eventService.SubmitTestEventTarget) == 0 {
+		return fmt.Errorf("event service does not support SubmitTestEvent action")
+	}
+
+	payload := map[string]interface{}{
+		"EventType":      "Alert",
+		"EventId":        "TestEvent",
+		"EventTimestamp": time.Now().UTC().Format(time.RFC3339),
+		"Severity":       "OK",
+		"Message":        TEST_EVENT_MESSAGE,
+		"MessageId":      TEST_EVENT_MESSAGE_ID,
+		"MessageArgs":    []string{},
+	}
+
+	tflog.Info(ctx, "Submitting test event", map[string]interface{}{
+		"target": eventService.SubmitTestEventTarget,
+	})
+
+	res, err := service.GetClient().Post(eventService.SubmitTestEventTarget, payload)
+	if err != nil {
+		return err
+	}
+
+	CloseResource(res.Body)
+	return nil
+}
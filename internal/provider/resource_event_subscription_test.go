@@ -0,0 +1,267 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+const resource_event_subscription_name = "irmc-redfish_event_subscription.subscription"
+
+const mockSubmitTestEventTarget = "/redfish/v1/EventService/Actions/EventService.SubmitTestEvent"
+
+func TestAccRedfishEventSubscription_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishResourceEventSubscriptionConfig(creds, "terraform-acc"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resource_event_subscription_name, "protocol", "Redfish"),
+					resource.TestCheckResourceAttr(resource_event_subscription_name, "context", "terraform-acc"),
+					resource.TestCheckResourceAttr(resource_event_subscription_name, "event_types.#", "1"),
+					resource.TestCheckResourceAttrSet(resource_event_subscription_name, "id"),
+				),
+			},
+			{
+				Config: testAccRedfishResourceEventSubscriptionConfig(creds, "terraform-acc-changed"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resource_event_subscription_name, "context", "terraform-acc-changed"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRedfishEventSubscription_import(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishResourceEventSubscriptionConfig(creds, "terraform-acc"),
+			},
+			{
+				ResourceName:            resource_event_subscription_name,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"server", "http_headers"},
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					id := s.RootModule().Resources[resource_event_subscription_name].Primary.ID
+					return fmt.Sprintf("{\"id\":\"%s\",\"username\":\"%s\", \"password\":\"%s\", \"endpoint\":\"https://%s\", \"ssl_insecure\":true}",
+						id, creds.Username, creds.Password, creds.Endpoint), nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccRedfishEventSubscription_negative(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				resource "irmc-redfish_event_subscription" "subscription" {
+					server {
+					  username     = "%s"
+					  password     = "%s"
+					  endpoint     = "https://%s"
+					  ssl_insecure = true
+					}
+
+					destination = "collector.example.com:8443"
+				}
+				`, creds.Username, creds.Password, creds.Endpoint),
+				ExpectError: regexp.MustCompile("must be http or https URL"),
+			},
+		},
+	})
+}
+
+func testAccRedfishResourceEventSubscriptionConfig(testingInfo TestingServerCredentials, context string) string {
+	return fmt.Sprintf(`
+	resource "irmc-redfish_event_subscription" "subscription" {
+
+		server {
+		  username     = "%s"
+		  password     = "%s"
+		  endpoint     = "https://%s"
+		  ssl_insecure = true
+		}
+
+		destination = "https://192.0.2.50:8443/events"
+		context     = "%s"
+		event_types = ["Alert"]
+		http_headers = {
+		  "Authorization" = "Bearer token"
+		}
+	  }
+	`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		context,
+	)
+}
+
+func TestEventSubscriptionLifecycle(t *testing.T) {
+	forEachMockFlavor(t, func(t *testing.T, m *mockRedfishServer) {
+		api := m.connect()
+		ctx := context.Background()
+
+		plan := models.EventSubscriptionResourceModel{
+			Destination:      types.StringValue("https://192.0.2.50:8443/events"),
+			Protocol:         types.StringValue(EVENT_DESTINATION_PROTOCOL_REDFISH),
+			Context:          types.StringUnknown(),
+			EventTypes:       types.ListValueMust(types.StringType, []attr.Value{types.StringValue("Alert")}),
+			RegistryPrefixes: types.ListUnknown(types.StringType),
+			ResourceTypes:    types.ListNull(types.StringType),
+			MessageIds:       types.ListUnknown(types.StringType),
+			HttpHeaders:      types.MapValueMust(types.StringType, map[string]attr.Value{"Authorization": types.StringValue("Bearer token")}),
+		}
+
+		id, err := createEventSubscription(ctx, api, &plan)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+
+		created := m.get(id)
+		if created["Destination"] != "https://192.0.2.50:8443/events" || fmt.Sprint(created["EventTypes"]) != "[Alert]" {
+			t.Errorf("Unexpected subscription %v", created)
+		}
+		if _, ok := created["Context"]; ok {
+			t.Errorf("Context not configured by user must not be sent, got %v", created["Context"])
+		}
+		if headers, _ := created["HttpHeaders"].(map[string]interface{}); headers["Authorization"] != "Bearer token" {
+			t.Errorf("Unexpected HTTP headers %v", created["HttpHeaders"])
+		}
+
+		plan.Id = types.StringValue(id)
+		found, err := readEventSubscriptionToModel(ctx, api, &plan)
+		if err != nil || !found {
+			t.Fatalf("Subscription not read back, found %t, error %v", found, err)
+		}
+
+		if plan.Context.ValueString() != "" || len(plan.RegistryPrefixes.Elements()) != 0 || plan.MessageIds.IsUnknown() {
+			t.Errorf("Unexpected model %v", plan)
+		}
+
+		// Only settings which changed are sent
+		state := plan
+		plan.Context = types.StringValue("collector")
+		etag := m.etag(id)
+		if err = updateEventSubscription(ctx, api, &plan, &state); err != nil {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+
+		patches := m.requestsTo(http.MethodPatch, id)
+		if len(patches) != 1 || patches[0].Body["Context"] != "collector" {
+			t.Fatalf("Unexpected PATCH requests %v", patches)
+		}
+		if patches[0].Header.Get(HTTP_HEADER_IF_MATCH) != etag {
+			t.Errorf("Expected PATCH with If-Match '%s', got '%s'", etag, patches[0].Header.Get(HTTP_HEADER_IF_MATCH))
+		}
+		if _, ok := patches[0].Body["HttpHeaders"]; ok {
+			t.Errorf("Unchanged HTTP headers must not be sent")
+		}
+
+		// Subscription removed outside of Terraform is reported as missing
+		m.remove(id)
+		found, err = readEventSubscriptionToModel(ctx, api, &plan)
+		if err != nil || found {
+			t.Errorf("Removed subscription reported as found %t, error %v", found, err)
+		}
+	})
+}
+
+func TestReadEventSubscriptionDrift(t *testing.T) {
+	m := newMockRedfishServer(t, FSAS)
+	api := m.connect()
+	ctx := context.Background()
+
+	id := EVENT_SUBSCRIPTIONS_ENDPOINT + "/1"
+	m.set(id, map[string]interface{}{
+		"Id":          "1",
+		"Destination": "https://192.0.2.50/events",
+		"Protocol":    "Redfish",
+		"Context":     "changed-on-irmc",
+		"EventTypes":  []interface{}{"Alert", "StatusChange"},
+		"HttpHeaders": nil,
+	})
+
+	headers := types.MapValueMust(types.StringType, map[string]attr.Value{"X-Token": types.StringValue("secret")})
+	model := models.EventSubscriptionResourceModel{
+		Id:          types.StringValue(id),
+		Context:     types.StringValue("terraform"),
+		HttpHeaders: headers,
+	}
+
+	found, err := readEventSubscriptionToModel(ctx, api, &model)
+	if err != nil || !found {
+		t.Fatalf("Subscription not read, found %t, error %v", found, err)
+	}
+
+	expected := types.ListValueMust(types.StringType, []attr.Value{types.StringValue("Alert"), types.StringValue("StatusChange")})
+	if model.Context.ValueString() != "changed-on-irmc" || !model.EventTypes.Equal(expected) {
+		t.Errorf("Change done outside of Terraform not visible in model %v", model)
+	}
+
+	if !model.HttpHeaders.Equal(headers) {
+		t.Errorf("HTTP headers not reported by service must be kept, got %v", model.HttpHeaders)
+	}
+
+	m.failNext(http.MethodGet, id, mockResponse{Status: http.StatusInternalServerError})
+	if _, err = readEventSubscriptionToModel(ctx, api, &model); err == nil {
+		t.Errorf("Expected error when service fails")
+	}
+}
+
+func TestSubmitTestEvent(t *testing.T) {
+	forEachMockFlavor(t, func(t *testing.T, m *mockRedfishServer) {
+		api := m.connect()
+
+		m.handle(mockSubmitTestEventTarget, func(m *mockRedfishServer, req mockRequest) mockResponse {
+			return mockResponse{Status: http.StatusNoContent}
+		})
+
+		if err := submitTestEvent(context.Background(), api.Service); err != nil {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+
+		requests := m.requestsTo(http.MethodPost, mockSubmitTestEventTarget)
+		if len(requests) != 1 || requests[0].Body["MessageId"] != TEST_EVENT_MESSAGE_ID || requests[0].Body["EventType"] != "Alert" {
+			t.Fatalf("Unexpected test event requests %v", requests)
+		}
+
+		m.failNext(http.MethodPost, mockSubmitTestEventTarget, mockResponse{Status: http.StatusBadRequest})
+		if err := submitTestEvent(context.Background(), api.Service); err == nil {
+			t.Errorf("Expected error when test event is rejected")
+		}
+	})
+}
@@ -135,7 +135,7 @@ func (r *IrmcAttributesResource) Create(ctx context.Context, req resource.Create
 	}
 
 	// Provide synchronization
-	var endpoint = plan.RedfishServer[0].Endpoint.ValueString()
+	var endpoint = GetServerEndpoint(r.p, plan.RedfishServer)
 	var resource_name = "resource-irmc-attributes"
 	mutexPool.Lock(ctx, endpoint, resource_name)
 	defer mutexPool.Unlock(ctx, endpoint, resource_name)
@@ -149,12 +149,17 @@ func (r *IrmcAttributesResource) Create(ctx context.Context, req resource.Create
 
 	defer api.Logout()
 
-	isFsas, err := IsFsasCheck(ctx, api)
+	vendor, err := GetOemVendor(ctx, api)
 	if err != nil {
 		resp.Diagnostics.AddError("Vendor Detection Failed", err.Error())
 		return
 	}
-	endp := getIrmcAttributesEndpoints(isFsas)
+	manager, err := GetManagerEndpoint(api.Service)
+	if err != nil {
+		resp.Diagnostics.AddError("Manager Resource Detection Failed", err.Error())
+		return
+	}
+	endp := getIrmcAttributesEndpoints(vendor, manager)
 
 	var plannedAttributes map[string]string
 	diags = plan.Attributes.ElementsAs(ctx, &plannedAttributes, true)
@@ -175,7 +180,7 @@ func (r *IrmcAttributesResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
-	diags = waitTillIrmcAttributesSettingsApplied(ctx, api.Service, location, plan.JobTimeout.ValueInt64(), isFsas)
+	diags = waitTillIrmcAttributesSettingsApplied(ctx, api.Service, location, plan.JobTimeout.ValueInt64(), vendor)
 	resp.Diagnostics.Append(diags...)
 	if diags.HasError() {
 		return
@@ -210,12 +215,17 @@ func (r *IrmcAttributesResource) Read(ctx context.Context, req resource.ReadRequ
 
 	defer api.Logout()
 
-	isFsas, err := IsFsasCheck(ctx, api)
+	vendor, err := GetOemVendor(ctx, api)
 	if err != nil {
 		resp.Diagnostics.AddError("Vendor Detection Failed", err.Error())
 		return
 	}
-	endp := getIrmcAttributesEndpoints(isFsas)
+	manager, err := GetManagerEndpoint(api.Service)
+	if err != nil {
+		resp.Diagnostics.AddError("Manager Resource Detection Failed", err.Error())
+		return
+	}
+	endp := getIrmcAttributesEndpoints(vendor, manager)
 
 	diags := readIrmcAttributesSettingsToModel(ctx, api.Service, &state.Attributes, false, endp.irmcAttributesSettingsEndpoint)
 	resp.Diagnostics.Append(diags...)
@@ -249,12 +259,17 @@ func (r *IrmcAttributesResource) Update(ctx context.Context, req resource.Update
 
 	defer api.Logout()
 
-	isFsas, err := IsFsasCheck(ctx, api)
+	vendor, err := GetOemVendor(ctx, api)
 	if err != nil {
 		resp.Diagnostics.AddError("Vendor Detection Failed", err.Error())
 		return
 	}
-	endp := getIrmcAttributesEndpoints(isFsas)
+	manager, err := GetManagerEndpoint(api.Service)
+	if err != nil {
+		resp.Diagnostics.AddError("Manager Resource Detection Failed", err.Error())
+		return
+	}
+	endp := getIrmcAttributesEndpoints(vendor, manager)
 
 	var plannedAttributes map[string]string
 	diags = plan.Attributes.ElementsAs(ctx, &plannedAttributes, true)
@@ -275,7 +290,7 @@ func (r *IrmcAttributesResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
-	diags = waitTillIrmcAttributesSettingsApplied(ctx, api.Service, location, plan.JobTimeout.ValueInt64(), isFsas)
+	diags = waitTillIrmcAttributesSettingsApplied(ctx, api.Service, location, plan.JobTimeout.ValueInt64(), vendor)
 	resp.Diagnostics.Append(diags...)
 	if diags.HasError() {
 		return
@@ -308,14 +323,7 @@ func (r *IrmcAttributesResource) ImportState(ctx context.Context, req resource.I
 		return
 	}
 
-	server := models.RedfishServer{
-		User:        types.StringValue(config.Username),
-		Password:    types.StringValue(config.Password),
-		Endpoint:    types.StringValue(config.Endpoint),
-		SslInsecure: types.BoolValue(config.SslInsecure),
-	}
-
-	creds := []models.RedfishServer{server}
+	creds := importServerBlock(config.ServerConfig)
 
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, tkpath.Root("server"), creds)...)
 
@@ -474,18 +482,18 @@ func applyIrmcAttributes(service *gofish.Service, attributes map[string]interfac
 	return diags, location
 }
 
-func waitTillIrmcAttributesSettingsApplied(ctx context.Context, service *gofish.Service, task_location string, timeout int64, isFsas bool) (diags diag.Diagnostics) {
+func waitTillIrmcAttributesSettingsApplied(ctx context.Context, service *gofish.Service, task_location string, timeout int64, vendor *OemVendor) (diags diag.Diagnostics) {
 	_, err := WaitForRedfishTaskEnd(ctx, service, task_location, timeout)
 	if err != nil {
 		diags.AddError("Task for patching attributes reported error", err.Error())
-		logs, internal_diags := FetchRedfishTaskLog(service, task_location, isFsas)
+		logs, internal_diags := FetchRedfishTaskLog(service, task_location, vendor)
 		if logs == nil {
 			diags = append(diags, internal_diags...)
 		} else {
 			diags.AddError("Task logs for patching attributes", string(logs))
 		}
 	} else {
-		diags = verifyErrorsInIrmcAttributesTaskLog(service, task_location, isFsas)
+		diags = verifyErrorsInIrmcAttributesTaskLog(service, task_location, vendor)
 	}
 
 	return diags
@@ -498,8 +506,8 @@ type taskLog struct {
 	} `json:"Messages"`
 }
 
-func verifyErrorsInIrmcAttributesTaskLog(service *gofish.Service, task_location string, isFsas bool) (diags diag.Diagnostics) {
-	logs_bytes, internal_diags := FetchRedfishTaskLog(service, task_location, isFsas)
+func verifyErrorsInIrmcAttributesTaskLog(service *gofish.Service, task_location string, vendor *OemVendor) (diags diag.Diagnostics) {
+	logs_bytes, internal_diags := FetchRedfishTaskLog(service, task_location, vendor)
 	if logs_bytes == nil {
 		diags = append(diags, internal_diags...)
 	} else {
@@ -521,14 +529,8 @@ func verifyErrorsInIrmcAttributesTaskLog(service *gofish.Service, task_location
 	return diags
 }
 
-func getIrmcAttributesEndpoints(isFsas bool) irmcAttributesEndpoints {
-	if isFsas {
-		return irmcAttributesEndpoints{
-			irmcAttributesSettingsEndpoint: fmt.Sprintf("/redfish/v1/Managers/iRMC/Oem/%s/iRMCConfiguration/Attributes", FSAS),
-		}
-	} else {
-		return irmcAttributesEndpoints{
-			irmcAttributesSettingsEndpoint: fmt.Sprintf("/redfish/v1/Managers/iRMC/Oem/%s/iRMCConfiguration/Attributes", TS_FUJITSU),
-		}
+func getIrmcAttributesEndpoints(vendor *OemVendor, manager string) irmcAttributesEndpoints {
+	return irmcAttributesEndpoints{
+		irmcAttributesSettingsEndpoint: vendor.OemPath(manager, "iRMCConfiguration/Attributes"),
 	}
 }
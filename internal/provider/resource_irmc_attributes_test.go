@@ -26,6 +26,8 @@ import (
 	"regexp"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/stmcginnis/gofish"
@@ -181,20 +183,13 @@ func testChangeCasLoginUri(creds TestingServerCredentials, loginUri string) {
 		return
 	}
 
-	isFsas, err := IsFsasCheck(context.Background(), api)
+	vendor, err := GetOemVendor(context.Background(), api)
 	if err != nil {
 		log.Printf("Vendor check reported error %s", err.Error())
 		return
 	}
 
-	var oemKey string
-	if isFsas {
-		oemKey = FSAS
-	} else {
-		oemKey = TS_FUJITSU
-	}
-
-	path := fmt.Sprintf("/redfish/v1/Managers/iRMC/Oem/%s/iRMCConfiguration/Cas", oemKey)
+	path := fmt.Sprintf("/redfish/v1/Managers/iRMC/Oem/%s/iRMCConfiguration/Cas", vendor.Key)
 
 	resp, err := api.Get(path)
 	if err != nil {
@@ -230,3 +225,72 @@ func testChangeCasLoginUri(creds TestingServerCredentials, loginUri string) {
 		}
 	}
 }
+
+func TestValidateAndAdjustPlannedIrmcAttributes(t *testing.T) {
+	forEachMockFlavor(t, func(t *testing.T, m *mockRedfishServer) {
+		api := m.connect()
+		ctx := context.Background()
+		endpoint := getIrmcAttributesEndpoints(m.vendor(), mockManagerEndpoint).irmcAttributesSettingsEndpoint
+
+		attributes, diags := validateAndAdjustPlannedIrmcAttributes(ctx, api.Service, map[string]string{
+			"BmcNetworkProtocolHttpPort": "8080",
+			"BmcTimeZone":                "UTC",
+		}, endpoint)
+		if diags.HasError() {
+			t.Fatalf("Unexpected error: %v", diags)
+		}
+
+		if len(attributes) != 1 || attributes["BmcNetworkProtocolHttpPort"] != 8080 {
+			t.Errorf("Unexpected adjusted attributes: %v", attributes)
+		}
+
+		_, diags = validateAndAdjustPlannedIrmcAttributes(ctx, api.Service, map[string]string{"XXX": "1"}, endpoint)
+		if !diags.HasError() {
+			t.Errorf("Expected error for not supported attribute")
+		}
+	})
+}
+
+func TestApplyIrmcAttributes(t *testing.T) {
+	forEachMockFlavor(t, func(t *testing.T, m *mockRedfishServer) {
+		api := m.connect()
+		ctx := context.Background()
+		endpoint := getIrmcAttributesEndpoints(m.vendor(), mockManagerEndpoint).irmcAttributesSettingsEndpoint
+
+		diags, location := applyIrmcAttributes(api.Service, map[string]interface{}{"BmcTimeZone": "CET"}, endpoint)
+		if diags.HasError() {
+			t.Fatalf("Unexpected error: %v", diags)
+		}
+
+		if location == "" {
+			t.Fatalf("Task location has not been returned")
+		}
+
+		if diags := waitTillIrmcAttributesSettingsApplied(ctx, api.Service, location, 10, m.vendor()); diags.HasError() {
+			t.Fatalf("Unexpected error: %v", diags)
+		}
+
+		attrMap := types.MapValueMust(types.StringType, map[string]attr.Value{
+			"BmcTimeZone": types.StringValue("UTC"),
+		})
+		if diags := readIrmcAttributesSettingsToModel(ctx, api.Service, &attrMap, false, endpoint); diags.HasError() {
+			t.Fatalf("Unexpected error: %v", diags)
+		}
+
+		if attrMap.Elements()["BmcTimeZone"] != types.StringValue("CET") {
+			t.Errorf("Attribute has not been applied: %v", attrMap)
+		}
+	})
+}
+
+func TestVerifyErrorsInIrmcAttributesTaskLog(t *testing.T) {
+	forEachMockFlavor(t, func(t *testing.T, m *mockRedfishServer) {
+		api := m.connect()
+
+		location := m.addTask([]string{"Attribute applied", "Error: value out of range"})
+		diags := verifyErrorsInIrmcAttributesTaskLog(api.Service, location, m.vendor())
+		if diags.ErrorsCount() != 1 {
+			t.Errorf("Expected single error from task log, got %v", diags)
+		}
+	})
+}
@@ -200,13 +200,18 @@ func (r *IrmcFirmwareUpdateResource) Create(ctx context.Context, req resource.Cr
 	}
 	defer api.Logout()
 
-	isFsas, err := IsFsasCheck(ctx, api)
+	vendor, err := GetOemVendor(ctx, api)
 	if err != nil {
 		resp.Diagnostics.AddError("Vendor Detection Failed", err.Error())
 		return
 	}
 
-	firmwareUpdEnpd := getFirmwareEndpoints(isFsas)
+	manager, err := GetManagerEndpoint(api.Service)
+	if err != nil {
+		resp.Diagnostics.AddError("Manager Resource Detection Failed", err.Error())
+		return
+	}
+	firmwareUpdEnpd := getFirmwareEndpoints(vendor, manager)
 
 	err = setSelectors(api, &plan, firmwareUpdEnpd.FirmwareUpdateEndpoint)
 	if err != nil {
@@ -222,7 +227,7 @@ func (r *IrmcFirmwareUpdateResource) Create(ctx context.Context, req resource.Cr
 			resp.Diagnostics.AddError("File firmware update failed.", err.Error())
 			return
 		}
-		err = checkFirmwareUpdateStatus(ctx, api.Service, taskLocation, plan.UpdateTimeout.ValueInt64(), isFsas)
+		err = checkFirmwareUpdateStatus(ctx, api.Service, taskLocation, plan.UpdateTimeout.ValueInt64(), vendor)
 		if err != nil {
 			resp.Diagnostics.AddError("File Firmware Update task did not complete successfully", err.Error())
 			return
@@ -233,7 +238,7 @@ func (r *IrmcFirmwareUpdateResource) Create(ctx context.Context, req resource.Cr
 			resp.Diagnostics.AddError("TFTP firmware update failed.", err.Error())
 			return
 		}
-		err = checkFirmwareUpdateStatus(ctx, api.Service, taskLocation, plan.UpdateTimeout.ValueInt64(), isFsas)
+		err = checkFirmwareUpdateStatus(ctx, api.Service, taskLocation, plan.UpdateTimeout.ValueInt64(), vendor)
 		if err != nil {
 			resp.Diagnostics.AddError("TFTP Firmware Update task did not complete successfully", err.Error())
 			return
@@ -244,7 +249,7 @@ func (r *IrmcFirmwareUpdateResource) Create(ctx context.Context, req resource.Cr
 			resp.Diagnostics.AddError("MemoryCard firmware update failed.", err.Error())
 			return
 		}
-		err = checkFirmwareUpdateStatus(ctx, api.Service, taskLocation, plan.UpdateTimeout.ValueInt64(), isFsas)
+		err = checkFirmwareUpdateStatus(ctx, api.Service, taskLocation, plan.UpdateTimeout.ValueInt64(), vendor)
 		if err != nil {
 			resp.Diagnostics.AddError("Memory Card Firmware Update task did not complete successfully", err.Error())
 			return
@@ -467,10 +472,10 @@ func setSelectors(api *gofish.APIClient, plan *models.IrmcFirmwareUpdateResource
 	return nil
 }
 
-func checkFirmwareUpdateStatus(ctx context.Context, service *gofish.Service, location string, timeout int64, isFsas bool) error {
+func checkFirmwareUpdateStatus(ctx context.Context, service *gofish.Service, location string, timeout int64, vendor *OemVendor) error {
 	finishedSuccessfully, err := WaitForRedfishTaskEnd(ctx, service, location, timeout)
 	if err != nil || !finishedSuccessfully {
-		taskLog, diags := FetchRedfishTaskLog(service, location, isFsas)
+		taskLog, diags := FetchRedfishTaskLog(service, location, vendor)
 		if diags.HasError() {
 			return fmt.Errorf("firmware Update task did not complete successfully: %s", err)
 		}
@@ -486,11 +491,11 @@ func ResetIrmcAfterFirmwareUpd(ctx context.Context, api *gofish.APIClient, plan
 	}
 
 	if poweredOn && plan.ResetIrmcAfterUpdate.ValueBool() {
-		irmc, err := api.Service.Managers()
+		irmc, err := GetManagerResource(api.Service)
 		if err != nil {
 			return fmt.Errorf("error when accessing Managers resource: %w", err)
 		}
-		err = irmc[0].Reset(redfish.GracefulRestartResetType)
+		err = irmc.Reset(redfish.GracefulRestartResetType)
 		if err != nil {
 			return fmt.Errorf("error resetting manager: %w", err)
 		}
@@ -506,24 +511,16 @@ func ResetIrmcAfterFirmwareUpd(ctx context.Context, api *gofish.APIClient, plan
 		return fmt.Errorf("failed to reboot iRMC: %w", err)
 	}
 
+	// First connection might have reached iRMC before it went down and got cached
+	provider.InvalidateClients(GetServerEndpoint(provider, plan.RedfishServer))
 	return nil
 }
 
-func getFirmwareEndpoints(isFsas bool) firmwareUpdateEndpoints {
-	if isFsas {
-		return firmwareUpdateEndpoints{
-			FirmwareUpdateEndpoint:           fmt.Sprintf("/redfish/v1/Managers/iRMC/Oem/%s/iRMCConfiguration/FWUpdate", FSAS),
-			FileFirmwareUpdateEndpoint:       fmt.Sprintf("/redfish/v1/Managers/iRMC/Actions/Oem/%sManager.FWUpdate", FSAS),
-			TftpFirmwareUpdateEndpoint:       fmt.Sprintf("/redfish/v1/Managers/iRMC/Actions/Oem/%sManager.FWTFTPUpdate", FSAS),
-			MemoryCardFirmwareUpdateEndpoint: fmt.Sprintf("/redfish/v1/Managers/iRMC/Actions/Oem/%sManager.FWMemoryCardUpdate", FSAS),
-		}
-	} else {
-		return firmwareUpdateEndpoints{
-			FirmwareUpdateEndpoint:           fmt.Sprintf("/redfish/v1/Managers/iRMC/Oem/%s/iRMCConfiguration/FWUpdate", TS_FUJITSU),
-			FileFirmwareUpdateEndpoint:       fmt.Sprintf("/redfish/v1/Managers/iRMC/Actions/Oem/%sManager.FWUpdate", FTS),
-			TftpFirmwareUpdateEndpoint:       fmt.Sprintf("/redfish/v1/Managers/iRMC/Actions/Oem/%sManager.FWTFTPUpdate", FTS),
-			MemoryCardFirmwareUpdateEndpoint: fmt.Sprintf("/redfish/v1/Managers/iRMC/Actions/Oem/%sManager.FWMemoryCardUpdate", FTS),
-		}
+func getFirmwareEndpoints(vendor *OemVendor, manager string) firmwareUpdateEndpoints {
+	return firmwareUpdateEndpoints{
+		FirmwareUpdateEndpoint:           vendor.OemPath(manager, "iRMCConfiguration/FWUpdate"),
+		FileFirmwareUpdateEndpoint:       vendor.ActionPath(manager, "Manager.FWUpdate"),
+		TftpFirmwareUpdateEndpoint:       vendor.ActionPath(manager, "Manager.FWTFTPUpdate"),
+		MemoryCardFirmwareUpdateEndpoint: vendor.ActionPath(manager, "Manager.FWMemoryCardUpdate"),
 	}
-
 }
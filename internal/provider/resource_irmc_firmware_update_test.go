@@ -18,11 +18,18 @@ limitations under the License.
 package provider
 
 import (
+	"context"
 	"fmt"
+	"os"
+	"path/filepath"
 	"regexp"
+	"strings"
+	"terraform-provider-irmc-redfish/internal/models"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stmcginnis/gofish/redfish"
 )
 
 func TestAccFirmwareUpdateResource_correct_MemoryCard_update(t *testing.T) {
@@ -133,3 +140,68 @@ func testAccFirmwareUpdateResourceConfig(testingInfo TestingServerCredentials, u
 		tftpUpdateFile,
 	)
 }
+
+func TestFirmwareUpdateHelpers(t *testing.T) {
+	forEachMockFlavor(t, func(t *testing.T, m *mockRedfishServer) {
+		api := m.connect()
+		endpoints := getFirmwareEndpoints(m.vendor(), mockManagerEndpoint)
+
+		plan := models.IrmcFirmwareUpdateResourceModel{
+			TftpServerAddr:    types.StringValue("10.0.0.1"),
+			TftpUpdateFile:    types.StringValue("irmc.bin"),
+			IRMCBootSelector:  types.StringValue("Auto"),
+			IRMCFlashSelector: types.StringValue("LowFWImage"),
+		}
+
+		if err := setSelectors(api, &plan, endpoints.FirmwareUpdateEndpoint); err != nil {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+
+		location, err := handleTftpUpdate(api, &plan, endpoints.FirmwareUpdateEndpoint, endpoints.TftpFirmwareUpdateEndpoint)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+
+		fwUpdate := m.get(endpoints.FirmwareUpdateEndpoint)
+		if fwUpdate["ServerName"] != "10.0.0.1" || fwUpdate["iRMCFileName"] != "irmc.bin" ||
+			fwUpdate["iRMCBootSelector"] != "Auto" || fwUpdate["iRMCFlashSelector"] != "LowFWImage" {
+			t.Errorf("Firmware update settings not applied: %v", fwUpdate)
+		}
+
+		if err = checkFirmwareUpdateStatus(context.Background(), api.Service, location, 10, m.vendor()); err != nil {
+			t.Errorf("Unexpected error: %s", err.Error())
+		}
+
+		if _, err = handleMemoryCardUpdate(api, endpoints.MemoryCardFirmwareUpdateEndpoint); err != nil {
+			t.Errorf("Unexpected error: %s", err.Error())
+		}
+
+		location = m.addTask([]string{"Firmware image is corrupted"}, redfish.ExceptionTaskState)
+		err = checkFirmwareUpdateStatus(context.Background(), api.Service, location, 10, m.vendor())
+		if err == nil || !strings.Contains(err.Error(), "Firmware image is corrupted") {
+			t.Errorf("Expected error containing task log, got %v", err)
+		}
+	})
+}
+
+func TestFileFirmwareUpdate(t *testing.T) {
+	m := newMockRedfishServer(t, FSAS)
+	api := m.connect()
+	endpoints := getFirmwareEndpoints(oemVendorFsas, mockManagerEndpoint)
+
+	plan := models.IrmcFirmwareUpdateResourceModel{IRMCPathToBinary: types.StringValue(filepath.Join(t.TempDir(), "irmc.txt"))}
+	if _, err := handleFileUpdate(api, &plan, endpoints.FileFirmwareUpdateEndpoint); err == nil {
+		t.Errorf("Expected error for file with wrong extension")
+	}
+
+	binary := filepath.Join(t.TempDir(), "irmc.bin")
+	if err := os.WriteFile(binary, []byte("firmware"), 0o600); err != nil {
+		t.Fatalf("Could not create firmware file: %s", err.Error())
+	}
+
+	plan.IRMCPathToBinary = types.StringValue(binary)
+	location, err := handleFileUpdate(api, &plan, endpoints.FileFirmwareUpdateEndpoint)
+	if err != nil || location == "" {
+		t.Errorf("Unexpected result: %s, %v", location, err)
+	}
+}
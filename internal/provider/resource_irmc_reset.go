@@ -36,6 +36,10 @@ import (
 const RESET_TIMEOUT int = 600
 const CHECK_INTERVAL int = 10
 
+// irmcStartupDelay is the time iRMC needs after reset request before it stops responding
+// to the requests, so status checks made earlier would report the old instance.
+var irmcStartupDelay = 45 * time.Second
+
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &IrmcRestartResource{}
 
@@ -103,7 +107,7 @@ func (r *IrmcRestartResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
-	var endpoint = plan.RedfishServer[0].Endpoint.ValueString()
+	var endpoint = GetServerEndpoint(r.p, plan.RedfishServer)
 	var resource_name = "resource-irmc-reset"
 	mutexPool.Lock(ctx, endpoint, resource_name)
 	defer mutexPool.Unlock(ctx, endpoint, resource_name)
@@ -115,18 +119,18 @@ func (r *IrmcRestartResource) Create(ctx context.Context, req resource.CreateReq
 	}
 
 	defer config.Logout()
-	var irmc []*redfish.Manager
+	var irmc *redfish.Manager
 
 	// Get manager
-	irmc, err = config.Service.Managers()
+	irmc, err = GetManagerResource(config.Service)
 	if err != nil {
 		resp.Diagnostics.AddError("Error when accessing Managers resource", err.Error())
 		return
 	}
-	plan.Id = types.StringValue(irmc[0].ID)
+	plan.Id = types.StringValue(irmc.ID)
 
 	// Perform manager reset
-	err = irmc[0].Reset(redfish.GracefulRestartResetType)
+	err = irmc.Reset(redfish.GracefulRestartResetType)
 	if err != nil {
 		resp.Diagnostics.AddError("Error resetting manager", err.Error())
 		return
@@ -144,6 +148,9 @@ func (r *IrmcRestartResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	// First connection might have reached iRMC before it went down and got cached
+	r.p.InvalidateClients(endpoint)
+
 	tflog.Info(ctx, "resource-irmc-reset: updating state finished")
 	// Save into State
 	diags = resp.State.Set(ctx, &plan)
@@ -182,18 +189,25 @@ func (*IrmcRestartResource) Delete(ctx context.Context, req resource.DeleteReque
 	tflog.Info(ctx, "resource-irmc-reset: delete ends")
 }
 
-func checkIrmcStatus(ctx context.Context, service *gofish.APIClient, interval int, timeout int) error {
+func checkIrmcStatus(ctx context.Context, api *gofish.APIClient, interval int, timeout int) error {
 	path := "/redfish/v1/"
 
-	time.Sleep(45 * time.Second)
+	service, release := withContext(ctx, api.Service)
+	defer release()
+
+	if err := sleepWithContext(ctx, irmcStartupDelay); err != nil {
+		return irmcStatusCheckCancelledError(err)
+	}
 
 	for start := time.Now(); time.Since(start) < (time.Duration(timeout) * time.Second); {
 		tflog.Info(ctx, "Checking IRMC server status via API GET")
 
-		resp, err := service.Get(path)
+		resp, err := service.GetClient().Get(path)
 		if err != nil {
 			tflog.Warn(ctx, fmt.Sprintf("GET on %s reported error: %s", path, err.Error()))
-			time.Sleep(time.Duration(interval) * time.Second)
+			if err := sleepWithContext(ctx, time.Duration(interval)*time.Second); err != nil {
+				return irmcStatusCheckCancelledError(err)
+			}
 			continue
 		}
 
@@ -208,9 +222,17 @@ func checkIrmcStatus(ctx context.Context, service *gofish.APIClient, interval in
 		}
 
 		tflog.Warn(ctx, fmt.Sprintf("Received non-200 status code: %d", resp.StatusCode))
+		CloseResource(resp.Body)
 
-		time.Sleep(time.Duration(interval) * time.Second)
+		if err := sleepWithContext(ctx, time.Duration(interval)*time.Second); err != nil {
+			return irmcStatusCheckCancelledError(err)
+		}
 	}
 
 	return fmt.Errorf("IRMC server status check timed out after %d seconds", timeout)
 }
+
+// irmcStatusCheckCancelledError describes interrupted waiting for iRMC to become available.
+func irmcStatusCheckCancelledError(err error) error {
+	return fmt.Errorf("waiting for iRMC to become available has been cancelled, iRMC might be still restarting: %w", err)
+}
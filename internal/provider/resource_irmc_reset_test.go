@@ -18,7 +18,9 @@ limitations under the License.
 package provider
 
 import (
+	"context"
 	"fmt"
+	"net/http"
 	"regexp"
 	"testing"
 	"time"
@@ -125,13 +127,13 @@ func testpreIrmcHostPowerOn(creds TestingServerCredentials, hoston bool) error {
 		return err
 	}
 	if hoston && !isPoweredOn {
-		err = changePowerState(api.Service, true, 300)
+		err = changePowerState(context.Background(), api.Service, true, 300)
 		if err != nil {
 			return err
 		}
 		time.Sleep(2 * time.Minute)
 	} else if !hoston && isPoweredOn {
-		err = changePowerState(api.Service, false, 300)
+		err = changePowerState(context.Background(), api.Service, false, 300)
 		if err != nil {
 			return err
 		}
@@ -140,3 +142,21 @@ func testpreIrmcHostPowerOn(creds TestingServerCredentials, hoston bool) error {
 
 	return nil
 }
+
+func TestRestartIrmc(t *testing.T) {
+	defaultDelay := irmcStartupDelay
+	irmcStartupDelay = 0
+	defer func() { irmcStartupDelay = defaultDelay }()
+
+	forEachMockFlavor(t, func(t *testing.T, m *mockRedfishServer) {
+		api := m.connect()
+
+		if err := restartIrmc(context.Background(), api, m.redfishServer(), &IrmcProvider{}); err != nil {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+
+		if len(m.requestsTo(http.MethodPost, "/redfish/v1/Managers/iRMC/Actions/Manager.Reset")) != 1 {
+			t.Errorf("Expected single iRMC reset request")
+		}
+	})
+}
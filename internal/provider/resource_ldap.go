@@ -0,0 +1,597 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	tkpath "github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/stmcginnis/gofish"
+)
+
+const (
+	ACCOUNT_SERVICE_ENDPOINT = "/redfish/v1/AccountService"
+
+	LDAP_SERVICE_TYPE_LDAP             = "LDAP"
+	LDAP_SERVICE_TYPE_ACTIVE_DIRECTORY = "ActiveDirectory"
+)
+
+var ldapServiceAddressRegex = regexp.MustCompile(`^(ldaps?://)?[^\s/]+$`)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &LdapResource{}
+var _ resource.ResourceWithImportState = &LdapResource{}
+var _ resource.ResourceWithValidateConfig = &LdapResource{}
+
+func NewLdapResource() resource.Resource {
+	return &LdapResource{}
+}
+
+// LdapResource defines the resource implementation.
+type LdapResource struct {
+	p *IrmcProvider
+}
+
+func (r *LdapResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + ldapName
+}
+
+func LdapSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "ID of account service resource on iRMC.",
+			Description:         "ID of account service resource on iRMC.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"service_type": schema.StringAttribute{
+			Required:            true,
+			MarkdownDescription: "Type of directory service: 'LDAP' or 'ActiveDirectory'. Change of type manages the other directory service.",
+			Description:         "Type of directory service: 'LDAP' or 'ActiveDirectory'. Change of type manages the other directory service.",
+			Validators: []validator.String{
+				stringvalidator.OneOf([]string{
+					LDAP_SERVICE_TYPE_LDAP,
+					LDAP_SERVICE_TYPE_ACTIVE_DIRECTORY,
+				}...),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"enabled": schema.BoolAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Indicates whether users are authenticated by directory service. If not set, current value is kept.",
+			Description:         "Indicates whether users are authenticated by directory service. If not set, current value is kept.",
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"service_addresses": schema.ListAttribute{
+			Optional:            true,
+			Computed:            true,
+			ElementType:         types.StringType,
+			MarkdownDescription: "Addresses of directory servers in order of preference (e.g. 'ldaps://ad.example.com:636'). If not set, current servers are kept.",
+			Description:         "Addresses of directory servers in order of preference (e.g. 'ldaps://ad.example.com:636'). If not set, current servers are kept.",
+			Validators: []validator.List{
+				listvalidator.UniqueValues(),
+				listvalidator.ValueStringsAre(
+					stringvalidator.RegexMatches(ldapServiceAddressRegex, "must be host name or IP address, optionally with ldap:// or ldaps:// scheme and port"),
+				),
+			},
+			PlanModifiers: []planmodifier.List{
+				listplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"base_distinguished_names": schema.ListAttribute{
+			Optional:            true,
+			Computed:            true,
+			ElementType:         types.StringType,
+			MarkdownDescription: "Base distinguished names where users are searched (e.g. 'DC=example,DC=com'). If not set, current values are kept.",
+			Description:         "Base distinguished names where users are searched (e.g. 'DC=example,DC=com'). If not set, current values are kept.",
+			Validators: []validator.List{
+				listvalidator.UniqueValues(),
+				listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+			},
+			PlanModifiers: []planmodifier.List{
+				listplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"username_attribute": schema.StringAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Attribute of directory entry holding user name (e.g. 'sAMAccountName', 'uid'). If not set, current value is kept.",
+			Description:         "Attribute of directory entry holding user name (e.g. 'sAMAccountName', 'uid'). If not set, current value is kept.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"groups_attribute": schema.StringAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Attribute of directory entry holding groups of user (e.g. 'memberOf'). If not set, current value is kept.",
+			Description:         "Attribute of directory entry holding groups of user (e.g. 'memberOf'). If not set, current value is kept.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"bind_username": schema.StringAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "User (e.g. distinguished name) iRMC binds to directory as to search users. If not set, current value is kept.",
+			Description:         "User (e.g. distinguished name) iRMC binds to directory as to search users. If not set, current value is kept.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"bind_password": schema.StringAttribute{
+			Optional:            true,
+			Sensitive:           true,
+			WriteOnly:           true,
+			MarkdownDescription: "Password of bind user. It's never stored in state and is sent to iRMC only when the resource is created or `bind_password_version` changes.",
+			Description:         "Password of bind user. It's never stored in state and is sent to iRMC only when the resource is created or bind_password_version changes.",
+		},
+		"bind_password_version": schema.Int64Attribute{
+			Optional:            true,
+			MarkdownDescription: "Version of bind password. Since password is write-only, change of the value (e.g. after rotation) is the only way to send it to iRMC again.",
+			Description:         "Version of bind password. Since password is write-only, change of the value (e.g. after rotation) is the only way to send it to iRMC again.",
+		},
+		"verify_certificate": schema.BoolAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Indicates whether certificate presented by directory server is verified. If not set, current value is kept.",
+			Description:         "Indicates whether certificate presented by directory server is verified. If not set, current value is kept.",
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.UseStateForUnknown(),
+			},
+		},
+	}
+}
+
+func LdapBlocks() map[string]schema.Block {
+	blocks := RedfishServerResourceBlockMap()
+	blocks["role_mapping"] = schema.ListNestedBlock{
+		MarkdownDescription: "Mapping of directory group to iRMC role. Mappings present on iRMC, but not defined in configuration, are removed.",
+		Description:         "Mapping of directory group to iRMC role. Mappings present on iRMC, but not defined in configuration, are removed.",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"remote_group": schema.StringAttribute{
+					Required:            true,
+					MarkdownDescription: "Name of directory group (e.g. 'iRMC-Admins').",
+					Description:         "Name of directory group (e.g. 'iRMC-Admins').",
+					Validators: []validator.String{
+						stringvalidator.LengthAtLeast(1),
+					},
+				},
+				"local_role": schema.StringAttribute{
+					Required:            true,
+					MarkdownDescription: "iRMC role granted to members of the group: 'Administrator', 'Operator' or 'ReadOnly'.",
+					Description:         "iRMC role granted to members of the group: 'Administrator', 'Operator' or 'ReadOnly'.",
+					Validators: []validator.String{
+						stringvalidator.OneOf([]string{
+							"Administrator",
+							"Operator",
+							"ReadOnly",
+						}...),
+					},
+				},
+			},
+		},
+	}
+	return blocks
+}
+
+func (r *LdapResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The resource is used to control (read, modify or import) authentication of iRMC users by directory service (LDAP or Active Directory) on Fujitsu server equipped with iRMC controller. Bind password is write-only, so Terraform 1.11 or later is required.",
+		Description:         "The resource is used to control (read, modify or import) authentication of iRMC users by directory service (LDAP or Active Directory) on Fujitsu server equipped with iRMC controller. Bind password is write-only, so Terraform 1.11 or later is required.",
+		Attributes:          LdapSchema(),
+		Blocks:              LdapBlocks(),
+	}
+}
+
+func (r *LdapResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	p, ok := req.ProviderData.(*IrmcProvider)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *IrmcProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.p = p
+}
+
+func (r *LdapResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config models.LdapResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateLdapConfig(config)...)
+}
+
+func (r *LdapResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "resource-ldap: create starts")
+
+	// Read Terraform plan data into the model, write-only bind password is available only in config
+	var plan, config models.LdapResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan, &config, nil)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	tflog.Info(ctx, "resource-ldap: create ends")
+}
+
+func (r *LdapResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "resource-ldap: read starts")
+
+	// Read Terraform prior state data into the model
+	var state models.LdapResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, err := ConnectTargetSystem(r.p, &state.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError("service error: ", err.Error())
+		return
+	}
+
+	defer api.Logout()
+
+	resp.Diagnostics.Append(readLdapSettingsToModel(ctx, api, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	tflog.Info(ctx, "resource-ldap: read ends")
+}
+
+func (r *LdapResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "resource-ldap: update starts")
+
+	// Read Terraform plan, config and state data into the models, write-only bind password is available only in config
+	var plan, config, state models.LdapResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan, &config, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	tflog.Info(ctx, "resource-ldap: update ends")
+}
+
+func (r *LdapResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "resource-ldap: delete starts")
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "resource-ldap: delete ends")
+}
+
+type LdapImportConfig struct {
+	ServerConfig
+	ServiceType string `json:"service_type"`
+}
+
+func (r *LdapResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Info(ctx, "resource-ldap: import starts")
+
+	var config LdapImportConfig
+	err := json.Unmarshal([]byte(req.ID), &config)
+	if err != nil {
+		resp.Diagnostics.AddError("Error while unmarshalling import config", err.Error())
+		return
+	}
+
+	if !slices.Contains([]string{LDAP_SERVICE_TYPE_LDAP, LDAP_SERVICE_TYPE_ACTIVE_DIRECTORY}, config.ServiceType) {
+		resp.Diagnostics.AddError("Error while importing directory service settings",
+			fmt.Sprintf("service_type must be '%s' or '%s'", LDAP_SERVICE_TYPE_LDAP, LDAP_SERVICE_TYPE_ACTIVE_DIRECTORY))
+		return
+	}
+
+	creds := importServerBlock(config.ServerConfig)
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, tkpath.Root("server"), creds)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, tkpath.Root("service_type"), config.ServiceType)...)
+
+	tflog.Info(ctx, "resource-ldap: import ends")
+}
+
+// apply configures directory service requested by plan and reads settings back into plan. Write-only bind password
+// is taken from config. State is nil when resource is created, so configured bind password is always sent to iRMC.
+func (r *LdapResource) apply(ctx context.Context, plan *models.LdapResourceModel, config *models.LdapResourceModel,
+	state *models.LdapResourceModel) (diags diag.Diagnostics) {
+	// Provide synchronization
+	var endpoint = GetServerEndpoint(r.p, plan.RedfishServer)
+	var resource_name = "resource-ldap"
+	mutexPool.Lock(ctx, endpoint, resource_name)
+	defer mutexPool.Unlock(ctx, endpoint, resource_name)
+
+	// Connect to service
+	api, err := ConnectTargetSystem(r.p, &plan.RedfishServer)
+	if err != nil {
+		diags.AddError("service error: ", err.Error())
+		return diags
+	}
+
+	defer api.Logout()
+
+	vendor, err := GetOemVendor(ctx, api)
+	if err != nil {
+		diags.AddError("Vendor Detection Failed", err.Error())
+		return diags
+	}
+
+	if err = applyLdapSettings(ctx, api, vendor, plan, config, state); err != nil {
+		diags.AddError("Error while applying directory service settings", err.Error())
+		return diags
+	}
+
+	return readLdapSettingsToModel(ctx, api, plan)
+}
+
+// validateLdapConfig verifies at plan time that directory service settings are consistent.
+func validateLdapConfig(config models.LdapResourceModel) (diags diag.Diagnostics) {
+	const summary = "Invalid directory service configuration"
+
+	if isBoolConfigured(config.Enabled, true) && !config.ServiceAddresses.IsNull() && !config.ServiceAddresses.IsUnknown() &&
+		len(config.ServiceAddresses.Elements()) == 0 {
+		diags.AddAttributeError(tkpath.Root("service_addresses"), summary,
+			"Directory service requires at least one server")
+	}
+
+	if !config.BindPassword.IsNull() && !config.BindUsername.IsUnknown() && config.BindUsername.ValueString() == "" {
+		diags.AddAttributeError(tkpath.Root("bind_password"), summary,
+			"Bind password requires bind user name")
+	}
+
+	groups := map[string]bool{}
+	for i, mapping := range config.RoleMappings {
+		if mapping.RemoteGroup.IsUnknown() {
+			continue
+		}
+		if groups[mapping.RemoteGroup.ValueString()] {
+			diags.AddAttributeError(tkpath.Root("role_mapping").AtListIndex(i).AtName("remote_group"), summary,
+				fmt.Sprintf("Group '%s' is mapped more than once", mapping.RemoteGroup.ValueString()))
+		}
+		groups[mapping.RemoteGroup.ValueString()] = true
+	}
+
+	return diags
+}
+
+type ldapOem struct {
+	VerifyCertificate *bool `json:"VerifyCertificate,omitempty"`
+}
+
+type ldapRoleMappingSettings struct {
+	RemoteGroup string `json:"RemoteGroup"`
+	LocalRole   string `json:"LocalRole"`
+}
+
+type ldapServiceSettings struct {
+	ServiceEnabled   bool     `json:"ServiceEnabled"`
+	ServiceAddresses []string `json:"ServiceAddresses"`
+	Authentication   struct {
+		Username string `json:"Username"`
+	} `json:"Authentication"`
+	LDAPService struct {
+		SearchSettings struct {
+			BaseDistinguishedNames []string `json:"BaseDistinguishedNames"`
+			UsernameAttribute      string   `json:"UsernameAttribute"`
+			GroupsAttribute        string   `json:"GroupsAttribute"`
+		} `json:"SearchSettings"`
+	} `json:"LDAPService"`
+	RemoteRoleMapping []ldapRoleMappingSettings `json:"RemoteRoleMapping"`
+	Oem               OemObject[ldapOem]        `json:"Oem"`
+}
+
+type accountServiceDirectorySettings struct {
+	LDAP            ldapServiceSettings `json:"LDAP"`
+	ActiveDirectory ldapServiceSettings `json:"ActiveDirectory"`
+}
+
+// service returns settings of directory service of given type.
+func (s *accountServiceDirectorySettings) service(serviceType string) *ldapServiceSettings {
+	if serviceType == LDAP_SERVICE_TYPE_ACTIVE_DIRECTORY {
+		return &s.ActiveDirectory
+	}
+	return &s.LDAP
+}
+
+// applyLdapSettings sends to iRMC only these settings from plan, which are known and differ from current ones.
+// Bind password is write-only and not reported by iRMC, so it's sent (taken from config) only on create or if its version
+// differs from state.
+func applyLdapSettings(ctx context.Context, api *gofish.APIClient, vendor *OemVendor, plan *models.LdapResourceModel,
+	config *models.LdapResourceModel, state *models.LdapResourceModel) error {
+	var settings accountServiceDirectorySettings
+	etag, err := getRedfishResource(api, ACCOUNT_SERVICE_ENDPOINT, &settings)
+	if err != nil {
+		return err
+	}
+	current := settings.service(plan.ServiceType.ValueString())
+
+	service := map[string]interface{}{}
+	if !plan.Enabled.IsNull() && !plan.Enabled.IsUnknown() && plan.Enabled.ValueBool() != current.ServiceEnabled {
+		service["ServiceEnabled"] = plan.Enabled.ValueBool()
+	}
+
+	if !plan.ServiceAddresses.IsNull() && !plan.ServiceAddresses.IsUnknown() {
+		var addresses []string
+		if diags := plan.ServiceAddresses.ElementsAs(ctx, &addresses, false); diags.HasError() {
+			return fmt.Errorf("could not read planned service addresses")
+		}
+		if !slices.Equal(addresses, nonEmptyStrings(current.ServiceAddresses)) {
+			service["ServiceAddresses"] = padStrings(addresses, len(current.ServiceAddresses))
+		}
+	}
+
+	authentication := map[string]interface{}{}
+	if !plan.BindUsername.IsNull() && !plan.BindUsername.IsUnknown() && plan.BindUsername.ValueString() != current.Authentication.Username {
+		authentication["Username"] = plan.BindUsername.ValueString()
+	}
+	if !config.BindPassword.IsNull() && (state == nil || !plan.BindPasswordVersion.Equal(state.BindPasswordVersion)) {
+		authentication["Password"] = config.BindPassword.ValueString()
+	}
+	if len(authentication) > 0 {
+		authentication["AuthenticationType"] = "UsernameAndPassword"
+		service["Authentication"] = authentication
+	}
+
+	search := map[string]interface{}{}
+	if !plan.BaseDistinguishedNames.IsNull() && !plan.BaseDistinguishedNames.IsUnknown() {
+		var names []string
+		if diags := plan.BaseDistinguishedNames.ElementsAs(ctx, &names, false); diags.HasError() {
+			return fmt.Errorf("could not read planned base distinguished names")
+		}
+		if !slices.Equal(names, nonEmptyStrings(current.LDAPService.SearchSettings.BaseDistinguishedNames)) {
+			search["BaseDistinguishedNames"] = padStrings(names, len(current.LDAPService.SearchSettings.BaseDistinguishedNames))
+		}
+	}
+	if !plan.UsernameAttribute.IsNull() && !plan.UsernameAttribute.IsUnknown() && plan.UsernameAttribute.ValueString() != current.LDAPService.SearchSettings.UsernameAttribute {
+		search["UsernameAttribute"] = plan.UsernameAttribute.ValueString()
+	}
+	if !plan.GroupsAttribute.IsNull() && !plan.GroupsAttribute.IsUnknown() && plan.GroupsAttribute.ValueString() != current.LDAPService.SearchSettings.GroupsAttribute {
+		search["GroupsAttribute"] = plan.GroupsAttribute.ValueString()
+	}
+	if len(search) > 0 {
+		service["LDAPService"] = map[string]interface{}{"SearchSettings": search}
+	}
+
+	mappings := []ldapRoleMappingSettings{}
+	for _, mapping := range plan.RoleMappings {
+		mappings = append(mappings, ldapRoleMappingSettings{
+			RemoteGroup: mapping.RemoteGroup.ValueString(),
+			LocalRole:   mapping.LocalRole.ValueString(),
+		})
+	}
+	if !slices.Equal(mappings, current.RemoteRoleMapping) && (len(mappings) > 0 || len(current.RemoteRoleMapping) > 0) {
+		service["RemoteRoleMapping"] = mappings
+	}
+
+	if !plan.VerifyCertificate.IsNull() && !plan.VerifyCertificate.IsUnknown() {
+		oem := current.Oem.Get()
+		if oem == nil || oem.VerifyCertificate == nil || *oem.VerifyCertificate != plan.VerifyCertificate.ValueBool() {
+			service["Oem"] = NewOemObject(vendor, &ldapOem{VerifyCertificate: plan.VerifyCertificate.ValueBoolPointer()})
+		}
+	}
+
+	if len(service) == 0 {
+		return nil
+	}
+
+	tflog.Info(ctx, "Changing directory service settings", map[string]interface{}{
+		"service_type":           plan.ServiceType.ValueString(),
+		"role_mappings_changed":  service["RemoteRoleMapping"] != nil,
+		"authentication_changed": service["Authentication"] != nil,
+	})
+	return patchRedfishResource(api, ACCOUNT_SERVICE_ENDPOINT, etag, map[string]interface{}{plan.ServiceType.ValueString(): service})
+}
+
+// readLdapSettingsToModel reads current settings of directory service of iRMC into model, so changes done
+// outside of Terraform are detected. Bind password is write-only, so it's never kept in model, while its version is kept as it is.
+func readLdapSettingsToModel(ctx context.Context, api *gofish.APIClient, model *models.LdapResourceModel) (diags diag.Diagnostics) {
+	var settings accountServiceDirectorySettings
+	if _, err := getRedfishResource(api, ACCOUNT_SERVICE_ENDPOINT, &settings); err != nil {
+		diags.AddError("Error while reading directory service settings", err.Error())
+		return diags
+	}
+	service := settings.service(model.ServiceType.ValueString())
+
+	model.Id = types.StringValue(ACCOUNT_SERVICE_ENDPOINT)
+	model.Enabled = types.BoolValue(service.ServiceEnabled)
+	model.UsernameAttribute = types.StringValue(service.LDAPService.SearchSettings.UsernameAttribute)
+	model.GroupsAttribute = types.StringValue(service.LDAPService.SearchSettings.GroupsAttribute)
+	model.BindUsername = types.StringValue(service.Authentication.Username)
+	model.BindPassword = types.StringNull()
+
+	var d diag.Diagnostics
+	model.ServiceAddresses, d = types.ListValueFrom(ctx, types.StringType, nonEmptyStrings(service.ServiceAddresses))
+	diags.Append(d...)
+	model.BaseDistinguishedNames, d = types.ListValueFrom(ctx, types.StringType, nonEmptyStrings(service.LDAPService.SearchSettings.BaseDistinguishedNames))
+	diags.Append(d...)
+
+	model.VerifyCertificate = types.BoolNull()
+	if oem := service.Oem.Get(); oem != nil && oem.VerifyCertificate != nil {
+		model.VerifyCertificate = types.BoolValue(*oem.VerifyCertificate)
+	}
+
+	model.RoleMappings = []models.LdapRoleMapping{}
+	for _, mapping := range service.RemoteRoleMapping {
+		model.RoleMappings = append(model.RoleMappings, models.LdapRoleMapping{
+			RemoteGroup: types.StringValue(mapping.RemoteGroup),
+			LocalRole:   types.StringValue(mapping.LocalRole),
+		})
+	}
+
+	return diags
+}
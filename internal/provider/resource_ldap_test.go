@@ -0,0 +1,289 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+const resource_ldap_name = "irmc-redfish_ldap.ldap"
+
+func TestAccRedfishLdap_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishResourceLdapConfig(creds, "Administrator"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resource_ldap_name, "enabled", "true"),
+					resource.TestCheckResourceAttr(resource_ldap_name, "service_addresses.#", "1"),
+					resource.TestCheckResourceAttr(resource_ldap_name, "role_mapping.#", "1"),
+					resource.TestCheckResourceAttr(resource_ldap_name, "role_mapping.0.local_role", "Administrator"),
+				),
+			},
+			{
+				Config: testAccRedfishResourceLdapConfig(creds, "ReadOnly"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resource_ldap_name, "role_mapping.0.local_role", "ReadOnly"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRedfishLdap_import(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:       `resource "irmc-redfish_ldap" "ldap" {}`,
+				ResourceName: resource_ldap_name,
+				ImportState:  true,
+				ImportStateIdFunc: func(d *terraform.State) (string, error) {
+					return fmt.Sprintf("{\"username\":\"%s\", \"password\":\"%s\", \"endpoint\":\"https://%s\", \"ssl_insecure\":true, \"service_type\":\"ActiveDirectory\"}",
+						creds.Username, creds.Password, creds.Endpoint), nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccRedfishLdap_negative(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRedfishResourceLdapConfig(creds, "Operators"),
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+			},
+		},
+	})
+}
+
+func testAccRedfishResourceLdapConfig(testingInfo TestingServerCredentials, role string) string {
+	return fmt.Sprintf(`
+	resource "irmc-redfish_ldap" "ldap" {
+
+		server {
+		  username     = "%s"
+		  password     = "%s"
+		  endpoint     = "https://%s"
+		  ssl_insecure = true
+		}
+
+		service_type             = "ActiveDirectory"
+		enabled                  = true
+		service_addresses        = ["ldaps://192.0.2.50:636"]
+		base_distinguished_names = ["DC=example,DC=com"]
+		bind_username            = "CN=irmc,OU=Services,DC=example,DC=com"
+		bind_password            = "bind-secret"
+		verify_certificate       = false
+
+		role_mapping {
+		  remote_group = "iRMC-Admins"
+		  local_role   = "%s"
+		}
+	  }
+	`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		role,
+	)
+}
+
+func TestValidateLdapConfig(t *testing.T) {
+	config := models.LdapResourceModel{
+		Enabled:          types.BoolValue(true),
+		ServiceAddresses: types.ListValueMust(types.StringType, []attr.Value{}),
+		BindUsername:     types.StringNull(),
+		BindPassword:     types.StringValue("secret"),
+		RoleMappings: []models.LdapRoleMapping{
+			{RemoteGroup: types.StringValue("iRMC-Admins"), LocalRole: types.StringValue("Administrator")},
+			{RemoteGroup: types.StringValue("iRMC-Admins"), LocalRole: types.StringValue("ReadOnly")},
+		},
+	}
+
+	diags := validateLdapConfig(config)
+	if diags.ErrorsCount() != 3 {
+		t.Errorf("Expected errors for servers, password and duplicated group, got %v", diags)
+	}
+
+	config.ServiceAddresses = types.ListUnknown(types.StringType)
+	config.BindUsername = types.StringValue("CN=irmc,DC=example,DC=com")
+	config.RoleMappings = config.RoleMappings[:1]
+	if diags = validateLdapConfig(config); diags.HasError() {
+		t.Errorf("Unexpected errors %v", diags)
+	}
+}
+
+func TestApplyLdapSettings(t *testing.T) {
+	forEachMockFlavor(t, func(t *testing.T, m *mockRedfishServer) {
+		api := m.connect()
+		ctx := context.Background()
+
+		config := models.LdapResourceModel{
+			ServiceType:            types.StringValue(LDAP_SERVICE_TYPE_ACTIVE_DIRECTORY),
+			Enabled:                types.BoolValue(true),
+			ServiceAddresses:       types.ListValueMust(types.StringType, []attr.Value{types.StringValue("ldaps://192.0.2.50:636")}),
+			BaseDistinguishedNames: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("DC=example,DC=com")}),
+			UsernameAttribute:      types.StringUnknown(),
+			GroupsAttribute:        types.StringUnknown(),
+			BindUsername:           types.StringValue("CN=irmc,DC=example,DC=com"),
+			BindPassword:           types.StringValue("bind-secret"),
+			BindPasswordVersion:    types.Int64Value(1),
+			VerifyCertificate:      types.BoolValue(true),
+			RoleMappings: []models.LdapRoleMapping{
+				{RemoteGroup: types.StringValue("iRMC-Admins"), LocalRole: types.StringValue("Administrator")},
+			},
+		}
+
+		// Write-only password is never part of plan
+		plan := config
+		plan.BindPassword = types.StringNull()
+
+		if err := applyLdapSettings(ctx, api, m.vendor(), &plan, &config, nil); err != nil {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+
+		settings, _ := m.get(ACCOUNT_SERVICE_ENDPOINT)["ActiveDirectory"].(map[string]interface{})
+		mappings, _ := settings["RemoteRoleMapping"].([]interface{})
+		if settings["ServiceEnabled"] != true || len(mappings) != 1 {
+			t.Errorf("Unexpected directory service settings %v", settings)
+		}
+		oemObject, _ := settings["Oem"].(map[string]interface{})
+		oem, _ := oemObject[m.oemKey].(map[string]interface{})
+		if oem["VerifyCertificate"] != true {
+			t.Errorf("Unexpected OEM directory service settings %v", oem)
+		}
+
+		patches := m.requestsTo(http.MethodPatch, ACCOUNT_SERVICE_ENDPOINT)
+		if len(patches) != 1 {
+			t.Fatalf("Expected 1 PATCH request, got %d", len(patches))
+		}
+		var body map[string]map[string]interface{}
+		_ = json.Unmarshal(patches[0].Raw, &body)
+		if _, ok := body["LDAP"]; ok {
+			t.Errorf("LDAP settings must not be sent when Active Directory is managed, got %v", body)
+		}
+		authentication, _ := body["ActiveDirectory"]["Authentication"].(map[string]interface{})
+		if authentication["Password"] != "bind-secret" {
+			t.Errorf("Bind password must be sent on create, got %v", authentication)
+		}
+
+		// Settings equal to current ones and password of unchanged version must not be sent again
+		state := plan
+		config.BindPassword = types.StringValue("changed-secret")
+		if err := applyLdapSettings(ctx, api, m.vendor(), &plan, &config, &state); err != nil {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+		if count := len(m.requestsTo(http.MethodPatch, ACCOUNT_SERVICE_ENDPOINT)); count != 1 {
+			t.Errorf("Got %d PATCH requests for unchanged settings, expected none", count-1)
+		}
+
+		plan.BindPasswordVersion = types.Int64Value(2)
+		if err := applyLdapSettings(ctx, api, m.vendor(), &plan, &config, &state); err != nil {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+		patches = m.requestsTo(http.MethodPatch, ACCOUNT_SERVICE_ENDPOINT)
+		body = nil
+		_ = json.Unmarshal(patches[len(patches)-1].Raw, &body)
+		if authentication, _ = body["ActiveDirectory"]["Authentication"].(map[string]interface{}); authentication["Password"] != "changed-secret" {
+			t.Errorf("Bind password must be sent when its version changes, got %v", body)
+		}
+		state = plan
+
+		// Removed role mappings must be removed from iRMC
+		plan.RoleMappings = []models.LdapRoleMapping{}
+		if err := applyLdapSettings(ctx, api, m.vendor(), &plan, &config, &state); err != nil {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+		settings, _ = m.get(ACCOUNT_SERVICE_ENDPOINT)["ActiveDirectory"].(map[string]interface{})
+		if mappings, _ = settings["RemoteRoleMapping"].([]interface{}); len(mappings) != 0 {
+			t.Errorf("Expected no role mappings, got %v", mappings)
+		}
+
+		// Slots of removed servers must be cleared
+		plan.ServiceAddresses = types.ListValueMust(types.StringType, []attr.Value{
+			types.StringValue("ldaps://192.0.2.50:636"), types.StringValue("ldaps://192.0.2.51:636"),
+		})
+		if err := applyLdapSettings(ctx, api, m.vendor(), &plan, &config, &state); err != nil {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+		plan.ServiceAddresses = types.ListValueMust(types.StringType, []attr.Value{types.StringValue("ldaps://192.0.2.51:636")})
+		if err := applyLdapSettings(ctx, api, m.vendor(), &plan, &config, &state); err != nil {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+		settings, _ = m.get(ACCOUNT_SERVICE_ENDPOINT)["ActiveDirectory"].(map[string]interface{})
+		if addresses := fmt.Sprint(settings["ServiceAddresses"]); addresses != "[ldaps://192.0.2.51:636 ]" {
+			t.Errorf("Unexpected service addresses %s", addresses)
+		}
+	})
+}
+
+func TestReadLdapSettingsToModel(t *testing.T) {
+	m := newMockRedfishServer(t, FSAS)
+	api := m.connect()
+
+	// Change done outside of Terraform must be visible in state
+	m.update(ACCOUNT_SERVICE_ENDPOINT, map[string]interface{}{
+		"LDAP": map[string]interface{}{
+			"ServiceEnabled":   true,
+			"ServiceAddresses": []interface{}{"192.0.2.51", ""},
+			"RemoteRoleMapping": []interface{}{map[string]interface{}{
+				"RemoteGroup": "operators", "LocalRole": "Operator",
+			}},
+		},
+	})
+
+	model := models.LdapResourceModel{
+		ServiceType:         types.StringValue(LDAP_SERVICE_TYPE_LDAP),
+		BindPasswordVersion: types.Int64Value(3),
+	}
+	if diags := readLdapSettingsToModel(context.Background(), api, &model); diags.HasError() {
+		t.Fatalf("Unexpected errors %v", diags)
+	}
+
+	if !model.Enabled.ValueBool() || model.Id.ValueString() != ACCOUNT_SERVICE_ENDPOINT || model.UsernameAttribute.ValueString() != "uid" {
+		t.Errorf("Unexpected model %v", model)
+	}
+
+	if len(model.ServiceAddresses.Elements()) != 1 || len(model.BaseDistinguishedNames.Elements()) != 0 {
+		t.Errorf("Empty address slots must be skipped, got %v and %v", model.ServiceAddresses, model.BaseDistinguishedNames)
+	}
+
+	if len(model.RoleMappings) != 1 || model.RoleMappings[0].LocalRole.ValueString() != "Operator" {
+		t.Errorf("Unexpected role mappings %v", model.RoleMappings)
+	}
+
+	if !model.BindPassword.IsNull() || model.BindPasswordVersion.ValueInt64() != 3 || model.VerifyCertificate.ValueBool() {
+		t.Errorf("Unexpected bind password or certificate verification in model %v", model)
+	}
+}
@@ -0,0 +1,839 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"path"
+	"regexp"
+	"slices"
+	"time"
+
+	"terraform-provider-irmc-redfish/internal/models"
+	"terraform-provider-irmc-redfish/internal/validators"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	tkpath "github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/stmcginnis/gofish"
+	"github.com/stmcginnis/gofish/common"
+)
+
+const (
+	ETHERNET_INTERFACES_PATH = "EthernetInterfaces"
+	DHCPV6_MODE_STATEFUL     = "Stateful"
+	DHCPV6_MODE_DISABLED     = "Disabled"
+)
+
+// networkChangeDelay is the time iRMC needs to apply changed network settings, before it's
+// reachable again.
+var networkChangeDelay = 15 * time.Second
+
+var hostNameRegex = regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9-]*[a-zA-Z0-9])?$`)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ManagerNetworkResource{}
+var _ resource.ResourceWithImportState = &ManagerNetworkResource{}
+var _ resource.ResourceWithValidateConfig = &ManagerNetworkResource{}
+var _ resource.ResourceWithModifyPlan = &ManagerNetworkResource{}
+
+func NewManagerNetworkResource() resource.Resource {
+	return &ManagerNetworkResource{}
+}
+
+// ManagerNetworkResource defines the resource implementation.
+type ManagerNetworkResource struct {
+	p *IrmcProvider
+}
+
+func (r *ManagerNetworkResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + managerNetworkName
+}
+
+func ManagerNetworkSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "ID of iRMC ethernet interface resource.",
+			Description:         "ID of iRMC ethernet interface resource.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"interface_id": schema.StringAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Id of managed member of iRMC EthernetInterfaces collection. If not set, first interface reported by iRMC is used.",
+			Description:         "Id of managed member of iRMC EthernetInterfaces collection. If not set, first interface reported by iRMC is used.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplaceIfConfigured(),
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"hostname": schema.StringAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Host name of iRMC. If not set, current value is kept.",
+			Description:         "Host name of iRMC. If not set, current value is kept.",
+			Validators: []validator.String{
+				stringvalidator.LengthBetween(1, 63),
+				stringvalidator.RegexMatches(hostNameRegex, "must contain only letters, digits and hyphens and must not start or end with hyphen"),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"dhcp_enabled": schema.BoolAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Indicates whether IPv4 address of iRMC is assigned by DHCP. If not set, current value is kept.",
+			Description:         "Indicates whether IPv4 address of iRMC is assigned by DHCP. If not set, current value is kept.",
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"ipv4_address": schema.StringAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Static IPv4 address of iRMC. Can be set only if DHCP is disabled. If not set, current value is kept.",
+			Description:         "Static IPv4 address of iRMC. Can be set only if DHCP is disabled. If not set, current value is kept.",
+			Validators: []validator.String{
+				validators.IsIPv4Address(),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"ipv4_subnet_mask": schema.StringAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "IPv4 subnet mask of iRMC (e.g. 255.255.255.0). Can be set only if DHCP is disabled. If not set, current value is kept.",
+			Description:         "IPv4 subnet mask of iRMC (e.g. 255.255.255.0). Can be set only if DHCP is disabled. If not set, current value is kept.",
+			Validators: []validator.String{
+				validators.IsSubnetMask(),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"ipv4_gateway": schema.StringAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "IPv4 default gateway of iRMC. Can be set only if DHCP is disabled. If not set, current value is kept.",
+			Description:         "IPv4 default gateway of iRMC. Can be set only if DHCP is disabled. If not set, current value is kept.",
+			Validators: []validator.String{
+				validators.IsIPv4Address(),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"ipv6_dhcp_enabled": schema.BoolAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Indicates whether IPv6 address of iRMC is assigned by DHCPv6. If not set, current value is kept.",
+			Description:         "Indicates whether IPv6 address of iRMC is assigned by DHCPv6. If not set, current value is kept.",
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"ipv6_address": schema.StringAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Static IPv6 address of iRMC. Can be set only if DHCPv6 is disabled. If not set, current value is kept.",
+			Description:         "Static IPv6 address of iRMC. Can be set only if DHCPv6 is disabled. If not set, current value is kept.",
+			Validators: []validator.String{
+				validators.IsIPv6Address(),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"ipv6_prefix_length": schema.Int64Attribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Prefix length of static IPv6 address of iRMC. Can be set only if DHCPv6 is disabled. If not set, current value is kept.",
+			Description:         "Prefix length of static IPv6 address of iRMC. Can be set only if DHCPv6 is disabled. If not set, current value is kept.",
+			Validators: []validator.Int64{
+				int64validator.Between(1, 128),
+			},
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"ipv6_gateway": schema.StringAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Static IPv6 default gateway of iRMC. Can be set only if DHCPv6 is disabled. If not set, current value is kept.",
+			Description:         "Static IPv6 default gateway of iRMC. Can be set only if DHCPv6 is disabled. If not set, current value is kept.",
+			Validators: []validator.String{
+				validators.IsIPv6Address(),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"vlan_enabled": schema.BoolAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Indicates whether iRMC traffic is tagged with VLAN ID. If not set, current value is kept.",
+			Description:         "Indicates whether iRMC traffic is tagged with VLAN ID. If not set, current value is kept.",
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"vlan_id": schema.Int64Attribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "VLAN ID used by iRMC. If not set, current value is kept.",
+			Description:         "VLAN ID used by iRMC. If not set, current value is kept.",
+			Validators: []validator.Int64{
+				int64validator.Between(1, 4094),
+			},
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"dns_servers": schema.ListAttribute{
+			Optional:            true,
+			Computed:            true,
+			ElementType:         types.StringType,
+			MarkdownDescription: "Static DNS servers (IPv4 or IPv6 addresses) used by iRMC. If not set, current value is kept.",
+			Description:         "Static DNS servers (IPv4 or IPv6 addresses) used by iRMC. If not set, current value is kept.",
+			Validators: []validator.List{
+				listvalidator.UniqueValues(),
+				listvalidator.ValueStringsAre(stringvalidator.Any(validators.IsIPv4Address(), validators.IsIPv6Address())),
+			},
+			PlanModifiers: []planmodifier.List{
+				listplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"current_endpoint": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Endpoint under which iRMC has been reachable after last apply. It differs from the configured endpoint if address of iRMC used by endpoint has been changed by the resource; it's then used for following operations until configured endpoint is updated.",
+			Description:         "Endpoint under which iRMC has been reachable after last apply. It differs from the configured endpoint if address of iRMC used by endpoint has been changed by the resource; it's then used for following operations until configured endpoint is updated.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+	}
+}
+
+func (r *ManagerNetworkResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The resource is used to control (read, modify or import) network configuration (IPv4, IPv6, VLAN, host name and DNS servers) of iRMC on Fujitsu server equipped with iRMC controller.",
+		Description:         "The resource is used to control (read, modify or import) network configuration (IPv4, IPv6, VLAN, host name and DNS servers) of iRMC on Fujitsu server equipped with iRMC controller.",
+		Attributes:          ManagerNetworkSchema(),
+		Blocks:              RedfishServerResourceBlockMap(),
+	}
+}
+
+func (r *ManagerNetworkResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	p, ok := req.ProviderData.(*IrmcProvider)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *IrmcProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.p = p
+}
+
+func (r *ManagerNetworkResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config models.ManagerNetworkResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if isKnown(config.DhcpEnabled) && config.DhcpEnabled.ValueBool() {
+		for name, value := range map[string]attr.Value{
+			"ipv4_address":     config.Ipv4Address,
+			"ipv4_subnet_mask": config.Ipv4SubnetMask,
+			"ipv4_gateway":     config.Ipv4Gateway,
+		} {
+			if !value.IsNull() {
+				resp.Diagnostics.AddAttributeError(tkpath.Root(name), "Invalid network configuration",
+					fmt.Sprintf("Attribute %s cannot be set while IPv4 address is assigned by DHCP", name))
+			}
+		}
+	}
+
+	if isKnown(config.Ipv6DhcpEnabled) && config.Ipv6DhcpEnabled.ValueBool() {
+		for name, value := range map[string]attr.Value{
+			"ipv6_address":       config.Ipv6Address,
+			"ipv6_prefix_length": config.Ipv6Prefix,
+			"ipv6_gateway":       config.Ipv6Gateway,
+		} {
+			if !value.IsNull() {
+				resp.Diagnostics.AddAttributeError(tkpath.Root(name), "Invalid network configuration",
+					fmt.Sprintf("Attribute %s cannot be set while IPv6 address is assigned by DHCPv6", name))
+			}
+		}
+	}
+}
+
+// ModifyPlan marks addresses, which are not configured, as unknown if DHCP setting is going to be changed,
+// since they are then assigned by iRMC or DHCP server. Endpoint under which iRMC will be reachable is
+// unknown as well, if the address might change or configured endpoint has been changed.
+func (r *ManagerNetworkResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to adjust on creation (computed values are unknown anyway) or destruction
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var config, plan, state models.ManagerNetworkResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.DhcpEnabled.Equal(state.DhcpEnabled) {
+		if config.Ipv4Address.IsNull() {
+			plan.Ipv4Address = types.StringUnknown()
+		}
+		if config.Ipv4SubnetMask.IsNull() {
+			plan.Ipv4SubnetMask = types.StringUnknown()
+		}
+		if config.Ipv4Gateway.IsNull() {
+			plan.Ipv4Gateway = types.StringUnknown()
+		}
+	}
+
+	if !plan.Ipv6DhcpEnabled.Equal(state.Ipv6DhcpEnabled) {
+		if config.Ipv6Address.IsNull() {
+			plan.Ipv6Address = types.StringUnknown()
+		}
+		if config.Ipv6Prefix.IsNull() {
+			plan.Ipv6Prefix = types.Int64Unknown()
+		}
+		if config.Ipv6Gateway.IsNull() {
+			plan.Ipv6Gateway = types.StringUnknown()
+		}
+	}
+
+	if !plan.Ipv4Address.Equal(state.Ipv4Address) || !plan.Ipv6Address.Equal(state.Ipv6Address) ||
+		GetServerEndpoint(r.p, plan.RedfishServer) != GetServerEndpoint(r.p, state.RedfishServer) {
+		plan.CurrentEndpoint = types.StringUnknown()
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+func (r *ManagerNetworkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "resource-manager_network: create starts")
+
+	// Read Terraform plan data into the model
+	var plan models.ManagerNetworkResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan, plan.RedfishServer)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	tflog.Info(ctx, "resource-manager_network: create ends")
+}
+
+func (r *ManagerNetworkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "resource-manager_network: read starts")
+
+	// Read Terraform prior state data into the model
+	var state models.ManagerNetworkResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rserver := reachableServer(r.p, state.RedfishServer, state.RedfishServer, state.CurrentEndpoint)
+	api, err := ConnectTargetSystem(r.p, &rserver)
+	if err != nil {
+		resp.Diagnostics.AddError("service error: ", err.Error())
+		return
+	}
+
+	defer api.Logout()
+
+	endpoint, err := getManagerEthernetInterfaceEndpoint(api, state.InterfaceId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Manager Ethernet Interface Detection Failed", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(readManagerNetworkToModel(ctx, api, &state, endpoint)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.CurrentEndpoint = types.StringValue(GetServerEndpoint(r.p, rserver))
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	tflog.Info(ctx, "resource-manager_network: read ends")
+}
+
+func (r *ManagerNetworkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "resource-manager_network: update starts")
+
+	// Read Terraform plan and prior state data into the models
+	var plan, state models.ManagerNetworkResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rserver := reachableServer(r.p, plan.RedfishServer, state.RedfishServer, state.CurrentEndpoint)
+	resp.Diagnostics.Append(r.apply(ctx, &plan, rserver)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	tflog.Info(ctx, "resource-manager_network: update ends")
+}
+
+func (r *ManagerNetworkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "resource-manager_network: delete starts")
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "resource-manager_network: delete ends")
+}
+
+func (r *ManagerNetworkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Info(ctx, "resource-manager_network: import starts")
+
+	var config CommonImportConfig
+	err := json.Unmarshal([]byte(req.ID), &config)
+	if err != nil {
+		resp.Diagnostics.AddError("Error while unmarshalling import config", err.Error())
+		return
+	}
+
+	creds := importServerBlock(config.ServerConfig)
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, tkpath.Root("server"), creds)...)
+
+	if len(config.ID) > 0 {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, tkpath.Root("interface_id"), config.ID)...)
+	}
+
+	tflog.Info(ctx, "resource-manager_network: import ends")
+}
+
+// apply configures network settings requested by plan using rserver to reach iRMC. If the address
+// used by endpoint has been changed, iRMC is reached again under the new address, which is recorded
+// in plan as current endpoint.
+func (r *ManagerNetworkResource) apply(ctx context.Context, plan *models.ManagerNetworkResourceModel, rserver []models.RedfishServer) (diags diag.Diagnostics) {
+	// Provide synchronization
+	var serverEndpoint = GetServerEndpoint(r.p, rserver)
+	var resource_name = "resource-manager_network"
+	mutexPool.Lock(ctx, serverEndpoint, resource_name)
+	defer mutexPool.Unlock(ctx, serverEndpoint, resource_name)
+
+	// Connect to service
+	api, err := ConnectTargetSystem(r.p, &rserver)
+	if err != nil {
+		diags.AddError("service error: ", err.Error())
+		return diags
+	}
+
+	defer api.Logout()
+
+	endpoint, err := getManagerEthernetInterfaceEndpoint(api, plan.InterfaceId.ValueString())
+	if err != nil {
+		diags.AddError("Manager Ethernet Interface Detection Failed", err.Error())
+		return diags
+	}
+
+	var current ethernetInterfaceSettings
+	etag, err := getRedfishResource(api, endpoint, &current)
+	if err != nil {
+		diags.AddError("Error while reading iRMC network settings", err.Error())
+		return diags
+	}
+
+	payload, diags := managerNetworkPayload(ctx, plan, &current)
+	if diags.HasError() {
+		return diags
+	}
+
+	newEndpoint := serverEndpoint
+	if len(payload) > 0 {
+		if addresses, ok := payload["IPv4Addresses"].([]ipv4AddressSettings); ok {
+			newEndpoint = endpointAfterAddressChange(newEndpoint, current.ipv4().Address, addresses[0].Address)
+		}
+		if addresses, ok := payload["IPv6StaticAddresses"].([]ipv6StaticAddressSettings); ok {
+			newEndpoint = endpointAfterAddressChange(newEndpoint, current.ipv6().Address, addresses[0].Address)
+		}
+
+		tflog.Info(ctx, "Changing iRMC network settings", map[string]interface{}{"settings": payload})
+		err = patchRedfishResource(api, endpoint, etag, payload)
+		if err != nil {
+			// iRMC might drop the connection while applying new network settings, before it responds.
+			// Rejection of the request is reported as Redfish error instead.
+			var redfishErr *common.Error
+			if !isConnectivityAffected(payload) || errors.As(err, &redfishErr) {
+				diags.AddError("Error while applying iRMC network settings", err.Error())
+				return diags
+			}
+			tflog.Warn(ctx, "Connection to iRMC has been lost while applying network settings", map[string]interface{}{"error": err.Error()})
+		}
+
+		if isConnectivityAffected(payload) {
+			if enabled, ok := payload["DHCPv4"].(map[string]interface{}); ok && enabled["DHCPEnabled"] == true &&
+				endpointUsesAddress(serverEndpoint, current.ipv4().Address) {
+				diags.AddWarning("Address of iRMC is assigned by DHCP",
+					fmt.Sprintf("Endpoint %s uses address of iRMC, which is now assigned by DHCP and might change.", serverEndpoint))
+			}
+
+			tflog.Info(ctx, "Waiting for iRMC to apply network settings", map[string]interface{}{"endpoint": newEndpoint})
+			api, err = reconnectWithEndpoint(ctx, r.p, rserver, newEndpoint, networkChangeDelay)
+			if err != nil {
+				diags.AddError("iRMC has not been available after change of network settings", err.Error())
+				return diags
+			}
+
+			defer api.Logout()
+		}
+	}
+
+	if newEndpoint != serverEndpoint {
+		diags.AddWarning("Endpoint of iRMC has been changed",
+			fmt.Sprintf("iRMC is now reachable on %s, which is recorded as current_endpoint and used until endpoint defined in server block or provider configuration is updated.", newEndpoint))
+	}
+
+	diags.Append(readManagerNetworkToModel(ctx, api, plan, endpoint)...)
+	plan.CurrentEndpoint = types.StringValue(newEndpoint)
+	return diags
+}
+
+type ipv4AddressSettings struct {
+	Address    string `json:"Address"`
+	SubnetMask string `json:"SubnetMask"`
+	Gateway    string `json:"Gateway"`
+}
+
+type ipv6StaticAddressSettings struct {
+	Address      string `json:"Address"`
+	PrefixLength int64  `json:"PrefixLength"`
+}
+
+type ipv6GatewaySettings struct {
+	Address string `json:"Address"`
+}
+
+type vlanSettings struct {
+	VLANEnable bool  `json:"VLANEnable"`
+	VLANId     int64 `json:"VLANId"`
+}
+
+// ethernetInterfaceSettings contains properties of EthernetInterface resource managed by the resource.
+type ethernetInterfaceSettings struct {
+	HostName string `json:"HostName"`
+	DHCPv4   struct {
+		DHCPEnabled bool `json:"DHCPEnabled"`
+	} `json:"DHCPv4"`
+	DHCPv6 struct {
+		OperatingMode string `json:"OperatingMode"`
+	} `json:"DHCPv6"`
+	IPv4Addresses             []ipv4AddressSettings       `json:"IPv4Addresses"`
+	IPv6StaticAddresses       []ipv6StaticAddressSettings `json:"IPv6StaticAddresses"`
+	IPv6StaticDefaultGateways []ipv6GatewaySettings       `json:"IPv6StaticDefaultGateways"`
+	VLAN                      *vlanSettings               `json:"VLAN"`
+	StaticNameServers         []string                    `json:"StaticNameServers"`
+}
+
+func (s *ethernetInterfaceSettings) ipv4() ipv4AddressSettings {
+	if len(s.IPv4Addresses) > 0 {
+		return s.IPv4Addresses[0]
+	}
+	return ipv4AddressSettings{}
+}
+
+func (s *ethernetInterfaceSettings) ipv6() ipv6StaticAddressSettings {
+	if len(s.IPv6StaticAddresses) > 0 {
+		return s.IPv6StaticAddresses[0]
+	}
+	return ipv6StaticAddressSettings{}
+}
+
+func (s *ethernetInterfaceSettings) ipv6Gateway() string {
+	if len(s.IPv6StaticDefaultGateways) > 0 {
+		return s.IPv6StaticDefaultGateways[0].Address
+	}
+	return ""
+}
+
+func (s *ethernetInterfaceSettings) ipv6DhcpEnabled() bool {
+	return len(s.DHCPv6.OperatingMode) > 0 && s.DHCPv6.OperatingMode != DHCPV6_MODE_DISABLED
+}
+
+func isKnown(value attr.Value) bool {
+	return !value.IsNull() && !value.IsUnknown()
+}
+
+// getManagerEthernetInterfaceEndpoint returns path of managed EthernetInterface of iRMC. If interfaceId
+// is empty, first member of the collection is used.
+func getManagerEthernetInterfaceEndpoint(api *gofish.APIClient, interfaceId string) (string, error) {
+	manager, err := GetManagerEndpoint(api.Service)
+	if err != nil {
+		return "", err
+	}
+
+	collection := fmt.Sprintf("%s/%s", manager, ETHERNET_INTERFACES_PATH)
+	if len(interfaceId) > 0 {
+		return fmt.Sprintf("%s/%s", collection, interfaceId), nil
+	}
+
+	members, err := getCollectionMembers(api, collection)
+	if err != nil {
+		return "", err
+	}
+
+	if len(members) == 0 {
+		return "", fmt.Errorf("no ethernet interface has been reported by iRMC")
+	}
+
+	return members[0], nil
+}
+
+// managerNetworkPayload returns PATCH payload with settings from plan, which are known and differ from
+// current ones. Static addresses are sent only if related DHCP is going to be disabled.
+func managerNetworkPayload(ctx context.Context, plan *models.ManagerNetworkResourceModel, current *ethernetInterfaceSettings) (map[string]interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	payload := map[string]interface{}{}
+
+	if isKnown(plan.HostName) && plan.HostName.ValueString() != current.HostName {
+		payload["HostName"] = plan.HostName.ValueString()
+	}
+
+	dhcpEnabled := current.DHCPv4.DHCPEnabled
+	if isKnown(plan.DhcpEnabled) && plan.DhcpEnabled.ValueBool() != dhcpEnabled {
+		dhcpEnabled = plan.DhcpEnabled.ValueBool()
+		payload["DHCPv4"] = map[string]interface{}{"DHCPEnabled": dhcpEnabled}
+	}
+
+	if !dhcpEnabled {
+		address := current.ipv4()
+		changed := false
+		for _, field := range []struct {
+			planned types.String
+			value   *string
+		}{
+			{plan.Ipv4Address, &address.Address},
+			{plan.Ipv4SubnetMask, &address.SubnetMask},
+			{plan.Ipv4Gateway, &address.Gateway},
+		} {
+			if isKnown(field.planned) && field.planned.ValueString() != *field.value {
+				*field.value = field.planned.ValueString()
+				changed = true
+			}
+		}
+
+		if changed {
+			payload["IPv4Addresses"] = []ipv4AddressSettings{address}
+		}
+	}
+
+	ipv6DhcpEnabled := current.ipv6DhcpEnabled()
+	if isKnown(plan.Ipv6DhcpEnabled) && plan.Ipv6DhcpEnabled.ValueBool() != ipv6DhcpEnabled {
+		ipv6DhcpEnabled = plan.Ipv6DhcpEnabled.ValueBool()
+		mode := DHCPV6_MODE_DISABLED
+		if ipv6DhcpEnabled {
+			mode = DHCPV6_MODE_STATEFUL
+		}
+		payload["DHCPv6"] = map[string]interface{}{"OperatingMode": mode}
+	}
+
+	if !ipv6DhcpEnabled {
+		address := current.ipv6()
+		changed := false
+		if isKnown(plan.Ipv6Address) && plan.Ipv6Address.ValueString() != address.Address {
+			address.Address = plan.Ipv6Address.ValueString()
+			changed = true
+		}
+		if isKnown(plan.Ipv6Prefix) && plan.Ipv6Prefix.ValueInt64() != address.PrefixLength {
+			address.PrefixLength = plan.Ipv6Prefix.ValueInt64()
+			changed = true
+		}
+
+		if changed {
+			payload["IPv6StaticAddresses"] = []ipv6StaticAddressSettings{address}
+		}
+
+		if isKnown(plan.Ipv6Gateway) && plan.Ipv6Gateway.ValueString() != current.ipv6Gateway() {
+			payload["IPv6StaticDefaultGateways"] = []ipv6GatewaySettings{{Address: plan.Ipv6Gateway.ValueString()}}
+		}
+	}
+
+	vlan := map[string]interface{}{}
+	if isKnown(plan.VlanEnabled) && (current.VLAN == nil || plan.VlanEnabled.ValueBool() != current.VLAN.VLANEnable) {
+		vlan["VLANEnable"] = plan.VlanEnabled.ValueBool()
+	}
+	if isKnown(plan.VlanId) && (current.VLAN == nil || plan.VlanId.ValueInt64() != current.VLAN.VLANId) {
+		vlan["VLANId"] = plan.VlanId.ValueInt64()
+	}
+	if len(vlan) > 0 {
+		if current.VLAN == nil {
+			diags.AddError("Error while applying iRMC network settings", "VLAN is not supported by the ethernet interface")
+			return nil, diags
+		}
+		payload["VLAN"] = vlan
+	}
+
+	if isKnown(plan.DnsServers) {
+		var servers []string
+		diags.Append(plan.DnsServers.ElementsAs(ctx, &servers, false)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		if !slices.Equal(servers, nonEmptyStrings(current.StaticNameServers)) {
+			payload["StaticNameServers"] = padStrings(servers, len(current.StaticNameServers))
+		}
+	}
+
+	return payload, diags
+}
+
+// isConnectivityAffected reports whether iRMC might be unreachable for a while after applying payload.
+func isConnectivityAffected(payload map[string]interface{}) bool {
+	for _, property := range []string{"DHCPv4", "IPv4Addresses", "DHCPv6", "IPv6StaticAddresses", "IPv6StaticDefaultGateways", "VLAN"} {
+		if _, ok := payload[property]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// endpointUsesAddress reports whether endpoint points to iRMC using address instead of host name.
+func endpointUsesAddress(endpoint string, address string) bool {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return false
+	}
+
+	host, ip := net.ParseIP(u.Hostname()), net.ParseIP(address)
+	return host != nil && ip != nil && host.Equal(ip)
+}
+
+// endpointAfterAddressChange returns endpoint under which iRMC is reachable after its address has been
+// changed from oldAddress to newAddress. Endpoint is returned unchanged, if it does not point to oldAddress
+// (e.g. it uses host name).
+func endpointAfterAddressChange(endpoint string, oldAddress string, newAddress string) string {
+	u, err := url.Parse(endpoint)
+	if err != nil || !endpointUsesAddress(endpoint, oldAddress) {
+		return endpoint
+	}
+
+	if port := u.Port(); len(port) > 0 {
+		u.Host = net.JoinHostPort(newAddress, port)
+	} else if ip := net.ParseIP(newAddress); ip != nil && ip.To4() == nil {
+		u.Host = "[" + newAddress + "]"
+	} else {
+		u.Host = newAddress
+	}
+
+	return u.String()
+}
+
+// reachableServer returns server block used to reach iRMC. Endpoint recorded in state as current
+// endpoint replaces the configured one, as long as the configured endpoint has not been changed.
+func reachableServer(pconfig *IrmcProvider, rserver []models.RedfishServer, previous []models.RedfishServer, currentEndpoint types.String) []models.RedfishServer {
+	configured := GetServerEndpoint(pconfig, rserver)
+	if !isKnown(currentEndpoint) || len(currentEndpoint.ValueString()) == 0 ||
+		currentEndpoint.ValueString() == configured || configured != GetServerEndpoint(pconfig, previous) {
+		return rserver
+	}
+
+	return serverWithEndpoint(rserver, currentEndpoint.ValueString())
+}
+
+// readManagerNetworkToModel reads current network settings of iRMC into model, so changes done outside
+// of Terraform are detected.
+func readManagerNetworkToModel(ctx context.Context, api *gofish.APIClient, model *models.ManagerNetworkResourceModel, endpoint string) (diags diag.Diagnostics) {
+	var settings ethernetInterfaceSettings
+	if _, err := getRedfishResource(api, endpoint, &settings); err != nil {
+		diags.AddError("Error while reading iRMC network settings", err.Error())
+		return diags
+	}
+
+	address, ipv6 := settings.ipv4(), settings.ipv6()
+
+	model.Id = types.StringValue(endpoint)
+	model.InterfaceId = types.StringValue(path.Base(endpoint))
+	model.HostName = types.StringValue(settings.HostName)
+	model.DhcpEnabled = types.BoolValue(settings.DHCPv4.DHCPEnabled)
+	model.Ipv4Address = types.StringValue(address.Address)
+	model.Ipv4SubnetMask = types.StringValue(address.SubnetMask)
+	model.Ipv4Gateway = types.StringValue(address.Gateway)
+	model.Ipv6DhcpEnabled = types.BoolValue(settings.ipv6DhcpEnabled())
+	model.Ipv6Address = types.StringValue(ipv6.Address)
+	model.Ipv6Prefix = types.Int64Value(ipv6.PrefixLength)
+	model.Ipv6Gateway = types.StringValue(settings.ipv6Gateway())
+
+	if settings.VLAN != nil {
+		model.VlanEnabled = types.BoolValue(settings.VLAN.VLANEnable)
+		model.VlanId = types.Int64Value(settings.VLAN.VLANId)
+	} else {
+		model.VlanEnabled = types.BoolNull()
+		model.VlanId = types.Int64Null()
+	}
+
+	model.DnsServers, diags = types.ListValueFrom(ctx, types.StringType, nonEmptyStrings(settings.StaticNameServers))
+	return diags
+}
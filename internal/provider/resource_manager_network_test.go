@@ -0,0 +1,324 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"testing"
+
+	"terraform-provider-irmc-redfish/internal/models"
+	"terraform-provider-irmc-redfish/internal/validators"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	tkpath "github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+const (
+	resource_manager_network_name = "irmc-redfish_manager_network.network"
+	mockEthernetInterfaceEndpoint = mockManagerEndpoint + "/" + ETHERNET_INTERFACES_PATH + "/0"
+)
+
+func TestAccRedfishManagerNetwork_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishResourceManagerNetworkConfig(creds, `["8.8.8.8"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resource_manager_network_name, "dns_servers.#", "1"),
+					resource.TestCheckResourceAttr(resource_manager_network_name, "dns_servers.0", "8.8.8.8"),
+					resource.TestCheckResourceAttr(resource_manager_network_name, "current_endpoint", "https://"+creds.Endpoint),
+				),
+			},
+			{
+				Config: testAccRedfishResourceManagerNetworkConfig(creds, `["8.8.8.8", "8.8.4.4"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resource_manager_network_name, "dns_servers.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRedfishManagerNetwork_import(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:       `resource "irmc-redfish_manager_network" "network" {}`,
+				ResourceName: resource_manager_network_name,
+				ImportState:  true,
+				ImportStateIdFunc: func(d *terraform.State) (string, error) {
+					return fmt.Sprintf("{\"username\":\"%s\", \"password\":\"%s\", \"endpoint\":\"https://%s\", \"ssl_insecure\":true}",
+						creds.Username, creds.Password, creds.Endpoint), nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccRedfishManagerNetwork_negative(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				resource "irmc-redfish_manager_network" "network" {
+					server {
+					  username     = "%s"
+					  password     = "%s"
+					  endpoint     = "https://%s"
+					  ssl_insecure = true
+					}
+
+					dhcp_enabled = true
+					ipv4_address = "192.0.2.100"
+				}
+				`, creds.Username, creds.Password, creds.Endpoint),
+				ExpectError: regexp.MustCompile("Invalid network configuration"),
+			},
+		},
+	})
+}
+
+func testAccRedfishResourceManagerNetworkConfig(testingInfo TestingServerCredentials, dnsServers string) string {
+	return fmt.Sprintf(`
+	resource "irmc-redfish_manager_network" "network" {
+
+		server {
+		  username     = "%s"
+		  password     = "%s"
+		  endpoint     = "https://%s"
+		  ssl_insecure = true
+		}
+
+		dns_servers = %s
+	  }
+	`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		dnsServers,
+	)
+}
+
+func TestManagerNetworkApply(t *testing.T) {
+	defaultDelay := networkChangeDelay
+	networkChangeDelay = 0
+	defer func() { networkChangeDelay = defaultDelay }()
+
+	m := newMockRedfishServer(t, FSAS)
+	// Endpoint uses host name, so it remains valid after change of iRMC address
+	rserver := m.redfishServer()
+	rserver[0].Endpoint = types.StringValue(strings.Replace(m.URL, "127.0.0.1", "localhost", 1))
+
+	r := &ManagerNetworkResource{p: &IrmcProvider{clients: InitClientCacheInstance()}}
+	plan := models.ManagerNetworkResourceModel{
+		RedfishServer: rserver,
+		HostName:      types.StringValue("irmc-test"),
+		Ipv4Address:   types.StringValue("192.0.2.101"),
+		Ipv6Address:   types.StringUnknown(),
+		VlanId:        types.Int64Value(10),
+		DnsServers:    types.ListValueMust(types.StringType, []attr.Value{types.StringValue("192.0.2.53"), types.StringValue("192.0.2.54")}),
+	}
+
+	connects := len(m.requestsTo(http.MethodGet, "/redfish/v1"))
+	if diags := r.apply(context.Background(), &plan, rserver); diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+
+	// Client must connect again after change of address
+	if count := len(m.requestsTo(http.MethodGet, "/redfish/v1")) - connects; count != 2 {
+		t.Errorf("Got %d connections, expected 2", count)
+	}
+
+	settings := m.get(mockEthernetInterfaceEndpoint)
+	addresses, _ := settings["IPv4Addresses"].([]interface{})
+	if address, _ := addresses[0].(map[string]interface{}); address["Address"] != "192.0.2.101" || address["SubnetMask"] != "255.255.255.0" {
+		t.Errorf("Unexpected IPv4 settings %v", addresses)
+	}
+	if vlan, _ := settings["VLAN"].(map[string]interface{}); vlan["VLANId"] != float64(10) || vlan["VLANEnable"] != false {
+		t.Errorf("Unexpected VLAN settings %v", vlan)
+	}
+
+	if plan.CurrentEndpoint.ValueString() != rserver[0].Endpoint.ValueString() || plan.InterfaceId.ValueString() != "0" ||
+		plan.Ipv6Address.ValueString() != "2001:db8::100" || plan.HostName.ValueString() != "irmc-test" {
+		t.Errorf("Unexpected state after apply %v", plan)
+	}
+
+	// Settings equal to current ones must not be sent again
+	patches := len(m.requestsTo(http.MethodPatch, mockEthernetInterfaceEndpoint))
+	if diags := r.apply(context.Background(), &plan, rserver); diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+	if count := len(m.requestsTo(http.MethodPatch, mockEthernetInterfaceEndpoint)); count != patches {
+		t.Errorf("Got %d PATCH requests for unchanged settings, expected none", count-patches)
+	}
+
+	// Slot of removed DNS server must be cleared
+	plan.DnsServers = types.ListValueMust(types.StringType, []attr.Value{types.StringValue("192.0.2.54")})
+	if diags := r.apply(context.Background(), &plan, rserver); diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+	if servers := fmt.Sprint(m.get(mockEthernetInterfaceEndpoint)["StaticNameServers"]); servers != "[192.0.2.54  ]" {
+		t.Errorf("Unexpected DNS servers %s", servers)
+	}
+}
+
+func TestManagerNetworkPayload(t *testing.T) {
+	current := ethernetInterfaceSettings{
+		IPv4Addresses:     []ipv4AddressSettings{{Address: "192.0.2.100", SubnetMask: "255.255.255.0", Gateway: "192.0.2.1"}},
+		StaticNameServers: []string{"192.0.2.53", ""},
+	}
+	current.DHCPv6.OperatingMode = DHCPV6_MODE_DISABLED
+
+	// Addresses assigned by DHCP are not sent
+	plan := models.ManagerNetworkResourceModel{
+		DhcpEnabled:     types.BoolValue(true),
+		Ipv4Address:     types.StringUnknown(),
+		Ipv6DhcpEnabled: types.BoolValue(true),
+		DnsServers:      types.ListValueMust(types.StringType, []attr.Value{types.StringValue("192.0.2.53")}),
+	}
+	payload, diags := managerNetworkPayload(context.Background(), &plan, &current)
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+
+	if len(payload) != 2 || fmt.Sprint(payload["DHCPv4"]) != "map[DHCPEnabled:true]" || fmt.Sprint(payload["DHCPv6"]) != "map[OperatingMode:Stateful]" {
+		t.Errorf("Unexpected payload %v", payload)
+	}
+
+	// VLAN can't be configured on interface not supporting it
+	plan = models.ManagerNetworkResourceModel{VlanEnabled: types.BoolValue(true)}
+	if _, diags = managerNetworkPayload(context.Background(), &plan, &current); !diags.HasError() {
+		t.Errorf("Expected error for not supported VLAN")
+	}
+}
+
+func TestReadManagerNetworkToModel(t *testing.T) {
+	m := newMockRedfishServer(t, FSAS)
+	api := m.connect()
+
+	// Change done outside of Terraform must be visible in state
+	m.update(mockEthernetInterfaceEndpoint, map[string]interface{}{
+		"DHCPv4":            map[string]interface{}{"DHCPEnabled": true},
+		"DHCPv6":            map[string]interface{}{"OperatingMode": DHCPV6_MODE_STATEFUL},
+		"StaticNameServers": []interface{}{"", "192.0.2.54", ""},
+		"VLAN":              nil,
+	})
+
+	var model models.ManagerNetworkResourceModel
+	if diags := readManagerNetworkToModel(context.Background(), api, &model, mockEthernetInterfaceEndpoint); diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+
+	if !model.DhcpEnabled.ValueBool() || !model.Ipv6DhcpEnabled.ValueBool() || model.Ipv4Address.ValueString() != "192.0.2.100" ||
+		model.Ipv6Prefix.ValueInt64() != 64 || model.HostName.ValueString() != "irmc-mock" {
+		t.Errorf("Unexpected model %v", model)
+	}
+
+	if !model.VlanEnabled.IsNull() || !model.VlanId.IsNull() {
+		t.Errorf("VLAN settings should be null if not supported, got %v and %v", model.VlanEnabled, model.VlanId)
+	}
+
+	expected := types.ListValueMust(types.StringType, []attr.Value{types.StringValue("192.0.2.54")})
+	if !model.DnsServers.Equal(expected) {
+		t.Errorf("Got DNS servers %v, expected %v", model.DnsServers, expected)
+	}
+
+	if model.Id.ValueString() != mockEthernetInterfaceEndpoint || model.InterfaceId.ValueString() != "0" {
+		t.Errorf("Got id %s and interface id %s", model.Id.ValueString(), model.InterfaceId.ValueString())
+	}
+}
+
+func TestEndpointAfterAddressChange(t *testing.T) {
+	for _, test := range []struct {
+		endpoint string
+		old      string
+		new      string
+		expected string
+	}{
+		{"https://192.0.2.100", "192.0.2.100", "192.0.2.101", "https://192.0.2.101"},
+		{"https://192.0.2.100:8443/", "192.0.2.100", "192.0.2.101", "https://192.0.2.101:8443/"},
+		{"https://192.0.2.100", "192.0.2.99", "192.0.2.101", "https://192.0.2.100"},
+		{"https://irmc.example.com", "192.0.2.100", "192.0.2.101", "https://irmc.example.com"},
+		{"https://[2001:db8::100]", "2001:db8::100", "2001:db8::101", "https://[2001:db8::101]"},
+		{"https://[2001:db8::100]:8443", "2001:db8:0::100", "2001:db8::101", "https://[2001:db8::101]:8443"},
+	} {
+		if endpoint := endpointAfterAddressChange(test.endpoint, test.old, test.new); endpoint != test.expected {
+			t.Errorf("Got '%s' for %s changed from %s to %s, expected '%s'", endpoint, test.endpoint, test.old, test.new, test.expected)
+		}
+	}
+}
+
+func TestReachableServer(t *testing.T) {
+	configured := []models.RedfishServer{{Endpoint: types.StringValue("https://192.0.2.100")}}
+	updated := []models.RedfishServer{{Endpoint: types.StringValue("https://192.0.2.101")}}
+	current := types.StringValue("https://192.0.2.101")
+
+	// Endpoint recorded in state is used until configuration is updated
+	if server := reachableServer(nil, configured, configured, current); server[0].Endpoint.ValueString() != current.ValueString() {
+		t.Errorf("Got endpoint %s, expected %s", server[0].Endpoint.ValueString(), current.ValueString())
+	}
+
+	if server := reachableServer(nil, updated, configured, current); server[0].Endpoint.ValueString() != "https://192.0.2.101" {
+		t.Errorf("Got endpoint %s, expected configured one", server[0].Endpoint.ValueString())
+	}
+
+	if server := reachableServer(nil, configured, configured, types.StringNull()); server[0].Endpoint.ValueString() != "https://192.0.2.100" {
+		t.Errorf("Got endpoint %s, expected configured one", server[0].Endpoint.ValueString())
+	}
+}
+
+func TestManagerNetworkValidators(t *testing.T) {
+	validate := func(v validator.String, value string) bool {
+		resp := &validator.StringResponse{}
+		v.ValidateString(context.Background(), validator.StringRequest{
+			Path:        tkpath.Root("value"),
+			ConfigValue: types.StringValue(value),
+		}, resp)
+		return !resp.Diagnostics.HasError()
+	}
+
+	for _, test := range []struct {
+		validator validator.String
+		value     string
+		valid     bool
+	}{
+		{validators.IsIPv4Address(), "192.0.2.1", true},
+		{validators.IsIPv4Address(), "2001:db8::1", false},
+		{validators.IsIPv4Address(), "192.0.2", false},
+		{validators.IsIPv6Address(), "2001:db8::1", true},
+		{validators.IsIPv6Address(), "192.0.2.1", false},
+		{validators.IsSubnetMask(), "255.255.255.0", true},
+		{validators.IsSubnetMask(), "255.255.0.255", false},
+		{validators.IsSubnetMask(), "ffff::", false},
+	} {
+		if validate(test.validator, test.value) != test.valid {
+			t.Errorf("Value '%s' validation result should be %t", test.value, test.valid)
+		}
+	}
+}
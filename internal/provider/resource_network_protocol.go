@@ -0,0 +1,548 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"time"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	tkpath "github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/stmcginnis/gofish"
+)
+
+const (
+	HTTP_PROTOCOL      = "HTTP"
+	HTTPS_PROTOCOL     = "HTTPS"
+	HTTPS_DEFAULT_PORT = 443
+)
+
+// webServerRestartDelay is the time iRMC needs after change of web server ports before
+// the web server is restarted, so connections made earlier would reach the old instance.
+var webServerRestartDelay = 15 * time.Second
+
+// networkProtocols lists protocols managed by the resource with their names used by ManagerNetworkProtocol.
+var networkProtocols = []struct {
+	attribute string
+	property  string
+	field     func(model *models.NetworkProtocolResourceModel) **models.NetworkProtocolModel
+}{
+	{"http", HTTP_PROTOCOL, func(m *models.NetworkProtocolResourceModel) **models.NetworkProtocolModel { return &m.Http }},
+	{"https", HTTPS_PROTOCOL, func(m *models.NetworkProtocolResourceModel) **models.NetworkProtocolModel { return &m.Https }},
+	{"ssh", "SSH", func(m *models.NetworkProtocolResourceModel) **models.NetworkProtocolModel { return &m.Ssh }},
+	{"telnet", "Telnet", func(m *models.NetworkProtocolResourceModel) **models.NetworkProtocolModel { return &m.Telnet }},
+	{"ipmi", "IPMI", func(m *models.NetworkProtocolResourceModel) **models.NetworkProtocolModel { return &m.Ipmi }},
+	{"snmp", "SNMP", func(m *models.NetworkProtocolResourceModel) **models.NetworkProtocolModel { return &m.Snmp }},
+	{"kvmip", "KVMIP", func(m *models.NetworkProtocolResourceModel) **models.NetworkProtocolModel { return &m.Kvmip }},
+}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &NetworkProtocolResource{}
+var _ resource.ResourceWithImportState = &NetworkProtocolResource{}
+var _ resource.ResourceWithValidateConfig = &NetworkProtocolResource{}
+var _ resource.ResourceWithModifyPlan = &NetworkProtocolResource{}
+
+func NewNetworkProtocolResource() resource.Resource {
+	return &NetworkProtocolResource{}
+}
+
+// NetworkProtocolResource defines the resource implementation.
+type NetworkProtocolResource struct {
+	p *IrmcProvider
+}
+
+func (r *NetworkProtocolResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + networkProtocolName
+}
+
+func networkProtocolAttributeSchema(protocol string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Optional:            true,
+		MarkdownDescription: fmt.Sprintf("Settings of %s protocol. If not set, protocol settings are not managed.", protocol),
+		Description:         fmt.Sprintf("Settings of %s protocol. If not set, protocol settings are not managed.", protocol),
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Indicates whether protocol is enabled. If not set, current value is kept.",
+				Description:         "Indicates whether protocol is enabled. If not set, current value is kept.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"port": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Port used by protocol. If not set, current value is kept.",
+				Description:         "Port used by protocol. If not set, current value is kept.",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func NetworkProtocolSchema() map[string]schema.Attribute {
+	attributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "ID of network protocol settings resource on iRMC.",
+			Description:         "ID of network protocol settings resource on iRMC.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"current_endpoint": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Endpoint under which iRMC has been reachable after last apply. It differs from the configured endpoint if HTTPS port has been changed by the resource; it's then used for following operations until configured endpoint is updated.",
+			Description:         "Endpoint under which iRMC has been reachable after last apply. It differs from the configured endpoint if HTTPS port has been changed by the resource; it's then used for following operations until configured endpoint is updated.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+	}
+
+	for _, protocol := range networkProtocols {
+		attributes[protocol.attribute] = networkProtocolAttributeSchema(protocol.property)
+	}
+
+	return attributes
+}
+
+func (r *NetworkProtocolResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The resource is used to control (read, modify or import) network services (HTTP, HTTPS, SSH, Telnet, IPMI, SNMP, KVM) of iRMC on Fujitsu server equipped with iRMC controller.",
+		Description:         "The resource is used to control (read, modify or import) network services (HTTP, HTTPS, SSH, Telnet, IPMI, SNMP, KVM) of iRMC on Fujitsu server equipped with iRMC controller.",
+		Attributes:          NetworkProtocolSchema(),
+		Blocks:              RedfishServerResourceBlockMap(),
+	}
+}
+
+func (r *NetworkProtocolResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	p, ok := req.ProviderData.(*IrmcProvider)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *IrmcProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.p = p
+}
+
+func (r *NetworkProtocolResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config models.NetworkProtocolResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Redfish API itself is served over HTTPS, so disabling it would cut provider off the system
+	if config.Https != nil && !config.Https.Enabled.IsNull() && !config.Https.Enabled.IsUnknown() && !config.Https.Enabled.ValueBool() {
+		resp.Diagnostics.AddAttributeError(tkpath.Root("https").AtName("enabled"), "Invalid network protocol configuration",
+			"HTTPS protocol cannot be disabled, since it's used to access Redfish API")
+	}
+
+	ports := map[int64]string{}
+	for _, protocol := range networkProtocols {
+		settings := *protocol.field(&config)
+		if settings == nil || settings.Port.IsNull() || settings.Port.IsUnknown() {
+			continue
+		}
+
+		port := settings.Port.ValueInt64()
+		if other, ok := ports[port]; ok {
+			resp.Diagnostics.AddAttributeError(tkpath.Root(protocol.attribute).AtName("port"), "Invalid network protocol configuration",
+				fmt.Sprintf("Port %d is used by both %s and %s protocols", port, other, protocol.property))
+			continue
+		}
+		ports[port] = protocol.property
+	}
+}
+
+// ModifyPlan marks endpoint under which iRMC will be reachable as unknown, if HTTPS port is going
+// to be changed or configured endpoint has been changed.
+func (r *NetworkProtocolResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to adjust on creation (computed values are unknown anyway) or destruction
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state models.NetworkProtocolResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	portChanged := plan.Https != nil && !plan.Https.Port.IsNull() && (state.Https == nil || !plan.Https.Port.Equal(state.Https.Port))
+	if portChanged || GetServerEndpoint(r.p, plan.RedfishServer) != GetServerEndpoint(r.p, state.RedfishServer) {
+		plan.CurrentEndpoint = types.StringUnknown()
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+func (r *NetworkProtocolResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "resource-network_protocol: create starts")
+
+	// Read Terraform plan data into the model
+	var plan models.NetworkProtocolResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan, plan.RedfishServer)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	tflog.Info(ctx, "resource-network_protocol: create ends")
+}
+
+func (r *NetworkProtocolResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "resource-network_protocol: read starts")
+
+	// Read Terraform prior state data into the model
+	var state models.NetworkProtocolResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rserver := reachableServer(r.p, state.RedfishServer, state.RedfishServer, state.CurrentEndpoint)
+	api, err := ConnectTargetSystem(r.p, &rserver)
+	if err != nil {
+		resp.Diagnostics.AddError("service error: ", err.Error())
+		return
+	}
+
+	defer api.Logout()
+
+	endpoint, err := getNetworkProtocolEndpoint(api.Service)
+	if err != nil {
+		resp.Diagnostics.AddError("Manager Resource Detection Failed", err.Error())
+		return
+	}
+
+	// Just imported resource does not have any protocol in state yet, so all of them are read
+	err = readNetworkProtocolToModel(api, &state, endpoint, state.Id.IsNull())
+	if err != nil {
+		resp.Diagnostics.AddError("Error while reading network protocol settings", err.Error())
+		return
+	}
+	state.CurrentEndpoint = types.StringValue(GetServerEndpoint(r.p, rserver))
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	tflog.Info(ctx, "resource-network_protocol: read ends")
+}
+
+func (r *NetworkProtocolResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "resource-network_protocol: update starts")
+
+	// Read Terraform plan and prior state data into the models
+	var plan, state models.NetworkProtocolResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rserver := reachableServer(r.p, plan.RedfishServer, state.RedfishServer, state.CurrentEndpoint)
+	resp.Diagnostics.Append(r.apply(ctx, &plan, rserver)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	tflog.Info(ctx, "resource-network_protocol: update ends")
+}
+
+func (r *NetworkProtocolResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "resource-network_protocol: delete starts")
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "resource-network_protocol: delete ends")
+}
+
+func (r *NetworkProtocolResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Info(ctx, "resource-network_protocol: import starts")
+
+	var config CommonImportConfig
+	err := json.Unmarshal([]byte(req.ID), &config)
+	if err != nil {
+		resp.Diagnostics.AddError("Error while unmarshalling import config", err.Error())
+		return
+	}
+
+	creds := importServerBlock(config.ServerConfig)
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, tkpath.Root("server"), creds)...)
+
+	tflog.Info(ctx, "resource-network_protocol: import ends")
+}
+
+// apply configures protocols requested by plan using rserver to reach iRMC and reads their settings back
+// into plan. If ports of web server have been changed, it waits until iRMC web server is restarted and
+// connects to it again. Endpoint with new HTTPS port is recorded in plan as current endpoint.
+func (r *NetworkProtocolResource) apply(ctx context.Context, plan *models.NetworkProtocolResourceModel, rserver []models.RedfishServer) (diags diag.Diagnostics) {
+	// Provide synchronization
+	var serverEndpoint = GetServerEndpoint(r.p, rserver)
+	var resource_name = "resource-network_protocol"
+	mutexPool.Lock(ctx, serverEndpoint, resource_name)
+	defer mutexPool.Unlock(ctx, serverEndpoint, resource_name)
+
+	// Connect to service
+	api, err := ConnectTargetSystem(r.p, &rserver)
+	if err != nil {
+		diags.AddError("service error: ", err.Error())
+		return diags
+	}
+
+	defer api.Logout()
+
+	endpoint, err := getNetworkProtocolEndpoint(api.Service)
+	if err != nil {
+		diags.AddError("Manager Resource Detection Failed", err.Error())
+		return diags
+	}
+
+	changes, err := applyNetworkProtocolSettings(ctx, api, plan, endpoint)
+	if err != nil {
+		diags.AddError("Error while applying network protocol settings", err.Error())
+		return diags
+	}
+
+	newEndpoint := serverEndpoint
+	if isWebServerRestartRequired(changes) {
+		if https, ok := changes[HTTPS_PROTOCOL]; ok && https.Port != nil {
+			newEndpoint, err = endpointWithPort(serverEndpoint, *https.Port)
+			if err != nil {
+				diags.AddError("Error while preparing new endpoint of iRMC", err.Error())
+				return diags
+			}
+		}
+
+		tflog.Info(ctx, "Waiting for restart of iRMC web server", map[string]interface{}{"endpoint": newEndpoint})
+		api, err = reconnectWithEndpoint(ctx, r.p, rserver, newEndpoint, webServerRestartDelay)
+		if err != nil {
+			diags.AddError("iRMC web server has not been available after change of ports", err.Error())
+			return diags
+		}
+
+		defer api.Logout()
+	}
+
+	if newEndpoint != serverEndpoint {
+		diags.AddWarning("Endpoint of iRMC has been changed",
+			fmt.Sprintf("iRMC web server accepts now connections on %s, which is recorded as current_endpoint and used until endpoint defined in server block or provider configuration is updated.", newEndpoint))
+	}
+
+	err = readNetworkProtocolToModel(api, plan, endpoint, false)
+	if err != nil {
+		diags.AddError("Error while reading network protocol settings", err.Error())
+		return diags
+	}
+	plan.CurrentEndpoint = types.StringValue(newEndpoint)
+
+	return diags
+}
+
+type protocolSettings struct {
+	ProtocolEnabled *bool  `json:"ProtocolEnabled,omitempty"`
+	Port            *int64 `json:"Port,omitempty"`
+}
+
+// getNetworkProtocolEndpoint returns path of ManagerNetworkProtocol resource of managed iRMC.
+func getNetworkProtocolEndpoint(service *gofish.Service) (string, error) {
+	manager, err := GetManagerEndpoint(service)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s/%s", manager, NETWORK_PROTOCOL_PATH), nil
+}
+
+// readProtocolSettings returns settings of protocols reported in ManagerNetworkProtocol resource together with its ETag.
+func readProtocolSettings(api *gofish.APIClient, endpoint string) (map[string]protocolSettings, string, error) {
+	var resource map[string]json.RawMessage
+	etag, err := getRedfishResource(api, endpoint, &resource)
+	if err != nil {
+		return nil, "", err
+	}
+
+	protocols := map[string]protocolSettings{}
+	for _, protocol := range networkProtocols {
+		data, ok := resource[protocol.property]
+		if !ok {
+			continue
+		}
+
+		var settings protocolSettings
+		if err = json.Unmarshal(data, &settings); err != nil {
+			return nil, "", fmt.Errorf("error during unmarshal of %s protocol settings '%w'", protocol.property, err)
+		}
+		protocols[protocol.property] = settings
+	}
+
+	return protocols, etag, nil
+}
+
+// applyNetworkProtocolSettings sends to iRMC only protocol settings from plan, which are known and differ
+// from current ones. Settings which have been sent are returned.
+func applyNetworkProtocolSettings(ctx context.Context, api *gofish.APIClient, plan *models.NetworkProtocolResourceModel, endpoint string) (map[string]protocolSettings, error) {
+	current, etag, err := readProtocolSettings(api, endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := map[string]protocolSettings{}
+	for _, protocol := range networkProtocols {
+		planned := *protocol.field(plan)
+		if planned == nil {
+			continue
+		}
+
+		settings, ok := current[protocol.property]
+		if !ok {
+			return nil, fmt.Errorf("protocol %s is not supported by the system", protocol.property)
+		}
+
+		var change protocolSettings
+		if !planned.Enabled.IsNull() && !planned.Enabled.IsUnknown() &&
+			(settings.ProtocolEnabled == nil || *settings.ProtocolEnabled != planned.Enabled.ValueBool()) {
+			change.ProtocolEnabled = planned.Enabled.ValueBoolPointer()
+		}
+		if !planned.Port.IsNull() && !planned.Port.IsUnknown() &&
+			(settings.Port == nil || *settings.Port != planned.Port.ValueInt64()) {
+			change.Port = planned.Port.ValueInt64Pointer()
+		}
+
+		if change.ProtocolEnabled != nil || change.Port != nil {
+			changes[protocol.property] = change
+		}
+	}
+
+	if len(changes) == 0 {
+		return changes, nil
+	}
+
+	tflog.Info(ctx, "Changing network protocol settings", map[string]interface{}{"protocols": changes})
+	if err = patchRedfishResource(api, endpoint, etag, changes); err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
+
+// isWebServerRestartRequired reports whether iRMC restarts its web server to apply changes of protocols.
+func isWebServerRestartRequired(changes map[string]protocolSettings) bool {
+	for _, protocol := range []string{HTTP_PROTOCOL, HTTPS_PROTOCOL} {
+		if change, ok := changes[protocol]; ok && change.Port != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// endpointWithPort returns endpoint pointing to the same host as endpoint, but on requested HTTPS port.
+func endpointWithPort(endpoint string, port int64) (string, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil || len(u.Host) == 0 {
+		return "", fmt.Errorf("endpoint '%s' is not valid URL", endpoint)
+	}
+
+	if port == HTTPS_DEFAULT_PORT {
+		u.Host = u.Hostname()
+		if ip := net.ParseIP(u.Host); ip != nil && ip.To4() == nil {
+			u.Host = "[" + u.Host + "]"
+		}
+	} else {
+		u.Host = net.JoinHostPort(u.Hostname(), strconv.FormatInt(port, 10))
+	}
+
+	return u.String(), nil
+}
+
+// readNetworkProtocolToModel reads current settings of protocols into model, so changes done outside
+// of Terraform are detected. Only protocols already present in model are read, unless all is set.
+func readNetworkProtocolToModel(api *gofish.APIClient, model *models.NetworkProtocolResourceModel, endpoint string, all bool) error {
+	current, _, err := readProtocolSettings(api, endpoint)
+	if err != nil {
+		return err
+	}
+
+	for _, protocol := range networkProtocols {
+		field := protocol.field(model)
+		if *field == nil && !all {
+			continue
+		}
+
+		settings, ok := current[protocol.property]
+		if !ok {
+			*field = nil
+			continue
+		}
+
+		*field = &models.NetworkProtocolModel{
+			Enabled: types.BoolPointerValue(settings.ProtocolEnabled),
+			Port:    types.Int64PointerValue(settings.Port),
+		}
+	}
+
+	model.Id = types.StringValue(endpoint)
+	return nil
+}
@@ -0,0 +1,252 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+const resource_network_protocol_name = "irmc-redfish_network_protocol.np"
+
+func TestAccRedfishNetworkProtocol_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishResourceNetworkProtocolConfig(creds, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resource_network_protocol_name, "telnet.enabled", "false"),
+					resource.TestCheckResourceAttr(resource_network_protocol_name, "ipmi.enabled", "false"),
+					resource.TestCheckResourceAttr(resource_network_protocol_name, "current_endpoint", "https://"+creds.Endpoint),
+				),
+			},
+			{
+				Config: testAccRedfishResourceNetworkProtocolConfig(creds, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resource_network_protocol_name, "telnet.enabled", "false"),
+					resource.TestCheckResourceAttr(resource_network_protocol_name, "ipmi.enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRedfishNetworkProtocol_import(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:       `resource "irmc-redfish_network_protocol" "np" {}`,
+				ResourceName: resource_network_protocol_name,
+				ImportState:  true,
+				ImportStateIdFunc: func(d *terraform.State) (string, error) {
+					return fmt.Sprintf("{\"username\":\"%s\", \"password\":\"%s\", \"endpoint\":\"https://%s\", \"ssl_insecure\":true}",
+						creds.Username, creds.Password, creds.Endpoint), nil
+				},
+			},
+		},
+	})
+}
+
+func testAccRedfishResourceNetworkProtocolConfig(testingInfo TestingServerCredentials, ipmiEnabled bool) string {
+	return fmt.Sprintf(`
+	resource "irmc-redfish_network_protocol" "np" {
+
+		server {
+		  username     = "%s"
+		  password     = "%s"
+		  endpoint     = "https://%s"
+		  ssl_insecure = true
+		}
+
+		telnet = {
+		  enabled = false
+		}
+
+		ipmi = {
+		  enabled = %t
+		}
+	  }
+	`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		ipmiEnabled,
+	)
+}
+
+func TestApplyNetworkProtocolSettings(t *testing.T) {
+	m := newMockRedfishServer(t, FSAS)
+	api := m.connect()
+	ctx := context.Background()
+	endpoint := mockManagerEndpoint + "/" + NETWORK_PROTOCOL_PATH
+
+	plan := models.NetworkProtocolResourceModel{
+		Telnet: &models.NetworkProtocolModel{Enabled: types.BoolValue(false), Port: types.Int64Unknown()},
+		Ssh:    &models.NetworkProtocolModel{Enabled: types.BoolUnknown(), Port: types.Int64Value(2222)},
+		Ipmi:   &models.NetworkProtocolModel{Enabled: types.BoolValue(true), Port: types.Int64Value(623)},
+	}
+
+	changes, err := applyNetworkProtocolSettings(ctx, api, &plan, endpoint)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+
+	// IPMI has already requested settings, so it must not be sent
+	if _, ok := changes["IPMI"]; len(changes) != 2 || ok || isWebServerRestartRequired(changes) {
+		t.Errorf("Unexpected changes %v", changes)
+	}
+
+	settings := m.get(endpoint)
+	if telnet, _ := settings["Telnet"].(map[string]interface{}); telnet["ProtocolEnabled"] != false || telnet["Port"] != float64(23) {
+		t.Errorf("Unexpected Telnet settings %v", telnet)
+	}
+	if ssh, _ := settings["SSH"].(map[string]interface{}); ssh["ProtocolEnabled"] != true || ssh["Port"] != float64(2222) {
+		t.Errorf("Unexpected SSH settings %v", ssh)
+	}
+
+	// Settings equal to current ones must not be sent again
+	patches := len(m.requestsTo(http.MethodPatch, endpoint))
+	if _, err := applyNetworkProtocolSettings(ctx, api, &plan, endpoint); err != nil {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+	if count := len(m.requestsTo(http.MethodPatch, endpoint)); count != patches {
+		t.Errorf("Got %d PATCH requests for unchanged settings, expected none", count-patches)
+	}
+
+	resource := m.get(endpoint)
+	delete(resource, "KVMIP")
+	m.set(endpoint, resource)
+
+	plan.Kvmip = &models.NetworkProtocolModel{Enabled: types.BoolValue(false), Port: types.Int64Unknown()}
+	if _, err := applyNetworkProtocolSettings(ctx, api, &plan, endpoint); err == nil {
+		t.Errorf("Expected error for not supported protocol")
+	}
+}
+
+func TestNetworkProtocolWebServerRestart(t *testing.T) {
+	defaultDelay := webServerRestartDelay
+	webServerRestartDelay = 0
+	defer func() { webServerRestartDelay = defaultDelay }()
+
+	m := newMockRedfishServer(t, FSAS)
+	// Web server restarted on new HTTPS port is emulated by another listener of the same mock
+	moved := httptest.NewTLSServer(http.HandlerFunc(m.serveHTTP))
+	defer moved.Close()
+	u, err := url.Parse(moved.URL)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+	port, _ := strconv.ParseInt(u.Port(), 10, 64)
+
+	r := &NetworkProtocolResource{p: &IrmcProvider{clients: InitClientCacheInstance()}}
+	plan := models.NetworkProtocolResourceModel{
+		RedfishServer: m.redfishServer(),
+		Https:         &models.NetworkProtocolModel{Enabled: types.BoolUnknown(), Port: types.Int64Value(port)},
+	}
+
+	connects := len(m.requestsTo(http.MethodGet, "/redfish/v1"))
+	if diags := r.apply(context.Background(), &plan, plan.RedfishServer); diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+
+	// Client must connect again after web server restart
+	if count := len(m.requestsTo(http.MethodGet, "/redfish/v1")) - connects; count != 2 {
+		t.Errorf("Got %d connections, expected 2", count)
+	}
+
+	if plan.Https.Port.ValueInt64() != port || !plan.Https.Enabled.ValueBool() || plan.Http != nil ||
+		plan.CurrentEndpoint.ValueString() != moved.URL {
+		t.Errorf("Unexpected state after apply %v", plan)
+	}
+
+	// Following runs must reach iRMC on recorded endpoint, although configured one has not been updated
+	m.Close()
+	rserver := reachableServer(r.p, plan.RedfishServer, plan.RedfishServer, plan.CurrentEndpoint)
+	if diags := r.apply(context.Background(), &plan, rserver); diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+
+	if plan.CurrentEndpoint.ValueString() != moved.URL {
+		t.Errorf("Got current endpoint %s, expected %s", plan.CurrentEndpoint.ValueString(), moved.URL)
+	}
+}
+
+func TestReadNetworkProtocolToModel(t *testing.T) {
+	m := newMockRedfishServer(t, FSAS)
+	api := m.connect()
+	endpoint := mockManagerEndpoint + "/" + NETWORK_PROTOCOL_PATH
+
+	// Change done outside of Terraform must be visible in state
+	m.update(endpoint, map[string]interface{}{"SSH": map[string]interface{}{"ProtocolEnabled": false}})
+
+	model := models.NetworkProtocolResourceModel{Ssh: &models.NetworkProtocolModel{}}
+	if err := readNetworkProtocolToModel(api, &model, endpoint, false); err != nil {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+
+	if model.Ssh.Enabled.ValueBool() || model.Ssh.Port.ValueInt64() != 22 || model.Https != nil {
+		t.Errorf("Unexpected model %v", model)
+	}
+
+	// After import every protocol is read
+	if err := readNetworkProtocolToModel(api, &model, endpoint, true); err != nil {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+
+	for _, protocol := range networkProtocols {
+		if *protocol.field(&model) == nil {
+			t.Errorf("Protocol %s has not been read", protocol.property)
+		}
+	}
+}
+
+func TestEndpointWithPort(t *testing.T) {
+	for _, test := range []struct {
+		endpoint string
+		port     int64
+		expected string
+	}{
+		{"https://10.172.201.240", 8443, "https://10.172.201.240:8443"},
+		{"https://10.172.201.240:8443", 443, "https://10.172.201.240"},
+		{"https://irmc.example.com:443/", 9443, "https://irmc.example.com:9443/"},
+		{"https://[2001:db8::1]:8443", 443, "https://[2001:db8::1]"},
+		{"https://[2001:db8::1]", 8443, "https://[2001:db8::1]:8443"},
+	} {
+		endpoint, err := endpointWithPort(test.endpoint, test.port)
+		if err != nil || endpoint != test.expected {
+			t.Errorf("Got '%s' (%v) for %s and port %d, expected '%s'", endpoint, err, test.endpoint, test.port, test.expected)
+		}
+	}
+
+	if _, err := endpointWithPort("10.172.201.240", 443); err == nil {
+		t.Errorf("Expected error for endpoint without scheme")
+	}
+}
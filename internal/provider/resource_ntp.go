@@ -0,0 +1,456 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"terraform-provider-irmc-redfish/internal/models"
+	"terraform-provider-irmc-redfish/internal/validators"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	tkpath "github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/stmcginnis/gofish"
+)
+
+const (
+	NETWORK_PROTOCOL_PATH = "NetworkProtocol"
+	NTP_TIME_OEM_PATH     = "iRMCConfiguration/Time"
+	NTP_SERVERS_MAX       = 2
+
+	TIME_MODE_NTP = "NTP"
+	TIME_MODE_RTC = "RTC"
+)
+
+type ntpEndpoints struct {
+	networkProtocolEndpoint string
+	timeEndpoint            string
+}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &NtpResource{}
+var _ resource.ResourceWithImportState = &NtpResource{}
+var _ resource.ResourceWithValidateConfig = &NtpResource{}
+
+func NewNtpResource() resource.Resource {
+	return &NtpResource{}
+}
+
+// NtpResource defines the resource implementation.
+type NtpResource struct {
+	p *IrmcProvider
+}
+
+func (r *NtpResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + ntpName
+}
+
+func NtpSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "ID of NTP settings resource on iRMC.",
+			Description:         "ID of NTP settings resource on iRMC.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"ntp_enabled": schema.BoolAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Indicates whether NTP protocol is enabled on iRMC. If not set, current value is kept.",
+			Description:         "Indicates whether NTP protocol is enabled on iRMC. If not set, current value is kept.",
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"ntp_servers": schema.ListAttribute{
+			Optional:            true,
+			Computed:            true,
+			ElementType:         types.StringType,
+			MarkdownDescription: "List of NTP servers (IP addresses or host names) in order of preference. If not set, current servers are kept.",
+			Description:         "List of NTP servers (IP addresses or host names) in order of preference. If not set, current servers are kept.",
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(NTP_SERVERS_MAX),
+				listvalidator.UniqueValues(),
+				listvalidator.ValueStringsAre(validators.IsHostAddress()),
+			},
+			PlanModifiers: []planmodifier.List{
+				listplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"time_mode": schema.StringAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Source of iRMC time: 'NTP' to synchronize time with NTP servers or 'RTC' to use real time clock of the system. If not set, current value is kept.",
+			Description:         "Source of iRMC time: 'NTP' to synchronize time with NTP servers or 'RTC' to use real time clock of the system. If not set, current value is kept.",
+			Validators: []validator.String{
+				stringvalidator.OneOf([]string{
+					TIME_MODE_NTP,
+					TIME_MODE_RTC,
+				}...),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"time_zone": schema.StringAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Time zone of iRMC as name from IANA time zone database (e.g. 'UTC', 'Europe/Berlin'). If not set, current value is kept.",
+			Description:         "Time zone of iRMC as name from IANA time zone database (e.g. 'UTC', 'Europe/Berlin'). If not set, current value is kept.",
+			Validators: []validator.String{
+				validators.IsTimeZone(),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"rtc_mode": schema.StringAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Defines whether real time clock of the system keeps 'LocalTime' or 'UTC'. If not set, current value is kept.",
+			Description:         "Defines whether real time clock of the system keeps 'LocalTime' or 'UTC'. If not set, current value is kept.",
+			Validators: []validator.String{
+				stringvalidator.OneOf([]string{
+					"LocalTime",
+					"UTC",
+				}...),
+			},
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+	}
+}
+
+func (r *NtpResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The resource is used to control (read, modify or import) time synchronization settings (NTP servers, time mode and time zone) of iRMC on Fujitsu server equipped with iRMC controller.",
+		Description:         "The resource is used to control (read, modify or import) time synchronization settings (NTP servers, time mode and time zone) of iRMC on Fujitsu server equipped with iRMC controller.",
+		Attributes:          NtpSchema(),
+		Blocks:              RedfishServerResourceBlockMap(),
+	}
+}
+
+func (r *NtpResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	p, ok := req.ProviderData.(*IrmcProvider)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *IrmcProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.p = p
+}
+
+func (r *NtpResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config models.NtpResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.TimeMode.ValueString() != TIME_MODE_NTP {
+		return
+	}
+
+	if !config.NtpEnabled.IsNull() && !config.NtpEnabled.IsUnknown() && !config.NtpEnabled.ValueBool() {
+		resp.Diagnostics.AddAttributeError(tkpath.Root("ntp_enabled"), "Invalid NTP configuration",
+			"Time mode 'NTP' requires NTP protocol to be enabled")
+	}
+
+	if !config.NtpServers.IsNull() && !config.NtpServers.IsUnknown() && len(config.NtpServers.Elements()) == 0 {
+		resp.Diagnostics.AddAttributeError(tkpath.Root("ntp_servers"), "Invalid NTP configuration",
+			"Time mode 'NTP' requires at least one NTP server")
+	}
+}
+
+func (r *NtpResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "resource-ntp: create starts")
+
+	// Read Terraform plan data into the model
+	var plan models.NtpResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	tflog.Info(ctx, "resource-ntp: create ends")
+}
+
+func (r *NtpResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "resource-ntp: read starts")
+
+	// Read Terraform prior state data into the model
+	var state models.NtpResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, err := ConnectTargetSystem(r.p, &state.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError("service error: ", err.Error())
+		return
+	}
+
+	defer api.Logout()
+
+	vendor, err := GetOemVendor(ctx, api)
+	if err != nil {
+		resp.Diagnostics.AddError("Vendor Detection Failed", err.Error())
+		return
+	}
+	manager, err := GetManagerEndpoint(api.Service)
+	if err != nil {
+		resp.Diagnostics.AddError("Manager Resource Detection Failed", err.Error())
+		return
+	}
+	endp := getNtpEndpoints(vendor, manager)
+
+	resp.Diagnostics.Append(readNtpSettingsToModel(ctx, api, &state, endp)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	tflog.Info(ctx, "resource-ntp: read ends")
+}
+
+func (r *NtpResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "resource-ntp: update starts")
+
+	// Read Terraform plan data into the model
+	var plan models.NtpResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	tflog.Info(ctx, "resource-ntp: update ends")
+}
+
+func (r *NtpResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "resource-ntp: delete starts")
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "resource-ntp: delete ends")
+}
+
+func (r *NtpResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Info(ctx, "resource-ntp: import starts")
+
+	var config CommonImportConfig
+	err := json.Unmarshal([]byte(req.ID), &config)
+	if err != nil {
+		resp.Diagnostics.AddError("Error while unmarshalling import config", err.Error())
+		return
+	}
+
+	creds := importServerBlock(config.ServerConfig)
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, tkpath.Root("server"), creds)...)
+
+	tflog.Info(ctx, "resource-ntp: import ends")
+}
+
+// apply configures time settings requested by plan and reads them back into plan, so values
+// not set by user are known after apply.
+func (r *NtpResource) apply(ctx context.Context, plan *models.NtpResourceModel) (diags diag.Diagnostics) {
+	// Provide synchronization
+	var endpoint = GetServerEndpoint(r.p, plan.RedfishServer)
+	var resource_name = "resource-ntp"
+	mutexPool.Lock(ctx, endpoint, resource_name)
+	defer mutexPool.Unlock(ctx, endpoint, resource_name)
+
+	// Connect to service
+	api, err := ConnectTargetSystem(r.p, &plan.RedfishServer)
+	if err != nil {
+		diags.AddError("service error: ", err.Error())
+		return diags
+	}
+
+	defer api.Logout()
+
+	vendor, err := GetOemVendor(ctx, api)
+	if err != nil {
+		diags.AddError("Vendor Detection Failed", err.Error())
+		return diags
+	}
+	manager, err := GetManagerEndpoint(api.Service)
+	if err != nil {
+		diags.AddError("Manager Resource Detection Failed", err.Error())
+		return diags
+	}
+	endp := getNtpEndpoints(vendor, manager)
+
+	if err = applyNtpSettings(ctx, api, plan, endp); err != nil {
+		diags.AddError("Error while applying NTP settings", err.Error())
+		return diags
+	}
+
+	return readNtpSettingsToModel(ctx, api, plan, endp)
+}
+
+type ntpProtocolSettings struct {
+	NTP struct {
+		ProtocolEnabled bool     `json:"ProtocolEnabled"`
+		NTPServers      []string `json:"NTPServers"`
+	} `json:"NTP"`
+}
+
+type timeSettings struct {
+	SyncSource       string `json:"SyncSource"`
+	TimeZoneLocation string `json:"TimeZoneLocation"`
+	RtcMode          string `json:"RtcMode"`
+}
+
+// applyNtpSettings sends to iRMC only these settings from plan, which are known and differ from current ones.
+// NTP protocol is configured first, since iRMC might reject NTP time mode without NTP servers.
+func applyNtpSettings(ctx context.Context, api *gofish.APIClient, plan *models.NtpResourceModel, endp ntpEndpoints) error {
+	var protocol ntpProtocolSettings
+	etag, err := getRedfishResource(api, endp.networkProtocolEndpoint, &protocol)
+	if err != nil {
+		return err
+	}
+
+	ntp := map[string]interface{}{}
+	if !plan.NtpEnabled.IsNull() && !plan.NtpEnabled.IsUnknown() && plan.NtpEnabled.ValueBool() != protocol.NTP.ProtocolEnabled {
+		ntp["ProtocolEnabled"] = plan.NtpEnabled.ValueBool()
+	}
+
+	if !plan.NtpServers.IsNull() && !plan.NtpServers.IsUnknown() {
+		var servers []string
+		if diags := plan.NtpServers.ElementsAs(ctx, &servers, false); diags.HasError() {
+			return fmt.Errorf("could not read planned NTP servers")
+		}
+
+		if !slices.Equal(servers, nonEmptyStrings(protocol.NTP.NTPServers)) {
+			ntp["NTPServers"] = padStrings(servers, len(protocol.NTP.NTPServers))
+		}
+	}
+
+	if len(ntp) > 0 {
+		tflog.Info(ctx, "Changing NTP protocol settings", ntp)
+		if err = patchRedfishResource(api, endp.networkProtocolEndpoint, etag, map[string]interface{}{"NTP": ntp}); err != nil {
+			return err
+		}
+	}
+
+	var current timeSettings
+	etag, err = getRedfishResource(api, endp.timeEndpoint, &current)
+	if err != nil {
+		return err
+	}
+
+	settings := map[string]interface{}{}
+	if !plan.TimeMode.IsNull() && !plan.TimeMode.IsUnknown() && plan.TimeMode.ValueString() != current.SyncSource {
+		settings["SyncSource"] = plan.TimeMode.ValueString()
+	}
+	if !plan.TimeZone.IsNull() && !plan.TimeZone.IsUnknown() && plan.TimeZone.ValueString() != current.TimeZoneLocation {
+		settings["TimeZoneLocation"] = plan.TimeZone.ValueString()
+	}
+	if !plan.RtcMode.IsNull() && !plan.RtcMode.IsUnknown() && plan.RtcMode.ValueString() != current.RtcMode {
+		settings["RtcMode"] = plan.RtcMode.ValueString()
+	}
+
+	if len(settings) > 0 {
+		tflog.Info(ctx, "Changing iRMC time settings", settings)
+		if err = patchRedfishResource(api, endp.timeEndpoint, etag, settings); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// readNtpSettingsToModel reads current time settings of iRMC into model, so changes done
+// outside of Terraform are detected.
+func readNtpSettingsToModel(ctx context.Context, api *gofish.APIClient, model *models.NtpResourceModel, endp ntpEndpoints) (diags diag.Diagnostics) {
+	var protocol ntpProtocolSettings
+	if _, err := getRedfishResource(api, endp.networkProtocolEndpoint, &protocol); err != nil {
+		diags.AddError("Error while reading NTP protocol settings", err.Error())
+		return diags
+	}
+
+	var settings timeSettings
+	if _, err := getRedfishResource(api, endp.timeEndpoint, &settings); err != nil {
+		diags.AddError("Error while reading iRMC time settings", err.Error())
+		return diags
+	}
+
+	model.NtpEnabled = types.BoolValue(protocol.NTP.ProtocolEnabled)
+	model.NtpServers, diags = types.ListValueFrom(ctx, types.StringType, nonEmptyStrings(protocol.NTP.NTPServers))
+	model.TimeMode = types.StringValue(settings.SyncSource)
+	model.TimeZone = types.StringValue(settings.TimeZoneLocation)
+	model.RtcMode = types.StringValue(settings.RtcMode)
+	model.Id = types.StringValue(endp.timeEndpoint)
+
+	return diags
+}
+
+func getNtpEndpoints(vendor *OemVendor, manager string) ntpEndpoints {
+	return ntpEndpoints{
+		networkProtocolEndpoint: fmt.Sprintf("%s/%s", manager, NETWORK_PROTOCOL_PATH),
+		timeEndpoint:            vendor.OemPath(manager, NTP_TIME_OEM_PATH),
+	}
+}
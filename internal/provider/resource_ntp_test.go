@@ -0,0 +1,231 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"terraform-provider-irmc-redfish/internal/models"
+	"terraform-provider-irmc-redfish/internal/validators"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	tkpath "github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+const resource_ntp_name = "irmc-redfish_ntp.ntp"
+
+func TestAccRedfishNtp_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishResourceNtpConfig(creds, "NTP", "Europe/Berlin"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resource_ntp_name, "ntp_enabled", "true"),
+					resource.TestCheckResourceAttr(resource_ntp_name, "time_mode", "NTP"),
+					resource.TestCheckResourceAttr(resource_ntp_name, "time_zone", "Europe/Berlin"),
+				),
+			},
+			{
+				Config: testAccRedfishResourceNtpConfig(creds, "RTC", "UTC"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resource_ntp_name, "time_mode", "RTC"),
+					resource.TestCheckResourceAttr(resource_ntp_name, "time_zone", "UTC"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRedfishNtp_import(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:       `resource "irmc-redfish_ntp" "ntp" {}`,
+				ResourceName: resource_ntp_name,
+				ImportState:  true,
+				ImportStateIdFunc: func(d *terraform.State) (string, error) {
+					return fmt.Sprintf("{\"username\":\"%s\", \"password\":\"%s\", \"endpoint\":\"https://%s\", \"ssl_insecure\":true}",
+						creds.Username, creds.Password, creds.Endpoint), nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccRedfishNtp_negative(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRedfishResourceNtpConfig(creds, "NTP", "Europe/Nowhere"),
+				ExpectError: regexp.MustCompile("Invalid time zone"),
+			},
+		},
+	})
+}
+
+func testAccRedfishResourceNtpConfig(testingInfo TestingServerCredentials, timeMode string, timeZone string) string {
+	return fmt.Sprintf(`
+	resource "irmc-redfish_ntp" "ntp" {
+
+		server {
+		  username     = "%s"
+		  password     = "%s"
+		  endpoint     = "https://%s"
+		  ssl_insecure = true
+		}
+
+		ntp_enabled = true
+		ntp_servers = ["0.pool.ntp.org", "1.pool.ntp.org"]
+		time_mode   = "%s"
+		time_zone   = "%s"
+	  }
+	`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		timeMode,
+		timeZone,
+	)
+}
+
+func TestApplyNtpSettings(t *testing.T) {
+	forEachMockFlavor(t, func(t *testing.T, m *mockRedfishServer) {
+		api := m.connect()
+		ctx := context.Background()
+		endp := getNtpEndpoints(m.vendor(), mockManagerEndpoint)
+
+		plan := models.NtpResourceModel{
+			NtpEnabled: types.BoolValue(true),
+			NtpServers: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("192.0.2.10"), types.StringValue("ntp.example.com")}),
+			TimeMode:   types.StringValue(TIME_MODE_NTP),
+			TimeZone:   types.StringValue("Europe/Berlin"),
+			RtcMode:    types.StringUnknown(),
+		}
+
+		if err := applyNtpSettings(ctx, api, &plan, endp); err != nil {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+
+		ntp, _ := m.get(endp.networkProtocolEndpoint)["NTP"].(map[string]interface{})
+		if ntp["ProtocolEnabled"] != true || fmt.Sprint(ntp["NTPServers"]) != "[192.0.2.10 ntp.example.com]" {
+			t.Errorf("Unexpected NTP protocol settings %v", ntp)
+		}
+
+		settings := m.get(endp.timeEndpoint)
+		if settings["SyncSource"] != TIME_MODE_NTP || settings["TimeZoneLocation"] != "Europe/Berlin" || settings["RtcMode"] != "LocalTime" {
+			t.Errorf("Unexpected time settings %v", settings)
+		}
+
+		// Settings equal to current ones must not be sent again
+		patches := len(m.requestsTo(http.MethodPatch, endp.networkProtocolEndpoint)) + len(m.requestsTo(http.MethodPatch, endp.timeEndpoint))
+		if err := applyNtpSettings(ctx, api, &plan, endp); err != nil {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+		if count := len(m.requestsTo(http.MethodPatch, endp.networkProtocolEndpoint)) + len(m.requestsTo(http.MethodPatch, endp.timeEndpoint)); count != patches {
+			t.Errorf("Got %d PATCH requests for unchanged settings, expected none", count-patches)
+		}
+
+		// Slot of removed server must be cleared
+		plan.NtpServers = types.ListValueMust(types.StringType, []attr.Value{types.StringValue("ntp.example.com")})
+		if err := applyNtpSettings(ctx, api, &plan, endp); err != nil {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+		ntp, _ = m.get(endp.networkProtocolEndpoint)["NTP"].(map[string]interface{})
+		if fmt.Sprint(ntp["NTPServers"]) != "[ntp.example.com ]" {
+			t.Errorf("Unexpected NTP servers %v", ntp["NTPServers"])
+		}
+	})
+}
+
+func TestReadNtpSettingsToModel(t *testing.T) {
+	m := newMockRedfishServer(t, FSAS)
+	api := m.connect()
+	endp := getNtpEndpoints(m.vendor(), mockManagerEndpoint)
+
+	// Change done outside of Terraform must be visible in state
+	m.update(endp.networkProtocolEndpoint, map[string]interface{}{
+		"NTP": map[string]interface{}{"ProtocolEnabled": true, "NTPServers": []interface{}{"192.0.2.20", ""}},
+	})
+	m.update(endp.timeEndpoint, map[string]interface{}{"TimeZoneLocation": "Asia/Tokyo"})
+
+	var model models.NtpResourceModel
+	if diags := readNtpSettingsToModel(context.Background(), api, &model, endp); diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+
+	if !model.NtpEnabled.ValueBool() || model.TimeZone.ValueString() != "Asia/Tokyo" || model.TimeMode.ValueString() != TIME_MODE_RTC {
+		t.Errorf("Unexpected model %v", model)
+	}
+
+	expected := types.ListValueMust(types.StringType, []attr.Value{types.StringValue("192.0.2.20")})
+	if !model.NtpServers.Equal(expected) {
+		t.Errorf("Got NTP servers %v, expected %v", model.NtpServers, expected)
+	}
+
+	if model.Id.ValueString() != endp.timeEndpoint {
+		t.Errorf("Got id %s, expected %s", model.Id.ValueString(), endp.timeEndpoint)
+	}
+}
+
+func TestNtpValidators(t *testing.T) {
+	validate := func(v validator.String, value string) bool {
+		resp := &validator.StringResponse{}
+		v.ValidateString(context.Background(), validator.StringRequest{
+			Path:        tkpath.Root("value"),
+			ConfigValue: types.StringValue(value),
+		}, resp)
+		return !resp.Diagnostics.HasError()
+	}
+
+	for value, valid := range map[string]bool{
+		"192.0.2.1":       true,
+		"2001:db8::1":     true,
+		"ntp.example.com": true,
+		"ntp":             true,
+		"ntp_server":      false,
+		"-ntp.example":    false,
+		"":                false,
+	} {
+		if validate(validators.IsHostAddress(), value) != valid {
+			t.Errorf("Host address '%s' validation result should be %t", value, valid)
+		}
+	}
+
+	for value, valid := range map[string]bool{
+		"UTC":            true,
+		"Europe/Berlin":  true,
+		"Europe/Nowhere": false,
+		"Local":          false,
+		"":               false,
+	} {
+		if validate(validators.IsTimeZone(), value) != valid {
+			t.Errorf("Time zone '%s' validation result should be %t", value, valid)
+		}
+	}
+}
@@ -21,6 +21,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strings"
 	"terraform-provider-irmc-redfish/internal/models"
 	"time"
 
@@ -148,7 +149,7 @@ func (r *PowerResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 
 	// Provide synchronization
-	var endpoint = powerPlan.RedfishServer[0].Endpoint.ValueString()
+	var endpoint = GetServerEndpoint(r.p, powerPlan.RedfishServer)
 	var resource_name = "resource-power"
 	mutexPool.Lock(ctx, endpoint, resource_name)
 	defer mutexPool.Unlock(ctx, endpoint, resource_name)
@@ -168,13 +169,13 @@ func (r *PowerResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	defer config.Logout()
 
-	isFsas, err := IsFsasCheck(ctx, config)
+	vendor, err := GetOemVendor(ctx, config)
 	if err != nil {
 		resp.Diagnostics.AddError("Vendor Detection Failed", err.Error())
 		return
 	}
 
-	powerEndpoint := getPowerEndpoints(isFsas)
+	powerEndpoint := getPowerEndpoints(vendor, strings.TrimSuffix(system.ODataID, "/"))
 
 	var powerErr error
 
@@ -182,21 +183,14 @@ func (r *PowerResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	switch powerAction {
 	case "On", "ForceOn":
-		powerErr = changePowerState(config.Service, true, powerPlan.MaxWaitTime.ValueInt64())
+		powerErr = changePowerState(ctx, config.Service, true, powerPlan.MaxWaitTime.ValueInt64())
 
 	case "ForceOff":
-		powerErr = changePowerState(config.Service, false, powerPlan.MaxWaitTime.ValueInt64())
+		powerErr = changePowerState(ctx, config.Service, false, powerPlan.MaxWaitTime.ValueInt64())
 
 	case "PowerCycle":
-		var payload map[string]string
-		if isFsas {
-			payload = map[string]string{
-				"FsasResetType": "PowerCycle",
-			}
-		} else {
-			payload = map[string]string{
-				"FTSResetType": "PowerCycle",
-			}
+		payload := map[string]string{
+			vendor.ActionName("ResetType"): "PowerCycle",
 		}
 
 		respPost, err := config.Post(powerEndpoint.hostPowerActionEndpoint, payload)
@@ -212,14 +206,16 @@ func (r *PowerResource) Create(ctx context.Context, req resource.CreateRequest,
 			return
 		}
 
-		powerErr = waitUntilHostStateChanged(config.Service, false, powerPlan.MaxWaitTime.ValueInt64())
+		powerErr = waitUntilHostStateChanged(ctx, config.Service, false, powerPlan.MaxWaitTime.ValueInt64())
 		if powerErr != nil {
 			resp.Diagnostics.AddError("Host state has not been changed within given timeout", powerErr.Error())
 			return
 		}
-		time.Sleep(30 * time.Second)
+		if err := sleepWithContext(ctx, 30*time.Second); err != nil {
+			powerErr = hostStateWaitCancelledError(err)
+		}
 	default:
-		powerErr = resetHost(config.Service, redfish.ResetType(powerAction),
+		powerErr = resetHost(ctx, config.Service, redfish.ResetType(powerAction),
 			powerPlan.MaxWaitTime.ValueInt64())
 	}
 
@@ -227,7 +223,11 @@ func (r *PowerResource) Create(ctx context.Context, req resource.CreateRequest,
 		resp.Diagnostics.AddError("Power Operation Error", powerErr.Error())
 		return
 	}
-	time.Sleep(10 * time.Second)
+
+	if err := sleepWithContext(ctx, 10*time.Second); err != nil {
+		resp.Diagnostics.AddError("Power Operation Error", hostStateWaitCancelledError(err).Error())
+		return
+	}
 	powerStateStatus, errpowerstate := isPoweredOn(config.Service)
 	if errpowerstate != nil {
 		resp.Diagnostics.AddError("Service Connect Target System Error", errpowerstate.Error())
@@ -307,14 +307,8 @@ func (*PowerResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 	tflog.Info(ctx, "resource-power: delete ends")
 }
 
-func getPowerEndpoints(isFsas bool) powerEndpoints {
-	if isFsas {
-		return powerEndpoints{
-			hostPowerActionEndpoint: fmt.Sprintf("/redfish/v1/Systems/0/Actions/Oem/%sComputerSystem.Reset", FSAS),
-		}
-	} else {
-		return powerEndpoints{
-			hostPowerActionEndpoint: fmt.Sprintf("/redfish/v1/Systems/0/Actions/Oem/%sComputerSystem.Reset", FTS),
-		}
+func getPowerEndpoints(vendor *OemVendor, system string) powerEndpoints {
+	return powerEndpoints{
+		hostPowerActionEndpoint: vendor.ActionPath(system, "ComputerSystem.Reset"),
 	}
 }
@@ -18,12 +18,15 @@ limitations under the License.
 package provider
 
 import (
+	"context"
 	"fmt"
+	"net/http"
 	"testing"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/stmcginnis/gofish"
+	"github.com/stmcginnis/gofish/redfish"
 )
 
 const (
@@ -54,7 +57,7 @@ func TestAccRedfishIrmcPower(t *testing.T) {
 			}
 
 			if isPoweredOn {
-				if err = changePowerState(api.Service, false, 120); err != nil {
+				if err = changePowerState(context.Background(), api.Service, false, 120); err != nil {
 					t.Fatalf("Failed to change power state within given timeout: %s", err.Error())
 				}
 			}
@@ -145,3 +148,82 @@ func testAccRedfishResourcePowerConfig(testingInfo TestingServerCredentials,
 		HostPowerAction,
 	)
 }
+
+func TestChangePowerState(t *testing.T) {
+	forEachMockFlavor(t, func(t *testing.T, m *mockRedfishServer) {
+		api := m.connect()
+
+		if err := changePowerState(context.Background(), api.Service, true, 30); err != nil {
+			t.Fatalf("Unexpected error while powering on: %s", err.Error())
+		}
+
+		if poweredOn, _ := isPoweredOn(api.Service); !poweredOn {
+			t.Errorf("Host has not been powered on")
+		}
+
+		// Host is already powered on, so no request is expected
+		resets := len(m.requestsTo(http.MethodPost, "/redfish/v1/Systems/0/Actions/ComputerSystem.Reset"))
+		if err := changePowerState(context.Background(), api.Service, true, 30); err != nil {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+
+		if len(m.requestsTo(http.MethodPost, "/redfish/v1/Systems/0/Actions/ComputerSystem.Reset")) != resets {
+			t.Errorf("Reset has been requested for host which is already powered on")
+		}
+
+		if err := changePowerState(context.Background(), api.Service, false, 30); err != nil {
+			t.Fatalf("Unexpected error while powering off: %s", err.Error())
+		}
+
+		if poweredOn, _ := isPoweredOn(api.Service); poweredOn {
+			t.Errorf("Host has not been powered off")
+		}
+	})
+}
+
+func TestResetHost(t *testing.T) {
+	forEachMockFlavor(t, func(t *testing.T, m *mockRedfishServer) {
+		api := m.connect()
+		m.update("/redfish/v1/Systems/0", map[string]interface{}{"PowerState": "On"})
+
+		if err := resetHost(context.Background(), api.Service, redfish.GracefulShutdownResetType, 30); err != nil {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+
+		if poweredOn, _ := isPoweredOn(api.Service); poweredOn {
+			t.Errorf("Host has not been powered off")
+		}
+
+		if err := resetOrPowerOnHostWithPostCheck(context.Background(), api.Service, redfish.ForceRestartResetType, 30); err != nil {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+
+		if poweredOn, _ := isPoweredOn(api.Service); !poweredOn {
+			t.Errorf("Host has not been powered on")
+		}
+	})
+}
+
+func TestPowerCycleEndpoint(t *testing.T) {
+	forEachMockFlavor(t, func(t *testing.T, m *mockRedfishServer) {
+		api := m.connect()
+		m.update("/redfish/v1/Systems/0", map[string]interface{}{"PowerState": "On"})
+
+		endpoint := getPowerEndpoints(m.vendor(), mockSystemEndpoint).hostPowerActionEndpoint
+		payload := map[string]string{m.oemActionPrefix() + "ResetType": "ForceOff"}
+
+		res, err := api.Post(endpoint, payload)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+		CloseResource(res.Body)
+
+		if res.StatusCode != http.StatusNoContent {
+			t.Errorf("Got status %d, expected %d", res.StatusCode, http.StatusNoContent)
+		}
+
+		if err := waitUntilHostStateChanged(context.Background(), api.Service, false, 10); err != nil {
+			t.Errorf("Unexpected error: %s", err.Error())
+		}
+	})
+}
@@ -169,7 +169,7 @@ func (r *SimpleUpdateResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
-	var endpoint = plan.RedfishServer[0].Endpoint.ValueString()
+	var endpoint = GetServerEndpoint(r.p, plan.RedfishServer)
 	const resource_name = "resource-simple-update"
 	mutexPool.Lock(ctx, endpoint, resource_name)
 	defer mutexPool.Unlock(ctx, endpoint, resource_name)
@@ -181,7 +181,7 @@ func (r *SimpleUpdateResource) Create(ctx context.Context, req resource.CreateRe
 	}
 	defer config.Logout()
 
-	isFsas, err := IsFsasCheck(ctx, config)
+	vendor, err := GetOemVendor(ctx, config)
 	if err != nil {
 		resp.Diagnostics.AddError("Vendor Detection Failed", err.Error())
 		return
@@ -194,7 +194,7 @@ func (r *SimpleUpdateResource) Create(ctx context.Context, req resource.CreateRe
 		resp.Diagnostics.AddError("Power state check failed", err.Error())
 		return
 	}
-	err = UpdateUmeToolsDirName(config, plan.UmeToolDirName.ValueString(), isFsas)
+	err = UpdateUmeToolsDirName(config, plan.UmeToolDirName.ValueString(), vendor)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to update SimpleUpdateOfflineToolsDirName", err.Error())
 		return
@@ -218,7 +218,7 @@ func (r *SimpleUpdateResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
-	err = CheckSimpleUpdateStatus(ctx, config.Service, taskLocation, plan.UpdateTimeout.ValueInt64(), isFsas)
+	err = CheckSimpleUpdateStatus(ctx, config.Service, taskLocation, plan.UpdateTimeout.ValueInt64(), vendor)
 	if err != nil {
 		resp.Diagnostics.AddError("Simple Update task did not complete successfully", err.Error())
 		return
@@ -261,10 +261,10 @@ func (r *SimpleUpdateResource) Delete(ctx context.Context, req resource.DeleteRe
 	tflog.Info(ctx, "resource-simple-update: delete ends")
 }
 
-func CheckSimpleUpdateStatus(ctx context.Context, service *gofish.Service, location string, timeout int64, isFsas bool) error {
+func CheckSimpleUpdateStatus(ctx context.Context, service *gofish.Service, location string, timeout int64, vendor *OemVendor) error {
 	finishedSuccessfully, err := WaitForRedfishTaskEnd(ctx, service, location, timeout)
 	if err != nil || !finishedSuccessfully {
-		taskLog, diags := FetchRedfishTaskLog(service, location, isFsas)
+		taskLog, diags := FetchRedfishTaskLog(service, location, vendor)
 		if diags.HasError() {
 			return fmt.Errorf("simple Update task did not complete successfully: %s", err)
 		}
@@ -303,7 +303,7 @@ func ConfigSimpleUpd(ctx context.Context, config *gofish.APIClient, updateImage
 	return taskLocation, diags
 }
 
-func UpdateUmeToolsDirName(apiClient *gofish.APIClient, umeFileDirectory string, isFsas bool) error {
+func UpdateUmeToolsDirName(apiClient *gofish.APIClient, umeFileDirectory string, vendor *OemVendor) error {
 	res, err := apiClient.Get(UPDATE_SERVICE_ENDPOINT)
 	if err != nil {
 		return fmt.Errorf("failed to fetch data from Redfish endpoint: %v", err)
@@ -317,19 +317,10 @@ func UpdateUmeToolsDirName(apiClient *gofish.APIClient, umeFileDirectory string,
 		return fmt.Errorf("failed to parse JSON response: %v", err)
 	}
 
-	var oemKey string
-	if isFsas {
-		oemKey = FSAS
-	} else {
-		oemKey = TS_FUJITSU
-	}
-
 	currentDirName := ""
-	if oem, oemOK := dataUpdateService["Oem"].(map[string]interface{}); oemOK {
-		if oemData, oemDataOK := oem[oemKey].(map[string]interface{}); oemDataOK {
-			if val, ok := oemData["SimpleUpdateOfflineToolsDirName"].(string); ok {
-				currentDirName = val
-			}
+	if oemData, oemDataOK := vendor.Properties(dataUpdateService["Oem"]); oemDataOK {
+		if val, ok := oemData["SimpleUpdateOfflineToolsDirName"].(string); ok {
+			currentDirName = val
 		}
 	}
 
@@ -338,11 +329,9 @@ func UpdateUmeToolsDirName(apiClient *gofish.APIClient, umeFileDirectory string,
 	}
 
 	patchData := map[string]interface{}{
-		"Oem": map[string]interface{}{
-			oemKey: map[string]interface{}{
-				"SimpleUpdateOfflineToolsDirName": umeFileDirectory,
-			},
-		},
+		"Oem": vendor.Oem(map[string]interface{}{
+			"SimpleUpdateOfflineToolsDirName": umeFileDirectory,
+		}),
 	}
 
 	res, err = apiClient.PatchWithHeaders(UPDATE_SERVICE_ENDPOINT, patchData,
@@ -18,12 +18,16 @@ limitations under the License.
 package provider
 
 import (
+	"context"
 	"fmt"
+	"net/http"
 	"os"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stmcginnis/gofish/redfish"
 )
 
 const (
@@ -107,3 +111,51 @@ func testAccSimpleUpdateResourceConfig(testingInfo TestingServerCredentials, tra
 		applyTime,
 	)
 }
+
+func TestConfigSimpleUpd(t *testing.T) {
+	forEachMockFlavor(t, func(t *testing.T, m *mockRedfishServer) {
+		api := m.connect()
+
+		location, diags := ConfigSimpleUpd(context.Background(), api, "192.168.1.1/image.bin", TRANSFER_PROTOCOL, APPLY_TIME)
+		if diags.HasError() {
+			t.Fatalf("Unexpected error: %v", diags)
+		}
+
+		requests := m.requestsTo(http.MethodPost, SIMPLE_UPDATE_ENDPOINT)
+		if len(requests) != 1 || requests[0].Body["ImageURI"] != "http://192.168.1.1/image.bin" {
+			t.Errorf("Unexpected SimpleUpdate requests %v", requests)
+		}
+
+		if err := CheckSimpleUpdateStatus(context.Background(), api.Service, location, 10, m.vendor()); err != nil {
+			t.Errorf("Unexpected error: %s", err.Error())
+		}
+
+		location = m.addTask([]string{"Image could not be downloaded"}, redfish.ExceptionTaskState)
+		err := CheckSimpleUpdateStatus(context.Background(), api.Service, location, 10, m.vendor())
+		if err == nil || !strings.Contains(err.Error(), "Image could not be downloaded") {
+			t.Errorf("Expected error containing task log, got %v", err)
+		}
+	})
+}
+
+func TestUpdateUmeToolsDirName(t *testing.T) {
+	forEachMockFlavor(t, func(t *testing.T, m *mockRedfishServer) {
+		api := m.connect()
+
+		if err := UpdateUmeToolsDirName(api, "UME", m.vendor()); err != nil {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+
+		if len(m.requestsTo(http.MethodPatch, UPDATE_SERVICE_ENDPOINT)) != 0 {
+			t.Errorf("Directory name has been PATCHed although it did not change")
+		}
+
+		if err := UpdateUmeToolsDirName(api, "UME_NEW", m.vendor()); err != nil {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+
+		if dir := m.oem(UPDATE_SERVICE_ENDPOINT)["SimpleUpdateOfflineToolsDirName"]; dir != "UME_NEW" {
+			t.Errorf("Got directory name %v, expected UME_NEW", dir)
+		}
+	})
+}
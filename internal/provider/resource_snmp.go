@@ -0,0 +1,815 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"terraform-provider-irmc-redfish/internal/models"
+	"terraform-provider-irmc-redfish/internal/validators"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	tkpath "github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/stmcginnis/gofish"
+)
+
+const (
+	SNMP_ALERTING_OEM_PATH = "iRMCConfiguration/Alerting/SNMP"
+	SNMP_TRAP_DEFAULT_PORT = 162
+	SNMP_KEY_MIN_LENGTH    = 8
+
+	SNMP_VERSION_V1  = "v1"
+	SNMP_VERSION_V2C = "v2c"
+	SNMP_VERSION_V3  = "v3"
+
+	SNMP_ACCESS_MODE_FULL    = "Full"
+	SNMP_ACCESS_MODE_LIMITED = "Limited"
+
+	SNMP_PRIVACY_PROTOCOL_NONE = "None"
+)
+
+type snmpEndpoints struct {
+	networkProtocolEndpoint string
+	alertingEndpoint        string
+}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SnmpResource{}
+var _ resource.ResourceWithImportState = &SnmpResource{}
+var _ resource.ResourceWithValidateConfig = &SnmpResource{}
+
+func NewSnmpResource() resource.Resource {
+	return &SnmpResource{}
+}
+
+// SnmpResource defines the resource implementation.
+type SnmpResource struct {
+	p *IrmcProvider
+}
+
+func (r *SnmpResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + snmpName
+}
+
+func snmpVersionEnabledSchema(version string) schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: fmt.Sprintf("Indicates whether SNMP%s is enabled on iRMC. If not set, current value is kept.", version),
+		Description:         fmt.Sprintf("Indicates whether SNMP%s is enabled on iRMC. If not set, current value is kept.", version),
+		PlanModifiers: []planmodifier.Bool{
+			boolplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+func snmpAccessModeSchema() schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "Access granted: 'Limited' (read only, default) or 'Full' (read and write).",
+		Description:         "Access granted: 'Limited' (read only, default) or 'Full' (read and write).",
+		Default:             stringdefault.StaticString(SNMP_ACCESS_MODE_LIMITED),
+		Validators: []validator.String{
+			stringvalidator.OneOf([]string{
+				SNMP_ACCESS_MODE_FULL,
+				SNMP_ACCESS_MODE_LIMITED,
+			}...),
+		},
+	}
+}
+
+// snmpSecretVersionSchema returns attribute used to request sending of write-only secret again.
+func snmpSecretVersionSchema(secrets string) schema.Int64Attribute {
+	return schema.Int64Attribute{
+		Optional:            true,
+		MarkdownDescription: fmt.Sprintf("Version of %s. Since %s are write-only, change of the value (e.g. after rotation) is the only way to send them to iRMC again.", secrets, secrets),
+		Description:         fmt.Sprintf("Version of %s. Since %s are write-only, change of the value (e.g. after rotation) is the only way to send them to iRMC again.", secrets, secrets),
+	}
+}
+
+func SnmpSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "ID of SNMP alerting settings resource on iRMC.",
+			Description:         "ID of SNMP alerting settings resource on iRMC.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"protocol_v1_enabled":  snmpVersionEnabledSchema(SNMP_VERSION_V1),
+		"protocol_v2c_enabled": snmpVersionEnabledSchema(SNMP_VERSION_V2C),
+		"protocol_v3_enabled":  snmpVersionEnabledSchema(SNMP_VERSION_V3),
+		"community_strings": schema.ListNestedAttribute{
+			Optional:            true,
+			MarkdownDescription: "SNMPv1/v2c communities. If not set, communities are not managed. Community strings are write-only and not reported back by iRMC, so only their names and access modes are verified during refresh.",
+			Description:         "SNMPv1/v2c communities. If not set, communities are not managed. Community strings are write-only and not reported back by iRMC, so only their names and access modes are verified during refresh.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "Name identifying the community.",
+						Description:         "Name identifying the community.",
+						Validators: []validator.String{
+							stringvalidator.LengthBetween(1, 32),
+						},
+					},
+					"community": schema.StringAttribute{
+						Required:            true,
+						Sensitive:           true,
+						WriteOnly:           true,
+						MarkdownDescription: "Community string. It's never stored in state and is sent to iRMC only when the community is created or changed, or when `community_version` changes.",
+						Description:         "Community string. It's never stored in state and is sent to iRMC only when the community is created or changed, or when community_version changes.",
+						Validators: []validator.String{
+							stringvalidator.LengthBetween(1, 32),
+						},
+					},
+					"community_version": snmpSecretVersionSchema("community strings"),
+					"access_mode":       snmpAccessModeSchema(),
+				},
+			},
+		},
+		"v3_users": schema.ListNestedAttribute{
+			Optional:            true,
+			MarkdownDescription: "SNMPv3 users. If not set, users are not managed. Keys are write-only and not reported back by iRMC, so only the remaining settings are verified during refresh.",
+			Description:         "SNMPv3 users. If not set, users are not managed. Keys are write-only and not reported back by iRMC, so only the remaining settings are verified during refresh.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"user_name": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "Name of SNMPv3 user.",
+						Description:         "Name of SNMPv3 user.",
+						Validators: []validator.String{
+							stringvalidator.LengthBetween(1, 32),
+						},
+					},
+					"access_mode": snmpAccessModeSchema(),
+					"auth_protocol": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "Authentication protocol of the user: 'SHA', 'SHA256', 'SHA384' or 'SHA512'.",
+						Description:         "Authentication protocol of the user: 'SHA', 'SHA256', 'SHA384' or 'SHA512'.",
+						Validators: []validator.String{
+							stringvalidator.OneOf([]string{
+								"SHA",
+								"SHA256",
+								"SHA384",
+								"SHA512",
+							}...),
+						},
+					},
+					"auth_key": schema.StringAttribute{
+						Required:            true,
+						Sensitive:           true,
+						WriteOnly:           true,
+						MarkdownDescription: "Authentication key of the user (at least 8 characters). It's never stored in state and is sent to iRMC only when the user is created or changed, or when `keys_version` changes.",
+						Description:         "Authentication key of the user (at least 8 characters). It's never stored in state and is sent to iRMC only when the user is created or changed, or when keys_version changes.",
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(SNMP_KEY_MIN_LENGTH),
+						},
+					},
+					"privacy_protocol": schema.StringAttribute{
+						Optional:            true,
+						Computed:            true,
+						MarkdownDescription: "Privacy (encryption) protocol of the user: 'None' (default), 'DES' or 'AES'.",
+						Description:         "Privacy (encryption) protocol of the user: 'None' (default), 'DES' or 'AES'.",
+						Default:             stringdefault.StaticString(SNMP_PRIVACY_PROTOCOL_NONE),
+						Validators: []validator.String{
+							stringvalidator.OneOf([]string{
+								SNMP_PRIVACY_PROTOCOL_NONE,
+								"DES",
+								"AES",
+							}...),
+						},
+					},
+					"privacy_key": schema.StringAttribute{
+						Optional:            true,
+						Sensitive:           true,
+						WriteOnly:           true,
+						MarkdownDescription: "Privacy key of the user (at least 8 characters). Required if privacy protocol is other than 'None'. It's never stored in state, like authentication key.",
+						Description:         "Privacy key of the user (at least 8 characters). Required if privacy protocol is other than 'None'. It's never stored in state, like authentication key.",
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(SNMP_KEY_MIN_LENGTH),
+						},
+					},
+					"keys_version": snmpSecretVersionSchema("keys"),
+				},
+			},
+		},
+		"trap_destinations": schema.ListNestedAttribute{
+			Optional:            true,
+			MarkdownDescription: "Receivers of SNMP traps sent by iRMC. If not set, trap destinations are not managed.",
+			Description:         "Receivers of SNMP traps sent by iRMC. If not set, trap destinations are not managed.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"address": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "IP address or host name of trap receiver.",
+						Description:         "IP address or host name of trap receiver.",
+						Validators: []validator.String{
+							validators.IsHostAddress(),
+						},
+					},
+					"port": schema.Int64Attribute{
+						Optional:            true,
+						Computed:            true,
+						MarkdownDescription: "Port of trap receiver. Default value is 162.",
+						Description:         "Port of trap receiver. Default value is 162.",
+						Default:             int64default.StaticInt64(SNMP_TRAP_DEFAULT_PORT),
+						Validators: []validator.Int64{
+							int64validator.Between(1, 65535),
+						},
+					},
+					"version": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "SNMP version of traps: 'v1', 'v2c' or 'v3'.",
+						Description:         "SNMP version of traps: 'v1', 'v2c' or 'v3'.",
+						Validators: []validator.String{
+							stringvalidator.OneOf([]string{
+								SNMP_VERSION_V1,
+								SNMP_VERSION_V2C,
+								SNMP_VERSION_V3,
+							}...),
+						},
+					},
+					"community": schema.StringAttribute{
+						Optional:            true,
+						Sensitive:           true,
+						WriteOnly:           true,
+						MarkdownDescription: "Community string of traps. Required for SNMPv1/v2c traps. It's never stored in state and is sent to iRMC only when the destination is created or changed, or when `community_version` changes.",
+						Description:         "Community string of traps. Required for SNMPv1/v2c traps. It's never stored in state and is sent to iRMC only when the destination is created or changed, or when community_version changes.",
+						Validators: []validator.String{
+							stringvalidator.LengthBetween(1, 32),
+						},
+					},
+					"community_version": snmpSecretVersionSchema("community strings"),
+					"user_name": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "Name of SNMPv3 user the traps are sent as. Required for SNMPv3 traps.",
+						Description:         "Name of SNMPv3 user the traps are sent as. Required for SNMPv3 traps.",
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *SnmpResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The resource is used to control (read, modify or import) SNMP agent settings (protocol versions, communities, SNMPv3 users) and SNMP trap destinations of iRMC on Fujitsu server equipped with iRMC controller. Secrets are write-only, so Terraform 1.11 or later is required.",
+		Description:         "The resource is used to control (read, modify or import) SNMP agent settings (protocol versions, communities, SNMPv3 users) and SNMP trap destinations of iRMC on Fujitsu server equipped with iRMC controller. Secrets are write-only, so Terraform 1.11 or later is required.",
+		Attributes:          SnmpSchema(),
+		Blocks:              RedfishServerResourceBlockMap(),
+	}
+}
+
+func (r *SnmpResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	p, ok := req.ProviderData.(*IrmcProvider)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *IrmcProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.p = p
+}
+
+func (r *SnmpResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config models.SnmpResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateSnmpConfig(config)...)
+}
+
+func (r *SnmpResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "resource-snmp: create starts")
+
+	// Read Terraform plan data into the model, write-only secrets are available only in config
+	var plan, config models.SnmpResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan, &config, nil)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	tflog.Info(ctx, "resource-snmp: create ends")
+}
+
+func (r *SnmpResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "resource-snmp: read starts")
+
+	// Read Terraform prior state data into the model
+	var state models.SnmpResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, err := ConnectTargetSystem(r.p, &state.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError("service error: ", err.Error())
+		return
+	}
+
+	defer api.Logout()
+
+	endp, err := getSnmpEndpoints(ctx, api)
+	if err != nil {
+		resp.Diagnostics.AddError("Manager Resource Detection Failed", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(readSnmpSettingsToModel(api, &state, endp)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	tflog.Info(ctx, "resource-snmp: read ends")
+}
+
+func (r *SnmpResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "resource-snmp: update starts")
+
+	// Read Terraform plan, config and state data into the models, write-only secrets are available only in config
+	var plan, config, state models.SnmpResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan, &config, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags := resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	tflog.Info(ctx, "resource-snmp: update ends")
+}
+
+func (r *SnmpResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "resource-snmp: delete starts")
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "resource-snmp: delete ends")
+}
+
+func (r *SnmpResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Info(ctx, "resource-snmp: import starts")
+
+	var config CommonImportConfig
+	err := json.Unmarshal([]byte(req.ID), &config)
+	if err != nil {
+		resp.Diagnostics.AddError("Error while unmarshalling import config", err.Error())
+		return
+	}
+
+	creds := importServerBlock(config.ServerConfig)
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, tkpath.Root("server"), creds)...)
+
+	tflog.Info(ctx, "resource-snmp: import ends")
+}
+
+// apply configures SNMP settings requested by plan and reads them back into plan. Write-only secrets
+// are taken from config. State is nil when resource is created, so every configured secret is sent to iRMC.
+func (r *SnmpResource) apply(ctx context.Context, plan *models.SnmpResourceModel, config *models.SnmpResourceModel,
+	state *models.SnmpResourceModel) (diags diag.Diagnostics) {
+	// Provide synchronization
+	var endpoint = GetServerEndpoint(r.p, plan.RedfishServer)
+	var resource_name = "resource-snmp"
+	mutexPool.Lock(ctx, endpoint, resource_name)
+	defer mutexPool.Unlock(ctx, endpoint, resource_name)
+
+	// Connect to service
+	api, err := ConnectTargetSystem(r.p, &plan.RedfishServer)
+	if err != nil {
+		diags.AddError("service error: ", err.Error())
+		return diags
+	}
+
+	defer api.Logout()
+
+	endp, err := getSnmpEndpoints(ctx, api)
+	if err != nil {
+		diags.AddError("Manager Resource Detection Failed", err.Error())
+		return diags
+	}
+
+	if err = applySnmpSettings(ctx, api, plan, config, state, endp); err != nil {
+		diags.AddError("Error while applying SNMP settings", err.Error())
+		return diags
+	}
+
+	return readSnmpSettingsToModel(api, plan, endp)
+}
+
+type snmpCommunitySettings struct {
+	Name            string `json:"Name"`
+	CommunityString string `json:"CommunityString,omitempty"`
+	AccessMode      string `json:"AccessMode"`
+}
+
+type snmpProtocolSettings struct {
+	SNMP struct {
+		EnableSNMPv1     bool                    `json:"EnableSNMPv1"`
+		EnableSNMPv2c    bool                    `json:"EnableSNMPv2c"`
+		EnableSNMPv3     bool                    `json:"EnableSNMPv3"`
+		CommunityStrings []snmpCommunitySettings `json:"CommunityStrings"`
+	} `json:"SNMP"`
+}
+
+type snmpV3UserSettings struct {
+	UserName               string  `json:"UserName"`
+	AccessMode             string  `json:"AccessMode"`
+	AuthenticationProtocol string  `json:"AuthenticationProtocol"`
+	AuthenticationKey      string  `json:"AuthenticationKey,omitempty"`
+	PrivacyProtocol        string  `json:"PrivacyProtocol"`
+	PrivacyKey             *string `json:"PrivacyKey,omitempty"`
+}
+
+type snmpTrapDestinationSettings struct {
+	Address   string `json:"Address"`
+	Port      int64  `json:"Port"`
+	Version   string `json:"Version"`
+	Community string `json:"Community,omitempty"`
+	UserName  string `json:"UserName,omitempty"`
+}
+
+type snmpAlertingSettings struct {
+	V3Users          []snmpV3UserSettings          `json:"V3Users"`
+	TrapDestinations []snmpTrapDestinationSettings `json:"TrapDestinations"`
+}
+
+// isBoolConfigured reports whether value is set in configuration to expected value.
+func isBoolConfigured(value types.Bool, expected bool) bool {
+	return !value.IsNull() && !value.IsUnknown() && value.ValueBool() == expected
+}
+
+// validateSnmpConfig verifies at plan time that requested SNMP versions, communities, users
+// and trap destinations can be combined together.
+func validateSnmpConfig(config models.SnmpResourceModel) (diags diag.Diagnostics) {
+	const summary = "Invalid SNMP configuration"
+
+	if len(config.CommunityStrings) > 0 && isBoolConfigured(config.ProtocolV1Enabled, false) && isBoolConfigured(config.ProtocolV2cEnabled, false) {
+		diags.AddAttributeError(tkpath.Root("community_strings"), summary,
+			"Communities require SNMPv1 or SNMPv2c to be enabled")
+	}
+
+	names := map[string]bool{}
+	for i, community := range config.CommunityStrings {
+		if community.Name.IsUnknown() {
+			continue
+		}
+		if names[community.Name.ValueString()] {
+			diags.AddAttributeError(tkpath.Root("community_strings").AtListIndex(i).AtName("name"), summary,
+				fmt.Sprintf("Community '%s' is defined more than once", community.Name.ValueString()))
+		}
+		names[community.Name.ValueString()] = true
+	}
+
+	if len(config.V3Users) > 0 && isBoolConfigured(config.ProtocolV3Enabled, false) {
+		diags.AddAttributeError(tkpath.Root("v3_users"), summary,
+			"SNMPv3 users require SNMPv3 to be enabled")
+	}
+
+	users := map[string]bool{}
+	for i, user := range config.V3Users {
+		userPath := tkpath.Root("v3_users").AtListIndex(i)
+		if !user.UserName.IsUnknown() {
+			if users[user.UserName.ValueString()] {
+				diags.AddAttributeError(userPath.AtName("user_name"), summary,
+					fmt.Sprintf("SNMPv3 user '%s' is defined more than once", user.UserName.ValueString()))
+			}
+			users[user.UserName.ValueString()] = true
+		}
+
+		if user.PrivacyProtocol.IsUnknown() || user.PrivacyKey.IsUnknown() {
+			continue
+		}
+
+		privacy := !user.PrivacyProtocol.IsNull() && user.PrivacyProtocol.ValueString() != SNMP_PRIVACY_PROTOCOL_NONE
+		if privacy && user.PrivacyKey.IsNull() {
+			diags.AddAttributeError(userPath.AtName("privacy_key"), summary,
+				fmt.Sprintf("Privacy protocol '%s' requires privacy key", user.PrivacyProtocol.ValueString()))
+		}
+		if !privacy && !user.PrivacyKey.IsNull() {
+			diags.AddAttributeError(userPath.AtName("privacy_key"), summary,
+				"Privacy key cannot be used without privacy protocol")
+		}
+	}
+
+	versionEnabled := map[string]types.Bool{
+		SNMP_VERSION_V1:  config.ProtocolV1Enabled,
+		SNMP_VERSION_V2C: config.ProtocolV2cEnabled,
+		SNMP_VERSION_V3:  config.ProtocolV3Enabled,
+	}
+
+	for i, destination := range config.TrapDestinations {
+		destinationPath := tkpath.Root("trap_destinations").AtListIndex(i)
+		if destination.Version.IsNull() || destination.Version.IsUnknown() {
+			continue
+		}
+
+		version := destination.Version.ValueString()
+		if isBoolConfigured(versionEnabled[version], false) {
+			diags.AddAttributeError(destinationPath.AtName("version"), summary,
+				fmt.Sprintf("SNMP%s traps require SNMP%s to be enabled", version, version))
+		}
+
+		if version != SNMP_VERSION_V3 {
+			if destination.Community.IsNull() {
+				diags.AddAttributeError(destinationPath.AtName("community"), summary,
+					fmt.Sprintf("SNMP%s traps require community", version))
+			}
+			if !destination.UserName.IsNull() {
+				diags.AddAttributeError(destinationPath.AtName("user_name"), summary,
+					fmt.Sprintf("SNMP%s traps cannot be sent as SNMPv3 user", version))
+			}
+			continue
+		}
+
+		if !destination.Community.IsNull() {
+			diags.AddAttributeError(destinationPath.AtName("community"), summary,
+				"SNMPv3 traps do not use community")
+		}
+		if destination.UserName.IsNull() {
+			diags.AddAttributeError(destinationPath.AtName("user_name"), summary,
+				"SNMPv3 traps require SNMPv3 user")
+		} else if !destination.UserName.IsUnknown() && config.V3Users != nil && !users[destination.UserName.ValueString()] {
+			diags.AddAttributeError(destinationPath.AtName("user_name"), summary,
+				fmt.Sprintf("SNMPv3 user '%s' is not defined in v3_users", destination.UserName.ValueString()))
+		}
+	}
+
+	return diags
+}
+
+// applySnmpSettings sends to iRMC only these settings from plan, which are known and differ from current ones.
+// Secrets are write-only and not reported by iRMC, so communities, users and trap destinations are sent (together
+// with secrets taken from config) only if their remaining settings or versions of secrets differ from state.
+// SNMP versions are configured first, since iRMC might reject users of disabled SNMPv3.
+func applySnmpSettings(ctx context.Context, api *gofish.APIClient, plan *models.SnmpResourceModel, config *models.SnmpResourceModel,
+	state *models.SnmpResourceModel, endp snmpEndpoints) error {
+	if len(config.CommunityStrings) != len(plan.CommunityStrings) || len(config.V3Users) != len(plan.V3Users) ||
+		len(config.TrapDestinations) != len(plan.TrapDestinations) {
+		return fmt.Errorf("write-only secrets in configuration do not match planned SNMP settings")
+	}
+
+	var protocol snmpProtocolSettings
+	etag, err := getRedfishResource(api, endp.networkProtocolEndpoint, &protocol)
+	if err != nil {
+		return err
+	}
+
+	snmp := map[string]interface{}{}
+	if !plan.ProtocolV1Enabled.IsNull() && !plan.ProtocolV1Enabled.IsUnknown() && plan.ProtocolV1Enabled.ValueBool() != protocol.SNMP.EnableSNMPv1 {
+		snmp["EnableSNMPv1"] = plan.ProtocolV1Enabled.ValueBool()
+	}
+	if !plan.ProtocolV2cEnabled.IsNull() && !plan.ProtocolV2cEnabled.IsUnknown() && plan.ProtocolV2cEnabled.ValueBool() != protocol.SNMP.EnableSNMPv2c {
+		snmp["EnableSNMPv2c"] = plan.ProtocolV2cEnabled.ValueBool()
+	}
+	if !plan.ProtocolV3Enabled.IsNull() && !plan.ProtocolV3Enabled.IsUnknown() && plan.ProtocolV3Enabled.ValueBool() != protocol.SNMP.EnableSNMPv3 {
+		snmp["EnableSNMPv3"] = plan.ProtocolV3Enabled.ValueBool()
+	}
+
+	if plan.CommunityStrings != nil && (state == nil || !slices.Equal(plan.CommunityStrings, state.CommunityStrings)) {
+		communities := []snmpCommunitySettings{}
+		for i, community := range plan.CommunityStrings {
+			communities = append(communities, snmpCommunitySettings{
+				Name:            community.Name.ValueString(),
+				CommunityString: config.CommunityStrings[i].Community.ValueString(),
+				AccessMode:      community.AccessMode.ValueString(),
+			})
+		}
+		snmp["CommunityStrings"] = communities
+	}
+
+	if len(snmp) > 0 {
+		tflog.Info(ctx, "Changing SNMP protocol settings", map[string]interface{}{
+			"community_strings_changed": snmp["CommunityStrings"] != nil,
+		})
+		if err = patchRedfishResource(api, endp.networkProtocolEndpoint, etag, map[string]interface{}{"SNMP": snmp}); err != nil {
+			return err
+		}
+	}
+
+	settings := map[string]interface{}{}
+	if plan.V3Users != nil && (state == nil || !slices.Equal(plan.V3Users, state.V3Users)) {
+		users := []snmpV3UserSettings{}
+		for i, user := range plan.V3Users {
+			users = append(users, snmpV3UserSettings{
+				UserName:               user.UserName.ValueString(),
+				AccessMode:             user.AccessMode.ValueString(),
+				AuthenticationProtocol: user.AuthProtocol.ValueString(),
+				AuthenticationKey:      config.V3Users[i].AuthKey.ValueString(),
+				PrivacyProtocol:        user.PrivacyProtocol.ValueString(),
+				PrivacyKey:             config.V3Users[i].PrivacyKey.ValueStringPointer(),
+			})
+		}
+		settings["V3Users"] = users
+	}
+
+	if plan.TrapDestinations != nil && (state == nil || !slices.Equal(plan.TrapDestinations, state.TrapDestinations)) {
+		destinations := []snmpTrapDestinationSettings{}
+		for i, destination := range plan.TrapDestinations {
+			destinations = append(destinations, snmpTrapDestinationSettings{
+				Address:   destination.Address.ValueString(),
+				Port:      destination.Port.ValueInt64(),
+				Version:   destination.Version.ValueString(),
+				Community: config.TrapDestinations[i].Community.ValueString(),
+				UserName:  destination.UserName.ValueString(),
+			})
+		}
+		settings["TrapDestinations"] = destinations
+	}
+
+	if len(settings) > 0 {
+		_, usersChanged := settings["V3Users"]
+		_, destinationsChanged := settings["TrapDestinations"]
+		tflog.Info(ctx, "Changing SNMP alerting settings", map[string]interface{}{
+			"v3_users_changed":          usersChanged,
+			"trap_destinations_changed": destinationsChanged,
+		})
+		var alerting snmpAlertingSettings
+		if etag, err = getRedfishResource(api, endp.alertingEndpoint, &alerting); err != nil {
+			return err
+		}
+		if err = patchRedfishResource(api, endp.alertingEndpoint, etag, settings); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// optionalString converts value reported by iRMC into model, where empty string means not set.
+func optionalString(value string) types.String {
+	if len(value) == 0 {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
+
+// readSnmpSettingsToModel reads current SNMP settings of iRMC into model, so changes done outside
+// of Terraform are detected. Lists which are not managed (null in model) are left untouched.
+// Write-only secrets are never kept in model, only versions of secrets are taken over from it.
+func readSnmpSettingsToModel(api *gofish.APIClient, model *models.SnmpResourceModel, endp snmpEndpoints) (diags diag.Diagnostics) {
+	var protocol snmpProtocolSettings
+	if _, err := getRedfishResource(api, endp.networkProtocolEndpoint, &protocol); err != nil {
+		diags.AddError("Error while reading SNMP protocol settings", err.Error())
+		return diags
+	}
+
+	var alerting snmpAlertingSettings
+	if _, err := getRedfishResource(api, endp.alertingEndpoint, &alerting); err != nil {
+		diags.AddError("Error while reading SNMP alerting settings", err.Error())
+		return diags
+	}
+
+	model.Id = types.StringValue(endp.alertingEndpoint)
+	model.ProtocolV1Enabled = types.BoolValue(protocol.SNMP.EnableSNMPv1)
+	model.ProtocolV2cEnabled = types.BoolValue(protocol.SNMP.EnableSNMPv2c)
+	model.ProtocolV3Enabled = types.BoolValue(protocol.SNMP.EnableSNMPv3)
+
+	if model.CommunityStrings != nil {
+		communities := []models.SnmpCommunity{}
+		for _, community := range protocol.SNMP.CommunityStrings {
+			version := types.Int64Null()
+			if i := slices.IndexFunc(model.CommunityStrings, func(c models.SnmpCommunity) bool {
+				return c.Name.ValueString() == community.Name
+			}); i >= 0 {
+				version = model.CommunityStrings[i].CommunityVersion
+			}
+
+			communities = append(communities, models.SnmpCommunity{
+				Name:             types.StringValue(community.Name),
+				Community:        types.StringNull(),
+				CommunityVersion: version,
+				AccessMode:       types.StringValue(community.AccessMode),
+			})
+		}
+		model.CommunityStrings = communities
+	}
+
+	if model.V3Users != nil {
+		users := []models.SnmpV3User{}
+		for _, user := range alerting.V3Users {
+			version := types.Int64Null()
+			if i := slices.IndexFunc(model.V3Users, func(u models.SnmpV3User) bool {
+				return u.UserName.ValueString() == user.UserName
+			}); i >= 0 {
+				version = model.V3Users[i].KeysVersion
+			}
+
+			users = append(users, models.SnmpV3User{
+				UserName:        types.StringValue(user.UserName),
+				AccessMode:      types.StringValue(user.AccessMode),
+				AuthProtocol:    types.StringValue(user.AuthenticationProtocol),
+				AuthKey:         types.StringNull(),
+				PrivacyProtocol: types.StringValue(user.PrivacyProtocol),
+				PrivacyKey:      types.StringNull(),
+				KeysVersion:     version,
+			})
+		}
+		model.V3Users = users
+	}
+
+	if model.TrapDestinations != nil {
+		destinations := []models.SnmpTrapDestination{}
+		for _, destination := range alerting.TrapDestinations {
+			version := types.Int64Null()
+			if i := slices.IndexFunc(model.TrapDestinations, func(d models.SnmpTrapDestination) bool {
+				return d.Address.ValueString() == destination.Address && d.Port.ValueInt64() == destination.Port
+			}); i >= 0 {
+				version = model.TrapDestinations[i].CommunityVersion
+			}
+
+			destinations = append(destinations, models.SnmpTrapDestination{
+				Address:          types.StringValue(destination.Address),
+				Port:             types.Int64Value(destination.Port),
+				Version:          types.StringValue(destination.Version),
+				Community:        types.StringNull(),
+				CommunityVersion: version,
+				UserName:         optionalString(destination.UserName),
+			})
+		}
+		model.TrapDestinations = destinations
+	}
+
+	return diags
+}
+
+func getSnmpEndpoints(ctx context.Context, api *gofish.APIClient) (snmpEndpoints, error) {
+	vendor, err := GetOemVendor(ctx, api)
+	if err != nil {
+		return snmpEndpoints{}, err
+	}
+
+	manager, err := GetManagerEndpoint(api.Service)
+	if err != nil {
+		return snmpEndpoints{}, err
+	}
+
+	return snmpEndpoints{
+		networkProtocolEndpoint: fmt.Sprintf("%s/%s", manager, NETWORK_PROTOCOL_PATH),
+		alertingEndpoint:        vendor.OemPath(manager, SNMP_ALERTING_OEM_PATH),
+	}, nil
+}
@@ -0,0 +1,406 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+const resource_snmp_name = "irmc-redfish_snmp.snmp"
+
+func TestAccRedfishSnmp_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishResourceSnmpConfig(creds, "Limited"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resource_snmp_name, "protocol_v2c_enabled", "true"),
+					resource.TestCheckResourceAttr(resource_snmp_name, "community_strings.0.access_mode", "Limited"),
+					resource.TestCheckResourceAttr(resource_snmp_name, "v3_users.0.privacy_protocol", "AES"),
+					resource.TestCheckResourceAttr(resource_snmp_name, "trap_destinations.#", "2"),
+					resource.TestCheckResourceAttr(resource_snmp_name, "trap_destinations.0.port", "162"),
+				),
+			},
+			{
+				Config: testAccRedfishResourceSnmpConfig(creds, "Full"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resource_snmp_name, "community_strings.0.access_mode", "Full"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRedfishSnmp_import(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:       `resource "irmc-redfish_snmp" "snmp" {}`,
+				ResourceName: resource_snmp_name,
+				ImportState:  true,
+				ImportStateIdFunc: func(d *terraform.State) (string, error) {
+					return fmt.Sprintf("{\"username\":\"%s\", \"password\":\"%s\", \"endpoint\":\"https://%s\", \"ssl_insecure\":true}",
+						creds.Username, creds.Password, creds.Endpoint), nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccRedfishSnmp_negative(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				resource "irmc-redfish_snmp" "snmp" {
+					server {
+					  username     = "%s"
+					  password     = "%s"
+					  endpoint     = "https://%s"
+					  ssl_insecure = true
+					}
+
+					protocol_v3_enabled = false
+					v3_users = [{
+					  user_name     = "monitor"
+					  auth_protocol = "SHA256"
+					  auth_key      = "authKey123"
+					}]
+				}
+				`, creds.Username, creds.Password, creds.Endpoint),
+				ExpectError: regexp.MustCompile("SNMPv3 users require SNMPv3 to be enabled"),
+			},
+		},
+	})
+}
+
+func testAccRedfishResourceSnmpConfig(testingInfo TestingServerCredentials, accessMode string) string {
+	return fmt.Sprintf(`
+	resource "irmc-redfish_snmp" "snmp" {
+
+		server {
+		  username     = "%s"
+		  password     = "%s"
+		  endpoint     = "https://%s"
+		  ssl_insecure = true
+		}
+
+		protocol_v1_enabled  = false
+		protocol_v2c_enabled = true
+		protocol_v3_enabled  = true
+
+		community_strings = [{
+		  name        = "monitoring"
+		  community   = "c0mmun1ty"
+		  access_mode = "%s"
+		}]
+
+		v3_users = [{
+		  user_name        = "monitor"
+		  auth_protocol    = "SHA256"
+		  auth_key         = "authKey123"
+		  privacy_protocol = "AES"
+		  privacy_key      = "privKey123"
+		}]
+
+		trap_destinations = [
+		  {
+		    address   = "192.0.2.30"
+		    version   = "v2c"
+		    community = "c0mmun1ty"
+		  },
+		  {
+		    address   = "192.0.2.31"
+		    version   = "v3"
+		    user_name = "monitor"
+		  },
+		]
+	  }
+	`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		accessMode,
+	)
+}
+
+// testSnmpConfig returns configuration of SNMP resource including write-only secrets.
+func testSnmpConfig() models.SnmpResourceModel {
+	return models.SnmpResourceModel{
+		ProtocolV1Enabled:  types.BoolUnknown(),
+		ProtocolV2cEnabled: types.BoolValue(true),
+		ProtocolV3Enabled:  types.BoolValue(true),
+		CommunityStrings: []models.SnmpCommunity{{
+			Name:       types.StringValue("monitoring"),
+			Community:  types.StringValue("c0mmun1ty"),
+			AccessMode: types.StringValue(SNMP_ACCESS_MODE_LIMITED),
+		}},
+		V3Users: []models.SnmpV3User{{
+			UserName:        types.StringValue("monitor"),
+			AccessMode:      types.StringValue(SNMP_ACCESS_MODE_LIMITED),
+			AuthProtocol:    types.StringValue("SHA256"),
+			AuthKey:         types.StringValue("authKey123"),
+			PrivacyProtocol: types.StringValue("AES"),
+			PrivacyKey:      types.StringValue("privKey123"),
+		}},
+		TrapDestinations: []models.SnmpTrapDestination{{
+			Address:   types.StringValue("192.0.2.30"),
+			Port:      types.Int64Value(SNMP_TRAP_DEFAULT_PORT),
+			Version:   types.StringValue(SNMP_VERSION_V2C),
+			Community: types.StringValue("c0mmun1ty"),
+			UserName:  types.StringNull(),
+		}, {
+			Address:   types.StringValue("192.0.2.31"),
+			Port:      types.Int64Value(SNMP_TRAP_DEFAULT_PORT),
+			Version:   types.StringValue(SNMP_VERSION_V3),
+			Community: types.StringNull(),
+			UserName:  types.StringValue("monitor"),
+		}},
+	}
+}
+
+// testSnmpPlanFromConfig returns plan derived by Terraform from config, where write-only secrets are null.
+func testSnmpPlanFromConfig(config models.SnmpResourceModel) models.SnmpResourceModel {
+	plan := config
+	plan.CommunityStrings = slices.Clone(config.CommunityStrings)
+	for i := range plan.CommunityStrings {
+		plan.CommunityStrings[i].Community = types.StringNull()
+	}
+	plan.V3Users = slices.Clone(config.V3Users)
+	for i := range plan.V3Users {
+		plan.V3Users[i].AuthKey = types.StringNull()
+		plan.V3Users[i].PrivacyKey = types.StringNull()
+	}
+	plan.TrapDestinations = slices.Clone(config.TrapDestinations)
+	for i := range plan.TrapDestinations {
+		plan.TrapDestinations[i].Community = types.StringNull()
+	}
+	return plan
+}
+
+func TestApplySnmpSettings(t *testing.T) {
+	forEachMockFlavor(t, func(t *testing.T, m *mockRedfishServer) {
+		api := m.connect()
+		ctx := context.Background()
+		endp, err := getSnmpEndpoints(ctx, api)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+
+		config := testSnmpConfig()
+		plan := testSnmpPlanFromConfig(config)
+		etag := m.etag(endp.alertingEndpoint)
+		if err = applySnmpSettings(ctx, api, &plan, &config, nil, endp); err != nil {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+
+		if patches := m.requestsTo(http.MethodPatch, endp.alertingEndpoint); len(patches) != 1 || patches[0].Header.Get(HTTP_HEADER_IF_MATCH) != etag {
+			t.Errorf("Expected single PATCH of alerting settings with If-Match '%s', got %v", etag, patches)
+		}
+
+		snmp, _ := m.get(endp.networkProtocolEndpoint)["SNMP"].(map[string]interface{})
+		communities, _ := snmp["CommunityStrings"].([]interface{})
+		if community, _ := communities[0].(map[string]interface{}); len(communities) != 1 || community["CommunityString"] != "c0mmun1ty" {
+			t.Errorf("Unexpected SNMP protocol settings %v", snmp)
+		}
+		if body, _ := m.requestsTo(http.MethodPatch, endp.networkProtocolEndpoint)[0].Body["SNMP"].(map[string]interface{}); body["EnableSNMPv2c"] != nil {
+			t.Errorf("SNMP version equal to current one must not be sent")
+		}
+
+		alerting := m.get(endp.alertingEndpoint)
+		users, _ := alerting["V3Users"].([]interface{})
+		destinations, _ := alerting["TrapDestinations"].([]interface{})
+		user, _ := users[0].(map[string]interface{})
+		if len(users) != 1 || user["PrivacyKey"] != "privKey123" || len(destinations) != 2 {
+			t.Errorf("Unexpected SNMP alerting settings %v", alerting)
+		}
+		if destination, _ := destinations[1].(map[string]interface{}); destination["Community"] != nil {
+			t.Errorf("SNMPv3 trap destination must not carry community, got %v", destination)
+		}
+
+		// Lists equal to state must not be sent again, since write-only secrets cannot be compared
+		state := plan
+		patches := len(m.requestsTo(http.MethodPatch, endp.alertingEndpoint))
+		config.V3Users[0].AuthKey = types.StringValue("changedKey123")
+		if err = applySnmpSettings(ctx, api, &plan, &config, &state, endp); err != nil {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+		if count := len(m.requestsTo(http.MethodPatch, endp.alertingEndpoint)); count != patches {
+			t.Errorf("Got %d PATCH requests for unchanged settings, expected none", count-patches)
+		}
+
+		// Rotated keys are sent once their version changes
+		config.V3Users[0].KeysVersion = types.Int64Value(2)
+		plan = testSnmpPlanFromConfig(config)
+		if err = applySnmpSettings(ctx, api, &plan, &config, &state, endp); err != nil {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+		requests := m.requestsTo(http.MethodPatch, endp.alertingEndpoint)
+		if body := requests[len(requests)-1].Body; body["TrapDestinations"] != nil || body["V3Users"] == nil {
+			t.Errorf("Only changed users must be sent, got %v", body)
+		}
+		users, _ = m.get(endp.alertingEndpoint)["V3Users"].([]interface{})
+		if user, _ = users[0].(map[string]interface{}); user["AuthenticationKey"] != "changedKey123" {
+			t.Errorf("Rotated key has not been sent, got %v", user)
+		}
+	})
+}
+
+func TestReadSnmpSettingsToModel(t *testing.T) {
+	m := newMockRedfishServer(t, FSAS)
+	api := m.connect()
+	ctx := context.Background()
+	endp, _ := getSnmpEndpoints(ctx, api)
+
+	// Secrets are hidden by iRMC, remaining settings changed outside of Terraform
+	m.update(endp.alertingEndpoint, map[string]interface{}{
+		"V3Users": []interface{}{map[string]interface{}{
+			"UserName": "monitor", "AccessMode": "Full", "AuthenticationProtocol": "SHA256", "PrivacyProtocol": "AES",
+		}},
+		"TrapDestinations": []interface{}{map[string]interface{}{
+			"Address": "192.0.2.30", "Port": 162, "Version": "v2c", "UserName": "",
+		}},
+	})
+
+	model := testSnmpPlanFromConfig(testSnmpConfig())
+	model.ProtocolV1Enabled = types.BoolValue(true)
+	model.V3Users[0].KeysVersion = types.Int64Value(3)
+	model.TrapDestinations[0].CommunityVersion = types.Int64Value(1)
+	if diags := readSnmpSettingsToModel(api, &model, endp); diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+
+	if model.ProtocolV1Enabled.ValueBool() || !model.ProtocolV2cEnabled.ValueBool() || model.Id.ValueString() != endp.alertingEndpoint {
+		t.Errorf("Unexpected model %v", model)
+	}
+
+	// Community 'monitoring' is not configured on iRMC, 'public' is not known to Terraform
+	if len(model.CommunityStrings) != 1 || model.CommunityStrings[0].Name.ValueString() != "public" || !model.CommunityStrings[0].Community.IsNull() {
+		t.Errorf("Unexpected communities %v", model.CommunityStrings)
+	}
+
+	// Write-only secrets are never stored, versions of secrets are kept
+	if len(model.V3Users) != 1 || model.V3Users[0].AccessMode.ValueString() != "Full" || !model.V3Users[0].AuthKey.IsNull() ||
+		model.V3Users[0].KeysVersion.ValueInt64() != 3 {
+		t.Errorf("Unexpected SNMPv3 users %v", model.V3Users)
+	}
+
+	if len(model.TrapDestinations) != 1 || !model.TrapDestinations[0].Community.IsNull() || model.TrapDestinations[0].CommunityVersion.ValueInt64() != 1 ||
+		!model.TrapDestinations[0].UserName.IsNull() {
+		t.Errorf("Unexpected trap destinations %v", model.TrapDestinations)
+	}
+
+	// Lists not managed by Terraform stay unmanaged
+	model = models.SnmpResourceModel{}
+	if diags := readSnmpSettingsToModel(api, &model, endp); diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+	if model.CommunityStrings != nil || model.V3Users != nil || model.TrapDestinations != nil {
+		t.Errorf("Unmanaged lists must stay null, got %v", model)
+	}
+}
+
+func TestValidateSnmpConfig(t *testing.T) {
+	tests := map[string]struct {
+		change func(config *models.SnmpResourceModel)
+		error  string
+	}{
+		"valid": {
+			change: func(config *models.SnmpResourceModel) {},
+		},
+		"communities without v1 and v2c": {
+			change: func(config *models.SnmpResourceModel) {
+				config.ProtocolV1Enabled = types.BoolValue(false)
+				config.ProtocolV2cEnabled = types.BoolValue(false)
+				config.TrapDestinations = nil
+			},
+			error: "Communities require SNMPv1 or SNMPv2c to be enabled",
+		},
+		"duplicated user": {
+			change: func(config *models.SnmpResourceModel) {
+				config.V3Users = append(config.V3Users, config.V3Users[0])
+			},
+			error: "SNMPv3 user 'monitor' is defined more than once",
+		},
+		"privacy protocol without key": {
+			change: func(config *models.SnmpResourceModel) {
+				config.V3Users[0].PrivacyKey = types.StringNull()
+			},
+			error: "Privacy protocol 'AES' requires privacy key",
+		},
+		"privacy key without protocol": {
+			change: func(config *models.SnmpResourceModel) {
+				config.V3Users[0].PrivacyProtocol = types.StringValue(SNMP_PRIVACY_PROTOCOL_NONE)
+			},
+			error: "Privacy key cannot be used without privacy protocol",
+		},
+		"v2c trap without community": {
+			change: func(config *models.SnmpResourceModel) {
+				config.TrapDestinations[0].Community = types.StringNull()
+			},
+			error: "SNMPv2c traps require community",
+		},
+		"v3 trap with unknown user": {
+			change: func(config *models.SnmpResourceModel) {
+				config.TrapDestinations[1].UserName = types.StringValue("nobody")
+			},
+			error: "SNMPv3 user 'nobody' is not defined in v3_users",
+		},
+		"v1 trap with v1 disabled": {
+			change: func(config *models.SnmpResourceModel) {
+				config.ProtocolV1Enabled = types.BoolValue(false)
+				config.TrapDestinations[0].Version = types.StringValue(SNMP_VERSION_V1)
+			},
+			error: "SNMPv1 traps require SNMPv1 to be enabled",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			config := testSnmpConfig()
+			test.change(&config)
+
+			diags := validateSnmpConfig(config)
+			if test.error == "" {
+				if diags.HasError() {
+					t.Errorf("Unexpected errors %v", diags)
+				}
+				return
+			}
+
+			if !diags.HasError() || !strings.Contains(diags.Errors()[0].Detail(), test.error) {
+				t.Errorf("Expected error '%s', got %v", test.error, diags)
+			}
+		})
+	}
+}
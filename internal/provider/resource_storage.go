@@ -291,7 +291,7 @@ func (r *StorageResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
-	var endpoint = plan.RedfishServer[0].Endpoint.ValueString()
+	var endpoint = GetServerEndpoint(r.p, plan.RedfishServer)
 	var resource_name = "resource-storage"
 	mutexPool.Lock(ctx, endpoint, resource_name)
 	defer mutexPool.Unlock(ctx, endpoint, resource_name)
@@ -366,7 +366,7 @@ func (r *StorageResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
-	var endpoint = plan.RedfishServer[0].Endpoint.ValueString()
+	var endpoint = GetServerEndpoint(r.p, plan.RedfishServer)
 	var resource_name = "resource-storage"
 	mutexPool.Lock(ctx, endpoint, resource_name)
 	defer mutexPool.Unlock(ctx, endpoint, resource_name)
@@ -421,14 +421,7 @@ func (r *StorageResource) ImportState(ctx context.Context, req resource.ImportSt
 		return
 	}
 
-	server := models.RedfishServer{
-		User:        types.StringValue(config.Username),
-		Password:    types.StringValue(config.Password),
-		Endpoint:    types.StringValue(config.Endpoint),
-		SslInsecure: types.BoolValue(config.SslInsecure),
-	}
-
-	creds := []models.RedfishServer{server}
+	creds := importServerBlock(config.ServerConfig)
 
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, tkpath.Root("server"), creds)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, tkpath.Root("storage_controller_serial_number"), config.SN)...)
@@ -18,11 +18,14 @@ limitations under the License.
 package provider
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"regexp"
+	"terraform-provider-irmc-redfish/internal/models"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
@@ -244,3 +247,71 @@ func testAccStorageResourceConfigEmptyPayload(testingInfo TestingServerCredentia
 		serial,
 	)
 }
+
+const mockStorageSerial = "SKC4910421"
+
+func TestReadStorageControllerSettingsToState(t *testing.T) {
+	forEachMockFlavor(t, func(t *testing.T, m *mockRedfishServer) {
+		api := m.connect()
+
+		state := models.StorageSettings{StorageControllerSN: types.StringValue(mockStorageSerial)}
+		odataid, diags := readStorageControllerSettingsToState(api.Service, &state)
+		if diags.HasError() {
+			t.Fatalf("Unexpected error: %v", diags)
+		}
+
+		if odataid != "/redfish/v1/Systems/0/Storage/0" {
+			t.Errorf("Got odataid %s", odataid)
+		}
+
+		if state.BiosContinueOnError.ValueString() != "StopOnErrors" || state.PatrolReadRate.ValueInt64() != 30 ||
+			!state.AutoRebuild.ValueBool() || state.CoercionMode.ValueString() != "None" {
+			t.Errorf("Storage settings read incorrectly: %+v", state)
+		}
+
+		state.StorageControllerSN = types.StringValue("NOTEXISTING")
+		if _, diags = readStorageControllerSettingsToState(api.Service, &state); !diags.HasError() {
+			t.Errorf("Expected error for not existing controller serial")
+		}
+	})
+}
+
+func TestApplyStorageControllerProperties(t *testing.T) {
+	forEachMockFlavor(t, func(t *testing.T, m *mockRedfishServer) {
+		api := m.connect()
+
+		plan := models.StorageResourceModel{
+			JobTimeout: types.Int64Value(60),
+			StorageSettings: models.StorageSettings{
+				StorageControllerSN: types.StringValue(mockStorageSerial),
+				BiosContinueOnError: types.StringValue("PauseOnErrors"),
+				BGIRate:             types.Int64Value(40),
+			},
+		}
+
+		if diags := applyStorageControllerProperties(context.Background(), api, &plan); diags.HasError() {
+			t.Fatalf("Unexpected error: %v", diags)
+		}
+
+		if plan.Id.ValueString() != "/redfish/v1/Systems/0/Storage/0" {
+			t.Errorf("Got id %s", plan.Id.ValueString())
+		}
+
+		controller := m.get("/redfish/v1/Systems/0/Storage/0")["StorageControllers"].([]interface{})[0].(map[string]interface{})
+		oem := controller["Oem"].(map[string]interface{})[m.oemKey].(map[string]interface{})
+		if oem["BIOSContinueOnError"] != "PauseOnErrors" || oem["BGIRate"] != float64(40) || oem["PatrolRead"] != "Automatic" {
+			t.Errorf("Storage controller settings not applied as expected: %v", oem)
+		}
+	})
+
+	t.Run("EmptyPlan", func(t *testing.T) {
+		m := newMockRedfishServer(t, FSAS)
+		plan := models.StorageResourceModel{
+			StorageSettings: models.StorageSettings{StorageControllerSN: types.StringValue(mockStorageSerial)},
+		}
+
+		if diags := applyStorageControllerProperties(context.Background(), m.connect(), &plan); !diags.HasError() {
+			t.Errorf("Expected error for empty plan")
+		}
+	})
+}
@@ -52,11 +52,9 @@ type StorageVolumeResource struct {
 }
 
 const (
-	STORAGE_COLLECTION_ENDPOINT          = "/redfish/v1/Systems/0/Storage"
-	STORAGE_RAIDCAPABILITIES_SUFFIX      = "/Oem/ts_fujitsu/RAIDCapabilities"
-	STORAGE_RAIDCAPABILITIES_FSAS_SUFFIX = "/Oem/Fsas/RAIDCapabilities"
-	STORAGE_VOLUME_RESOURCE_NAME         = "resource-storage_volume"
-	STORAGE_VOLUME_JOB_DEFAULT_TIMEOUT   = 300
+	STORAGE_RAIDCAPABILITIES_OEM_PATH  = "RAIDCapabilities"
+	STORAGE_VOLUME_RESOURCE_NAME       = "resource-storage_volume"
+	STORAGE_VOLUME_JOB_DEFAULT_TIMEOUT = 300
 )
 
 func (r *StorageVolumeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -273,7 +271,7 @@ func (r *StorageVolumeResource) Create(ctx context.Context, req resource.CreateR
 	}
 
 	// Provide synchronization
-	var endpoint = plan.RedfishServer[0].Endpoint.ValueString()
+	var endpoint = GetServerEndpoint(r.p, plan.RedfishServer)
 	mutexPool.Lock(ctx, endpoint, STORAGE_VOLUME_RESOURCE_NAME)
 	defer mutexPool.Unlock(ctx, endpoint, STORAGE_VOLUME_RESOURCE_NAME)
 
@@ -380,7 +378,7 @@ func (r *StorageVolumeResource) Update(ctx context.Context, req resource.UpdateR
 	}
 
 	// Provide synchronization
-	var endpoint = plan.RedfishServer[0].Endpoint.ValueString()
+	var endpoint = GetServerEndpoint(r.p, plan.RedfishServer)
 	mutexPool.Lock(ctx, endpoint, STORAGE_VOLUME_RESOURCE_NAME)
 	defer mutexPool.Unlock(ctx, endpoint, STORAGE_VOLUME_RESOURCE_NAME)
 
@@ -422,7 +420,7 @@ func (r *StorageVolumeResource) Delete(ctx context.Context, req resource.DeleteR
 	}
 
 	// Provide synchronization
-	var endpoint = state.RedfishServer[0].Endpoint.ValueString()
+	var endpoint = GetServerEndpoint(r.p, state.RedfishServer)
 	mutexPool.Lock(ctx, endpoint, STORAGE_VOLUME_RESOURCE_NAME)
 	defer mutexPool.Unlock(ctx, endpoint, STORAGE_VOLUME_RESOURCE_NAME)
 
@@ -435,14 +433,14 @@ func (r *StorageVolumeResource) Delete(ctx context.Context, req resource.DeleteR
 
 	defer api.Logout()
 
-	is_fsas, err := IsFsasCheck(ctx, api)
+	vendor, err := GetOemVendor(ctx, api)
 	if err != nil {
 		resp.Diagnostics.AddError("Vendor detection failed: ", err.Error())
 		return
 	}
 
 	// Try to delete handled volume
-	diags = deleteStorageVolume(ctx, api.Service, state.Id.ValueString(), is_fsas, state.JobTimeout.ValueInt64())
+	diags = deleteStorageVolume(ctx, api.Service, state.Id.ValueString(), vendor, state.JobTimeout.ValueInt64())
 	resp.Diagnostics.Append(diags...)
 
 	if diags.HasError() {
@@ -467,18 +465,13 @@ func (r *StorageVolumeResource) ImportState(ctx context.Context, req resource.Im
 		return
 	}
 
-	server := models.RedfishServer{
-		User:        types.StringValue(config.Username),
-		Password:    types.StringValue(config.Password),
-		Endpoint:    types.StringValue(config.Endpoint),
-		SslInsecure: types.BoolValue(config.SslInsecure),
-	}
+	creds := importServerBlock(config.ServerConfig)
 
 	// no need to read current configuration since terraform will call Read() once
 	// import procedure will be successfully finished
 
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), config.ID)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("server"), []models.RedfishServer{server})...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("server"), creds)...)
 
 	tflog.Info(ctx, "resource-storage-volume: import ends")
 }
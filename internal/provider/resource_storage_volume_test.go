@@ -18,12 +18,17 @@ limitations under the License.
 package provider
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"regexp"
+	"terraform-provider-irmc-redfish/internal/models"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stmcginnis/gofish/redfish"
 )
 
 const (
@@ -111,3 +116,141 @@ func testAccRedfishResourceStorageVolumeConfig_withCapacity(testingInfo TestingS
 		write_mode,
 	)
 }
+
+func mockStorageVolumePlan(drives ...string) models.StorageVolumeResourceModel {
+	groups := []attr.Value{}
+	for _, group := range drives {
+		groups = append(groups, types.StringValue(group))
+	}
+
+	return models.StorageVolumeResourceModel{
+		StorageControllerSN: types.StringValue(mockStorageSerial),
+		JobTimeout:          types.Int64Value(60),
+		RaidType:            types.StringValue("RAID1"),
+		VolumeName:          types.StringValue("MockVolume"),
+		PhysicalDrives:      types.ListValueMust(types.StringType, groups),
+		OptimumIOSizeBytes:  types.Int64Value(65536),
+		ReadMode:            &models.StorageVolumeDynamicParam{Requested: types.StringValue("NoReadAhead")},
+		WriteMode:           &models.StorageVolumeDynamicParam{Requested: types.StringValue("WriteThrough")},
+	}
+}
+
+func TestVerifyRequestedDisks(t *testing.T) {
+	m := newMockRedfishServer(t, FSAS)
+	api := m.connect()
+
+	storage, err := getSystemStorageFromSerialNumber(api.Service, mockStorageSerial)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+
+	groups, mediaType, err := verifyRequestedDisks(context.Background(), mockStorageVolumePlan(`["64-0","64-1"]`), storage)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+
+	if len(groups) != 1 || len(groups[0].Group) != 2 || mediaType != redfish.HDDMediaType {
+		t.Errorf("Got groups %v and media type %s", groups, mediaType)
+	}
+
+	if _, _, err = verifyRequestedDisks(context.Background(), mockStorageVolumePlan(`64-0`), storage); err == nil {
+		t.Errorf("Expected error for malformed disk group")
+	}
+}
+
+func TestValidateRequestAgainstStorageControllerCapabilities(t *testing.T) {
+	forEachMockFlavor(t, func(t *testing.T, m *mockRedfishServer) {
+		api := m.connect()
+
+		plan := mockStorageVolumePlan(`["64-0","64-1"]`)
+		if _, err := validateRequestAgainstStorageControllerCapabilities(context.Background(), api.Service, mockStorageSerial, m.vendor(), plan); err != nil {
+			t.Errorf("Unexpected error: %s", err.Error())
+		}
+
+		plan.RaidType = types.StringValue("RAID6")
+		if _, err := validateRequestAgainstStorageControllerCapabilities(context.Background(), api.Service, mockStorageSerial, m.vendor(), plan); err == nil {
+			t.Errorf("Expected error for not supported RAID type")
+		}
+
+		plan = mockStorageVolumePlan(`["64-0","64-1"]`)
+		plan.OptimumIOSizeBytes = types.Int64Value(1024)
+		if _, err := validateRequestAgainstStorageControllerCapabilities(context.Background(), api.Service, mockStorageSerial, m.vendor(), plan); err == nil {
+			t.Errorf("Expected error for not supported stripe size")
+		}
+
+		plan = mockStorageVolumePlan(`["64-0"]`, `["64-1"]`)
+		if _, err := validateRequestAgainstStorageControllerCapabilities(context.Background(), api.Service, mockStorageSerial, m.vendor(), plan); err == nil {
+			t.Errorf("Expected error for not supported number of disk groups")
+		}
+	})
+}
+
+func TestRequestAndSuperviseVolumeCreationProcess(t *testing.T) {
+	forEachMockFlavor(t, func(t *testing.T, m *mockRedfishServer) {
+		api := m.connect()
+		plan := mockStorageVolumePlan(`["64-0","64-1"]`)
+
+		idsBefore, diags := getVolumesIdsList(api.Service, mockStorageSerial)
+		if diags.HasError() {
+			t.Fatalf("Unexpected error: %v", diags)
+		}
+
+		if diags = requestAndSuperviseVolumeCreationProcess(context.Background(), api, plan); diags.HasError() {
+			t.Fatalf("Unexpected error: %v", diags)
+		}
+
+		idsAfter, diags := getVolumesIdsList(api.Service, mockStorageSerial)
+		if diags.HasError() {
+			t.Fatalf("Unexpected error: %v", diags)
+		}
+
+		newId := getRecentlyCreatedVolumeId(idsAfter, idsBefore)
+		if newId == "" {
+			t.Fatalf("Volume has not been created, volumes %v", idsAfter)
+		}
+
+		volume, diags, _ := doesVolumeStillExist(api.Service, newId)
+		if diags.HasError() {
+			t.Fatalf("Unexpected error: %v", diags)
+		}
+
+		state := models.StorageVolumeResourceModel{
+			ReadMode:  &models.StorageVolumeDynamicParam{},
+			WriteMode: &models.StorageVolumeDynamicParam{},
+		}
+		if diags = readStorageVolumeToState(volume, mockStorageSerial, &state); diags.HasError() {
+			t.Fatalf("Unexpected error: %v", diags)
+		}
+
+		if state.VolumeName.ValueString() != "MockVolume" || state.RaidType.ValueString() != "RAID1" ||
+			state.ReadMode.Actual.ValueString() != "NoReadAhead" || state.WriteMode.Actual.ValueString() != "WriteThrough" {
+			t.Errorf("Volume state read incorrectly: %+v", state)
+		}
+	})
+}
+
+func TestDoesVolumeStillExist(t *testing.T) {
+	m := newMockRedfishServer(t, FSAS)
+	api := m.connect()
+
+	volume, diags, remove := doesVolumeStillExist(api.Service, "/redfish/v1/Systems/0/Storage/0/Volumes/0")
+	if diags.HasError() || remove || volume == nil {
+		t.Fatalf("Expected existing volume, got %v, remove %t", diags, remove)
+	}
+
+	_, diags, remove = doesVolumeStillExist(api.Service, "/redfish/v1/Systems/0/Storage/0/Volumes/7")
+	if !diags.HasError() || !remove {
+		t.Errorf("Expected not existing volume to be removed from state")
+	}
+}
+
+func TestGetStorageIdFromVolumeODataId(t *testing.T) {
+	if id := getStorageIdFromVolumeODataId("/redfish/v1/Systems/0/Storage/1001/Volumes/0"); id != "1001" {
+		t.Errorf("Got storage id %s, expected 1001", id)
+	}
+
+	state := models.StorageVolumeResourceModel{Id: types.StringValue("/redfish/v1/Systems/0/Storage/1001/Volumes/2")}
+	if !updateVolumeODataId("/redfish/v1/Systems/0/Storage/0", &state) {
+		t.Errorf("Expected volume id to be updated")
+	}
+}
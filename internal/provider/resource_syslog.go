@@ -0,0 +1,436 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"terraform-provider-irmc-redfish/internal/models"
+	"terraform-provider-irmc-redfish/internal/validators"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	tkpath "github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/stmcginnis/gofish"
+)
+
+const (
+	SYSLOG_ALERTING_OEM_PATH = "iRMCConfiguration/Alerting/Syslog"
+	SYSLOG_DEFAULT_PORT      = 514
+
+	ALERT_SEVERITY_DEFAULT = "Minor"
+)
+
+// alertSeverities lists severities of iRMC events used to filter alerts, from the lowest one.
+var alertSeverities = []string{"Informational", "Minor", "Major", "Critical"}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SyslogResource{}
+var _ resource.ResourceWithImportState = &SyslogResource{}
+var _ resource.ResourceWithValidateConfig = &SyslogResource{}
+
+func NewSyslogResource() resource.Resource {
+	return &SyslogResource{}
+}
+
+// SyslogResource defines the resource implementation.
+type SyslogResource struct {
+	p *IrmcProvider
+}
+
+func (r *SyslogResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + syslogName
+}
+
+func alertSeveritySchema() schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		MarkdownDescription: "Lowest severity of events which are sent: 'Informational', 'Minor' (default), 'Major' or 'Critical'.",
+		Description:         "Lowest severity of events which are sent: 'Informational', 'Minor' (default), 'Major' or 'Critical'.",
+		Default:             stringdefault.StaticString(ALERT_SEVERITY_DEFAULT),
+		Validators: []validator.String{
+			stringvalidator.OneOf(alertSeverities...),
+		},
+	}
+}
+
+func SyslogSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "ID of syslog forwarding settings resource on iRMC.",
+			Description:         "ID of syslog forwarding settings resource on iRMC.",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"enabled": schema.BoolAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Indicates whether events are forwarded to syslog servers. If not set, current value is kept.",
+			Description:         "Indicates whether events are forwarded to syslog servers. If not set, current value is kept.",
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"servers": schema.ListNestedAttribute{
+			Optional:            true,
+			MarkdownDescription: "Remote syslog servers events are forwarded to. If not set, servers are not managed.",
+			Description:         "Remote syslog servers events are forwarded to. If not set, servers are not managed.",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"address": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "IP address or host name of syslog server.",
+						Description:         "IP address or host name of syslog server.",
+						Validators: []validator.String{
+							validators.IsHostAddress(),
+						},
+					},
+					"port": schema.Int64Attribute{
+						Optional:            true,
+						Computed:            true,
+						MarkdownDescription: "Port of syslog server. Default value is 514.",
+						Description:         "Port of syslog server. Default value is 514.",
+						Default:             int64default.StaticInt64(SYSLOG_DEFAULT_PORT),
+						Validators: []validator.Int64{
+							int64validator.Between(1, 65535),
+						},
+					},
+					"protocol": schema.StringAttribute{
+						Optional:            true,
+						Computed:            true,
+						MarkdownDescription: "Transport protocol used to reach syslog server: 'UDP' (default) or 'TCP'.",
+						Description:         "Transport protocol used to reach syslog server: 'UDP' (default) or 'TCP'.",
+						Default:             stringdefault.StaticString("UDP"),
+						Validators: []validator.String{
+							stringvalidator.OneOf([]string{
+								"UDP",
+								"TCP",
+							}...),
+						},
+					},
+					"minimum_severity": alertSeveritySchema(),
+				},
+			},
+		},
+	}
+}
+
+func (r *SyslogResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The resource is used to control (read, modify or import) forwarding of iRMC events to remote syslog servers on Fujitsu server equipped with iRMC controller.",
+		Description:         "The resource is used to control (read, modify or import) forwarding of iRMC events to remote syslog servers on Fujitsu server equipped with iRMC controller.",
+		Attributes:          SyslogSchema(),
+		Blocks:              RedfishServerResourceBlockMap(),
+	}
+}
+
+func (r *SyslogResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	p, ok := req.ProviderData.(*IrmcProvider)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *IrmcProvider, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.p = p
+}
+
+func (r *SyslogResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config models.SyslogResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if isBoolConfigured(config.Enabled, true) && config.Servers != nil && len(config.Servers) == 0 {
+		resp.Diagnostics.AddAttributeError(tkpath.Root("servers"), "Invalid syslog configuration",
+			"Forwarding of events requires at least one syslog server")
+	}
+
+	servers := map[string]bool{}
+	for i, server := range config.Servers {
+		if server.Address.IsUnknown() || server.Port.IsUnknown() {
+			continue
+		}
+
+		key := fmt.Sprintf("%s:%d", server.Address.ValueString(), server.Port.ValueInt64())
+		if servers[key] {
+			resp.Diagnostics.AddAttributeError(tkpath.Root("servers").AtListIndex(i), "Invalid syslog configuration",
+				fmt.Sprintf("Syslog server %s is defined more than once", key))
+		}
+		servers[key] = true
+	}
+}
+
+func (r *SyslogResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "resource-syslog: create starts")
+
+	// Read Terraform plan data into the model
+	var plan models.SyslogResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	tflog.Info(ctx, "resource-syslog: create ends")
+}
+
+func (r *SyslogResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Info(ctx, "resource-syslog: read starts")
+
+	// Read Terraform prior state data into the model
+	var state models.SyslogResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	api, err := ConnectTargetSystem(r.p, &state.RedfishServer)
+	if err != nil {
+		resp.Diagnostics.AddError("service error: ", err.Error())
+		return
+	}
+
+	defer api.Logout()
+
+	endpoint, err := getAlertingEndpoint(ctx, api, SYSLOG_ALERTING_OEM_PATH)
+	if err != nil {
+		resp.Diagnostics.AddError("Manager Resource Detection Failed", err.Error())
+		return
+	}
+
+	// Just imported resource does not have servers in state yet, so they are read as well
+	if err = readSyslogSettingsToModel(api, &state, endpoint, state.Id.IsNull()); err != nil {
+		resp.Diagnostics.AddError("Error while reading syslog settings", err.Error())
+		return
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+
+	tflog.Info(ctx, "resource-syslog: read ends")
+}
+
+func (r *SyslogResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Info(ctx, "resource-syslog: update starts")
+
+	// Read Terraform plan data into the model
+	var plan models.SyslogResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+
+	tflog.Info(ctx, "resource-syslog: update ends")
+}
+
+func (r *SyslogResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "resource-syslog: delete starts")
+	resp.State.RemoveResource(ctx)
+	tflog.Info(ctx, "resource-syslog: delete ends")
+}
+
+func (r *SyslogResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	tflog.Info(ctx, "resource-syslog: import starts")
+
+	var config CommonImportConfig
+	err := json.Unmarshal([]byte(req.ID), &config)
+	if err != nil {
+		resp.Diagnostics.AddError("Error while unmarshalling import config", err.Error())
+		return
+	}
+
+	creds := importServerBlock(config.ServerConfig)
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, tkpath.Root("server"), creds)...)
+
+	tflog.Info(ctx, "resource-syslog: import ends")
+}
+
+// apply configures syslog forwarding requested by plan and reads settings back into plan.
+func (r *SyslogResource) apply(ctx context.Context, plan *models.SyslogResourceModel) (diags diag.Diagnostics) {
+	// Provide synchronization
+	var endpoint = GetServerEndpoint(r.p, plan.RedfishServer)
+	var resource_name = "resource-syslog"
+	mutexPool.Lock(ctx, endpoint, resource_name)
+	defer mutexPool.Unlock(ctx, endpoint, resource_name)
+
+	// Connect to service
+	api, err := ConnectTargetSystem(r.p, &plan.RedfishServer)
+	if err != nil {
+		diags.AddError("service error: ", err.Error())
+		return diags
+	}
+
+	defer api.Logout()
+
+	syslogEndpoint, err := getAlertingEndpoint(ctx, api, SYSLOG_ALERTING_OEM_PATH)
+	if err != nil {
+		diags.AddError("Manager Resource Detection Failed", err.Error())
+		return diags
+	}
+
+	if err = applySyslogSettings(ctx, api, plan, syslogEndpoint); err != nil {
+		diags.AddError("Error while applying syslog settings", err.Error())
+		return diags
+	}
+
+	if err = readSyslogSettingsToModel(api, plan, syslogEndpoint, false); err != nil {
+		diags.AddError("Error while reading syslog settings", err.Error())
+	}
+
+	return diags
+}
+
+type syslogServerSettings struct {
+	Address         string `json:"Address"`
+	Port            int64  `json:"Port"`
+	Protocol        string `json:"Protocol"`
+	MinimumSeverity string `json:"MinimumSeverity"`
+}
+
+type syslogSettings struct {
+	Enabled bool                   `json:"Enabled"`
+	Servers []syslogServerSettings `json:"Servers"`
+}
+
+// applySyslogSettings sends to iRMC only these settings from plan, which are known and differ from current ones.
+func applySyslogSettings(ctx context.Context, api *gofish.APIClient, plan *models.SyslogResourceModel, endpoint string) error {
+	var current syslogSettings
+	etag, err := getRedfishResource(api, endpoint, &current)
+	if err != nil {
+		return err
+	}
+
+	settings := map[string]interface{}{}
+	if plan.Servers != nil {
+		servers := []syslogServerSettings{}
+		for _, server := range plan.Servers {
+			servers = append(servers, syslogServerSettings{
+				Address:         server.Address.ValueString(),
+				Port:            server.Port.ValueInt64(),
+				Protocol:        server.Protocol.ValueString(),
+				MinimumSeverity: server.MinimumSeverity.ValueString(),
+			})
+		}
+
+		if !slices.Equal(servers, current.Servers) {
+			settings["Servers"] = servers
+		}
+	}
+
+	if !plan.Enabled.IsNull() && !plan.Enabled.IsUnknown() && plan.Enabled.ValueBool() != current.Enabled {
+		settings["Enabled"] = plan.Enabled.ValueBool()
+	}
+
+	if len(settings) > 0 {
+		tflog.Info(ctx, "Changing syslog forwarding settings", settings)
+		if err = patchRedfishResource(api, endpoint, etag, settings); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// readSyslogSettingsToModel reads current syslog forwarding settings of iRMC into model, so changes done
+// outside of Terraform are detected. Servers are read only if they are managed (not null in model), unless all is set.
+func readSyslogSettingsToModel(api *gofish.APIClient, model *models.SyslogResourceModel, endpoint string, all bool) error {
+	var settings syslogSettings
+	if _, err := getRedfishResource(api, endpoint, &settings); err != nil {
+		return err
+	}
+
+	model.Id = types.StringValue(endpoint)
+	model.Enabled = types.BoolValue(settings.Enabled)
+
+	if model.Servers != nil || all {
+		servers := []models.SyslogServer{}
+		for _, server := range settings.Servers {
+			servers = append(servers, models.SyslogServer{
+				Address:         types.StringValue(server.Address),
+				Port:            types.Int64Value(server.Port),
+				Protocol:        types.StringValue(server.Protocol),
+				MinimumSeverity: types.StringValue(server.MinimumSeverity),
+			})
+		}
+		model.Servers = servers
+	}
+
+	return nil
+}
+
+// getAlertingEndpoint returns path of OEM alerting settings resource (e.g. Syslog or Email) of managed iRMC.
+func getAlertingEndpoint(ctx context.Context, api *gofish.APIClient, path string) (string, error) {
+	vendor, err := GetOemVendor(ctx, api)
+	if err != nil {
+		return "", err
+	}
+
+	manager, err := GetManagerEndpoint(api.Service)
+	if err != nil {
+		return "", err
+	}
+
+	return vendor.OemPath(manager, path), nil
+}
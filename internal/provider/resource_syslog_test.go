@@ -0,0 +1,196 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+const resource_syslog_name = "irmc-redfish_syslog.syslog"
+
+func TestAccRedfishSyslog_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRedfishResourceSyslogConfig(creds, "Minor"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resource_syslog_name, "enabled", "true"),
+					resource.TestCheckResourceAttr(resource_syslog_name, "servers.#", "1"),
+					resource.TestCheckResourceAttr(resource_syslog_name, "servers.0.port", "514"),
+					resource.TestCheckResourceAttr(resource_syslog_name, "servers.0.protocol", "UDP"),
+				),
+			},
+			{
+				Config: testAccRedfishResourceSyslogConfig(creds, "Critical"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resource_syslog_name, "servers.0.minimum_severity", "Critical"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRedfishSyslog_import(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:       `resource "irmc-redfish_syslog" "syslog" {}`,
+				ResourceName: resource_syslog_name,
+				ImportState:  true,
+				ImportStateIdFunc: func(d *terraform.State) (string, error) {
+					return fmt.Sprintf("{\"username\":\"%s\", \"password\":\"%s\", \"endpoint\":\"https://%s\", \"ssl_insecure\":true}",
+						creds.Username, creds.Password, creds.Endpoint), nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccRedfishSyslog_negative(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRedfishResourceSyslogConfig(creds, "Debug"),
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+			},
+		},
+	})
+}
+
+func testAccRedfishResourceSyslogConfig(testingInfo TestingServerCredentials, severity string) string {
+	return fmt.Sprintf(`
+	resource "irmc-redfish_syslog" "syslog" {
+
+		server {
+		  username     = "%s"
+		  password     = "%s"
+		  endpoint     = "https://%s"
+		  ssl_insecure = true
+		}
+
+		enabled = true
+		servers = [{
+		  address          = "192.0.2.40"
+		  minimum_severity = "%s"
+		}]
+	  }
+	`,
+		testingInfo.Username,
+		testingInfo.Password,
+		testingInfo.Endpoint,
+		severity,
+	)
+}
+
+func TestApplySyslogSettings(t *testing.T) {
+	forEachMockFlavor(t, func(t *testing.T, m *mockRedfishServer) {
+		api := m.connect()
+		ctx := context.Background()
+		endpoint, err := getAlertingEndpoint(ctx, api, SYSLOG_ALERTING_OEM_PATH)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+
+		plan := models.SyslogResourceModel{
+			Enabled: types.BoolValue(true),
+			Servers: []models.SyslogServer{{
+				Address:         types.StringValue("192.0.2.40"),
+				Port:            types.Int64Value(SYSLOG_DEFAULT_PORT),
+				Protocol:        types.StringValue("TCP"),
+				MinimumSeverity: types.StringValue("Major"),
+			}},
+		}
+
+		if err = applySyslogSettings(ctx, api, &plan, endpoint); err != nil {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+
+		settings := m.get(endpoint)
+		servers, _ := settings["Servers"].([]interface{})
+		if settings["Enabled"] != true || len(servers) != 1 || servers[0].(map[string]interface{})["Protocol"] != "TCP" {
+			t.Errorf("Unexpected syslog settings %v", settings)
+		}
+
+		// Settings equal to current ones must not be sent again
+		patches := len(m.requestsTo(http.MethodPatch, endpoint))
+		if err = applySyslogSettings(ctx, api, &plan, endpoint); err != nil {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+		if count := len(m.requestsTo(http.MethodPatch, endpoint)); count != patches {
+			t.Errorf("Got %d PATCH requests for unchanged settings, expected none", count-patches)
+		}
+	})
+}
+
+func TestReadSyslogSettingsToModel(t *testing.T) {
+	m := newMockRedfishServer(t, FSAS)
+	api := m.connect()
+	endpoint, _ := getAlertingEndpoint(context.Background(), api, SYSLOG_ALERTING_OEM_PATH)
+
+	// Change done outside of Terraform must be visible in state
+	m.update(endpoint, map[string]interface{}{
+		"Enabled": true,
+		"Servers": []interface{}{map[string]interface{}{
+			"Address": "192.0.2.41", "Port": 1514, "Protocol": "UDP", "MinimumSeverity": "Informational",
+		}},
+	})
+
+	model := models.SyslogResourceModel{Servers: []models.SyslogServer{}}
+	if err := readSyslogSettingsToModel(api, &model, endpoint, false); err != nil {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+
+	if !model.Enabled.ValueBool() || model.Id.ValueString() != endpoint {
+		t.Errorf("Unexpected model %v", model)
+	}
+
+	if len(model.Servers) != 1 || model.Servers[0].Address.ValueString() != "192.0.2.41" || model.Servers[0].Port.ValueInt64() != 1514 {
+		t.Errorf("Unexpected syslog servers %v", model.Servers)
+	}
+
+	// Servers not managed by Terraform stay unmanaged
+	model = models.SyslogResourceModel{}
+	if err := readSyslogSettingsToModel(api, &model, endpoint, false); err != nil {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+	if model.Servers != nil {
+		t.Errorf("Unmanaged servers must stay null, got %v", model.Servers)
+	}
+
+	// Servers are read after import
+	model = models.SyslogResourceModel{}
+	if err := readSyslogSettingsToModel(api, &model, endpoint, true); err != nil {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+	if len(model.Servers) != 1 || model.Servers[0].Address.ValueString() != "192.0.2.41" {
+		t.Errorf("Unexpected syslog servers after import %v", model.Servers)
+	}
+}
@@ -60,11 +60,8 @@ const (
 )
 
 type userAccountImportConfig struct {
-	Username    string `json:"username"`
-	Password    string `json:"password"`
-	Endpoint    string `json:"endpoint"`
-	SslInsecure bool   `json:"ssl_insecure"`
-	UserID      string `json:"user_id"`
+	ServerConfig
+	UserID string `json:"user_id"`
 }
 
 const USER_ACCOUNT_ENDPOINT = "/redfish/v1/AccountService/Accounts"
@@ -118,8 +115,8 @@ func (r *IrmcUserAccountResource) Schema(ctx context.Context, req resource.Schem
 				},
 			},
 			"user_password": schema.StringAttribute{
-				MarkdownDescription: "Password of the user.",
-				Description:         "Password of the user.",
+				MarkdownDescription: "Password of the user. Password is checked against password policy currently configured on iRMC (see irmc-redfish_account_policy).",
+				Description:         "Password of the user. Password is checked against password policy currently configured on iRMC (see irmc-redfish_account_policy).",
 				Optional:            true,
 				Computed:            true,
 				Sensitive:           true,
@@ -265,7 +262,7 @@ func (r *IrmcUserAccountResource) Create(ctx context.Context, req resource.Creat
 	}
 
 	// Provide synchronization
-	var endpoint = plan.RedfishServer[0].Endpoint.ValueString()
+	var endpoint = GetServerEndpoint(r.p, plan.RedfishServer)
 	var resource_name = "resource-user-account"
 	mutexPool.Lock(ctx, endpoint, resource_name)
 	defer mutexPool.Unlock(ctx, endpoint, resource_name)
@@ -282,15 +279,21 @@ func (r *IrmcUserAccountResource) Create(ctx context.Context, req resource.Creat
 
 	defer config.Logout()
 
-	isFsas, err := IsFsasCheck(ctx, config)
+	vendor, err := GetOemVendor(ctx, config)
 	if err != nil {
 		resp.Diagnostics.AddError("Vendor Detection Failed", err.Error())
 		return
 	}
 	plan.Id = types.StringValue(USER_ACCOUNT_ENDPOINT)
 
-	// Chec Password validation
-	err = CheckPasswordValidation(userPassword)
+	// Check password against policy configured on iRMC
+	policy, err := GetPasswordPolicy(config)
+	if err != nil {
+		resp.Diagnostics.AddError("error.", err.Error())
+		return
+	}
+
+	err = CheckPasswordValidation(userPassword, policy)
 	if err != nil {
 		resp.Diagnostics.AddError("error.", err.Error())
 		return
@@ -315,7 +318,7 @@ func (r *IrmcUserAccountResource) Create(ctx context.Context, req resource.Creat
 		resp.Diagnostics.AddError("error.", err.Error())
 		return
 	}
-	createPayload, err := InitializeUserAccountRedfishRequest(plan, Create, isFsas)
+	createPayload, err := InitializeUserAccountRedfishRequest(plan, Create, vendor)
 	if err != nil {
 		resp.Diagnostics.AddError("error.", err.Error())
 		return
@@ -371,7 +374,7 @@ func (r *IrmcUserAccountResource) Read(ctx context.Context, req resource.ReadReq
 	}
 	defer config.Logout()
 
-	isFsas, err := IsFsasCheck(ctx, config)
+	vendor, err := GetOemVendor(ctx, config)
 	if err != nil {
 		resp.Diagnostics.AddError("Vendor Detection Failed", err.Error())
 		return
@@ -413,53 +416,44 @@ func (r *IrmcUserAccountResource) Read(ctx context.Context, req resource.ReadReq
 		state.UserPassword = types.StringNull()
 	}
 
-	var oemKey string
-	if isFsas {
-		oemKey = FSAS
-	} else {
-		oemKey = TS_FUJITSU
-	}
-
-	if oem, oemOK := data["Oem"].(map[string]interface{}); oemOK {
-		if oemData, oemDataOK := oem[oemKey].(map[string]interface{}); oemDataOK {
-			if baseValues, ok := oemData["BaseValues"].(map[string]interface{}); ok {
-				if val, ok := baseValues["Shell"].(string); ok {
-					state.UserShellAccess = types.StringValue(val)
+	if oemData, oemDataOK := vendor.Properties(data["Oem"]); oemDataOK {
+		if baseValues, ok := oemData["BaseValues"].(map[string]interface{}); ok {
+			if val, ok := baseValues["Shell"].(string); ok {
+				state.UserShellAccess = types.StringValue(val)
+			}
+			if val, ok := baseValues["Enabled"].(bool); ok {
+				state.UserRedfishEnabled = types.BoolValue(val)
+			}
+		}
+		if permissions, ok := oemData["Permissions"].(map[string]interface{}); ok {
+			if standard, ok := permissions["Standard"].(map[string]interface{}); ok {
+				if val, ok := standard["Lan"].(string); ok {
+					state.UserLanChannelRole = types.StringValue(val)
 				}
-				if val, ok := baseValues["Enabled"].(bool); ok {
-					state.UserRedfishEnabled = types.BoolValue(val)
+				if val, ok := standard["Serial"].(string); ok {
+					state.UserSerialChannelRole = types.StringValue(val)
 				}
 			}
-			if permissions, ok := oemData["Permissions"].(map[string]interface{}); ok {
-				if standard, ok := permissions["Standard"].(map[string]interface{}); ok {
-					if val, ok := standard["Lan"].(string); ok {
-						state.UserLanChannelRole = types.StringValue(val)
-					}
-					if val, ok := standard["Serial"].(string); ok {
-						state.UserSerialChannelRole = types.StringValue(val)
-					}
+			if extended, ok := permissions["Extended"].(map[string]interface{}); ok {
+				if val, ok := extended["ConfigureUsers"].(bool); ok {
+					state.UserEnabledAccountConfig = types.BoolValue(val)
 				}
-				if extended, ok := permissions["Extended"].(map[string]interface{}); ok {
-					if val, ok := extended["ConfigureUsers"].(bool); ok {
-						state.UserEnabledAccountConfig = types.BoolValue(val)
-					}
-					if val, ok := extended["ConfigureIrmc"].(bool); ok {
-						state.UserEnabledIRMCSettingsConfig = types.BoolValue(val)
-					}
-					if val, ok := extended["UseVideoRedirection"].(bool); ok {
-						state.UserEnabledVideoRedirection = types.BoolValue(val)
-					}
-					if val, ok := extended["UseRemoteStorage"].(bool); ok {
-						state.UserEnabledRemoteStorage = types.BoolValue(val)
-					}
+				if val, ok := extended["ConfigureIrmc"].(bool); ok {
+					state.UserEnabledIRMCSettingsConfig = types.BoolValue(val)
 				}
-			}
-			if email, ok := oemData["Email"].(map[string]interface{}); ok {
-				if val, ok := email["AlertChassisEventsUser"].(bool); ok {
-					state.UserEnabledAlertChassisEvents = types.BoolValue(val)
+				if val, ok := extended["UseVideoRedirection"].(bool); ok {
+					state.UserEnabledVideoRedirection = types.BoolValue(val)
+				}
+				if val, ok := extended["UseRemoteStorage"].(bool); ok {
+					state.UserEnabledRemoteStorage = types.BoolValue(val)
 				}
 			}
 		}
+		if email, ok := oemData["Email"].(map[string]interface{}); ok {
+			if val, ok := email["AlertChassisEventsUser"].(bool); ok {
+				state.UserEnabledAlertChassisEvents = types.BoolValue(val)
+			}
+		}
 	}
 
 	diags = resp.State.Set(ctx, &state)
@@ -493,7 +487,7 @@ func (r *IrmcUserAccountResource) Update(ctx context.Context, req resource.Updat
 	}
 	defer config.Logout()
 
-	isFsas, err := IsFsasCheck(ctx, config)
+	vendor, err := GetOemVendor(ctx, config)
 	if err != nil {
 		resp.Diagnostics.AddError("Vendor Detection Failed", err.Error())
 		return
@@ -507,14 +501,20 @@ func (r *IrmcUserAccountResource) Update(ctx context.Context, req resource.Updat
 
 	userPassword := plan.UserPassword.ValueString()
 	if userPassword != "" {
-		err = CheckPasswordValidation(userPassword)
+		policy, err := GetPasswordPolicy(config)
+		if err != nil {
+			resp.Diagnostics.AddError("Password validation failed", err.Error())
+			return
+		}
+
+		err = CheckPasswordValidation(userPassword, policy)
 		if err != nil {
 			resp.Diagnostics.AddError("Password validation failed", err.Error())
 			return
 		}
 	}
 
-	updatePayload, err := InitializeUserAccountRedfishRequest(plan, Update, isFsas)
+	updatePayload, err := InitializeUserAccountRedfishRequest(plan, Update, vendor)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to initialize update payload", err.Error())
 		return
@@ -587,53 +587,44 @@ func (r *IrmcUserAccountResource) Update(ctx context.Context, req resource.Updat
 		plan.UserPassword = types.StringNull()
 	}
 
-	var oemKey string
-	if isFsas {
-		oemKey = FSAS
-	} else {
-		oemKey = TS_FUJITSU
-	}
-
-	if oem, oemOK := data["Oem"].(map[string]interface{}); oemOK {
-		if oemData, oemDataOK := oem[oemKey].(map[string]interface{}); oemDataOK {
-			if baseValues, ok := oemData["BaseValues"].(map[string]interface{}); ok {
-				if val, ok := baseValues["Shell"].(string); ok {
-					plan.UserShellAccess = types.StringValue(val)
+	if oemData, oemDataOK := vendor.Properties(data["Oem"]); oemDataOK {
+		if baseValues, ok := oemData["BaseValues"].(map[string]interface{}); ok {
+			if val, ok := baseValues["Shell"].(string); ok {
+				plan.UserShellAccess = types.StringValue(val)
+			}
+			if val, ok := baseValues["Enabled"].(bool); ok {
+				plan.UserRedfishEnabled = types.BoolValue(val)
+			}
+		}
+		if permissions, ok := oemData["Permissions"].(map[string]interface{}); ok {
+			if standard, ok := permissions["Standard"].(map[string]interface{}); ok {
+				if val, ok := standard["Lan"].(string); ok {
+					plan.UserLanChannelRole = types.StringValue(val)
 				}
-				if val, ok := baseValues["Enabled"].(bool); ok {
-					plan.UserRedfishEnabled = types.BoolValue(val)
+				if val, ok := standard["Serial"].(string); ok {
+					plan.UserSerialChannelRole = types.StringValue(val)
 				}
 			}
-			if permissions, ok := oemData["Permissions"].(map[string]interface{}); ok {
-				if standard, ok := permissions["Standard"].(map[string]interface{}); ok {
-					if val, ok := standard["Lan"].(string); ok {
-						plan.UserLanChannelRole = types.StringValue(val)
-					}
-					if val, ok := standard["Serial"].(string); ok {
-						plan.UserSerialChannelRole = types.StringValue(val)
-					}
+			if extended, ok := permissions["Extended"].(map[string]interface{}); ok {
+				if val, ok := extended["ConfigureUsers"].(bool); ok {
+					plan.UserEnabledAccountConfig = types.BoolValue(val)
 				}
-				if extended, ok := permissions["Extended"].(map[string]interface{}); ok {
-					if val, ok := extended["ConfigureUsers"].(bool); ok {
-						plan.UserEnabledAccountConfig = types.BoolValue(val)
-					}
-					if val, ok := extended["ConfigureIrmc"].(bool); ok {
-						plan.UserEnabledIRMCSettingsConfig = types.BoolValue(val)
-					}
-					if val, ok := extended["UseVideoRedirection"].(bool); ok {
-						plan.UserEnabledVideoRedirection = types.BoolValue(val)
-					}
-					if val, ok := extended["UseRemoteStorage"].(bool); ok {
-						plan.UserEnabledRemoteStorage = types.BoolValue(val)
-					}
+				if val, ok := extended["ConfigureIrmc"].(bool); ok {
+					plan.UserEnabledIRMCSettingsConfig = types.BoolValue(val)
 				}
-			}
-			if email, ok := oemData["Email"].(map[string]interface{}); ok {
-				if val, ok := email["AlertChassisEventsUser"].(bool); ok {
-					plan.UserEnabledAlertChassisEvents = types.BoolValue(val)
+				if val, ok := extended["UseVideoRedirection"].(bool); ok {
+					plan.UserEnabledVideoRedirection = types.BoolValue(val)
+				}
+				if val, ok := extended["UseRemoteStorage"].(bool); ok {
+					plan.UserEnabledRemoteStorage = types.BoolValue(val)
 				}
 			}
 		}
+		if email, ok := oemData["Email"].(map[string]interface{}); ok {
+			if val, ok := email["AlertChassisEventsUser"].(bool); ok {
+				plan.UserEnabledAlertChassisEvents = types.BoolValue(val)
+			}
+		}
 	}
 	plan.UserID = state.UserID
 	plan.Id = types.StringValue(fmt.Sprintf("%s/%s", USER_ACCOUNT_ENDPOINT, userID))
@@ -700,15 +691,10 @@ func (r *IrmcUserAccountResource) ImportState(ctx context.Context, req resource.
 		resp.Diagnostics.AddError("Error while unmarshalling id", err.Error())
 	}
 
-	server := models.RedfishServer{
-		User:        types.StringValue(config.Username),
-		Password:    types.StringValue(config.Password),
-		Endpoint:    types.StringValue(config.Endpoint),
-		SslInsecure: types.BoolValue(config.SslInsecure),
-	}
+	creds := importServerBlock(config.ServerConfig)
 
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), config.UserID)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("server"), []models.RedfishServer{server})...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("server"), creds)...)
 
 	tflog.Info(ctx, "resource-user_account: import ends")
 }
@@ -723,9 +709,50 @@ func CheckIsUsernameTaken(accounts []*redfish.ManagerAccount, username string) e
 	return nil
 }
 
-func CheckPasswordValidation(password string) error {
-	if len(password) < minPasswordLength || len(password) > maxPasswordLength {
-		return fmt.Errorf("password for user must be at least 12 characters long")
+// PasswordPolicy describes rules which passwords of iRMC users have to fulfill.
+type PasswordPolicy struct {
+	MinLength         int
+	MaxLength         int
+	ComplexityEnabled bool
+}
+
+// defaultPasswordPolicy is used for rules which are not reported by iRMC.
+var defaultPasswordPolicy = PasswordPolicy{
+	MinLength:         minPasswordLength,
+	MaxLength:         maxPasswordLength,
+	ComplexityEnabled: true,
+}
+
+// GetPasswordPolicy reads password policy currently configured on iRMC, so passwords are checked
+// against the same rules as iRMC would apply.
+func GetPasswordPolicy(api *gofish.APIClient) (PasswordPolicy, error) {
+	policy := defaultPasswordPolicy
+
+	var settings accountPolicySettings
+	if _, err := getRedfishResource(api, ACCOUNT_SERVICE_ENDPOINT, &settings); err != nil {
+		return policy, fmt.Errorf("failed to retrieve password policy: %w", err)
+	}
+
+	if settings.MinPasswordLength != nil {
+		policy.MinLength = int(*settings.MinPasswordLength)
+	}
+	if settings.MaxPasswordLength != nil {
+		policy.MaxLength = int(*settings.MaxPasswordLength)
+	}
+	if oem := settings.Oem.Get(); oem != nil && oem.PasswordComplexityEnabled != nil {
+		policy.ComplexityEnabled = *oem.PasswordComplexityEnabled
+	}
+
+	return policy, nil
+}
+
+func CheckPasswordValidation(password string, policy PasswordPolicy) error {
+	if len(password) < policy.MinLength || len(password) > policy.MaxLength {
+		return fmt.Errorf("password for user must be between %d and %d characters long", policy.MinLength, policy.MaxLength)
+	}
+
+	if !policy.ComplexityEnabled {
+		return nil
 	}
 
 	hasLower := false
@@ -793,14 +820,7 @@ func CheckUserIDExistence(accounts []*redfish.ManagerAccount, userID string) err
 	return nil
 }
 
-func InitializeUserAccountRedfishRequest(plan models.IrmcUserAccountResourceModel, redfishMethod RedfishMethod, isFsas bool) (map[string]interface{}, error) {
-	var oemKey string
-	if isFsas {
-		oemKey = FSAS
-	} else {
-		oemKey = TS_FUJITSU
-	}
-
+func InitializeUserAccountRedfishRequest(plan models.IrmcUserAccountResourceModel, redfishMethod RedfishMethod, vendor *OemVendor) (map[string]interface{}, error) {
 	oemPayload := map[string]interface{}{
 		"BaseValues": map[string]interface{}{
 			"Enabled": plan.UserRedfishEnabled.ValueBool(),
@@ -830,7 +850,7 @@ func InitializeUserAccountRedfishRequest(plan models.IrmcUserAccountResourceMode
 			"Password": plan.UserPassword.ValueString(),
 			"RoleId":   plan.UserRole.ValueString(),
 			"Enabled":  plan.UserEnabled.ValueBool(),
-			"Oem":      map[string]interface{}{oemKey: oemPayload},
+			"Oem":      vendor.Oem(oemPayload),
 		}
 		return redfishRequest, nil
 
@@ -839,7 +859,7 @@ func InitializeUserAccountRedfishRequest(plan models.IrmcUserAccountResourceMode
 			"UserName": plan.UserUsername.ValueString(),
 			"Enabled":  plan.UserEnabled.ValueBool(),
 			"RoleId":   plan.UserRole.ValueString(),
-			"Oem":      map[string]interface{}{oemKey: oemPayload},
+			"Oem":      vendor.Oem(oemPayload),
 		}
 		if !plan.UserPassword.IsNull() && !plan.UserPassword.IsUnknown() && plan.UserPassword.ValueString() != "" {
 			redfishRequest["Password"] = plan.UserPassword.ValueString()
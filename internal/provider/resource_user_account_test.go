@@ -22,8 +22,10 @@ import (
 	"fmt"
 	"regexp"
 	"strconv"
+	"terraform-provider-irmc-redfish/internal/models"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/stmcginnis/gofish"
@@ -292,3 +294,139 @@ func testAccRedfishResourceUserAccountConfig(
 		alertChassisEventsEnabled,
 	)
 }
+
+func TestUserAccountHelpers(t *testing.T) {
+	forEachMockFlavor(t, func(t *testing.T, m *mockRedfishServer) {
+		api := m.connect()
+
+		accounts, err := GetListOfUserAccounts(api.Service)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+
+		if err := CheckIsUsernameTaken(accounts, "admin"); err == nil {
+			t.Errorf("Expected error for already taken username")
+		}
+
+		if err := CheckUserIDExistence(accounts, "2"); err == nil {
+			t.Errorf("Expected error for already taken user ID")
+		}
+
+		if err := CheckUserIDExistence(accounts, "3"); err != nil {
+			t.Errorf("Unexpected error: %s", err.Error())
+		}
+
+		plan := models.IrmcUserAccountResourceModel{
+			UserUsername:          types.StringValue("operator"),
+			UserPassword:          types.StringValue("Operator_123!"),
+			UserRole:              types.StringValue("Operator"),
+			UserEnabled:           types.BoolValue(true),
+			UserRedfishEnabled:    types.BoolValue(true),
+			UserLanChannelRole:    types.StringValue("Operator"),
+			UserSerialChannelRole: types.StringValue("Operator"),
+			UserShellAccess:       types.StringValue("None"),
+		}
+
+		payload, err := InitializeUserAccountRedfishRequest(plan, Create, m.vendor())
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+
+		res, err := api.Post(USER_ACCOUNT_ENDPOINT, payload)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+		CloseResource(res.Body)
+
+		accounts, err = GetListOfUserAccounts(api.Service)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+
+		id, err := FindUserIDByName(accounts, "operator")
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+
+		oem := m.oem(fmt.Sprintf("%s/%s", USER_ACCOUNT_ENDPOINT, id))
+		if oem == nil || oem["BaseValues"].(map[string]interface{})["Shell"] != "None" {
+			t.Errorf("OEM settings have not been stored under '%s' key", m.oemKey)
+		}
+
+		payload, err = InitializeUserAccountRedfishRequest(plan, Update, m.vendor())
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+
+		if _, ok := payload["Password"]; !ok {
+			t.Errorf("Password is expected in update payload when planned")
+		}
+
+		if _, err := FindUserIDByName(accounts, "unknown"); err == nil {
+			t.Errorf("Expected error for not existing user")
+		}
+	})
+}
+
+func TestCheckPasswordValidation(t *testing.T) {
+	cases := map[string]bool{
+		"Short1!":                 false,
+		"TooLongPassword_1234567": false,
+		"onlylowercase":           false,
+		"lowerUPPER1234":          true,
+		"lower_special_123":       true,
+	}
+
+	for password, valid := range cases {
+		err := CheckPasswordValidation(password, defaultPasswordPolicy)
+		if valid && err != nil {
+			t.Errorf("Password '%s' reported as invalid: %s", password, err.Error())
+		}
+		if !valid && err == nil {
+			t.Errorf("Password '%s' reported as valid", password)
+		}
+	}
+
+	// Relaxed policy configured on iRMC must be respected
+	policy := PasswordPolicy{MinLength: 8, MaxLength: 32, ComplexityEnabled: false}
+	for _, password := range []string{"onlylowercase", "TooLongPassword_1234567"} {
+		if err := CheckPasswordValidation(password, policy); err != nil {
+			t.Errorf("Password '%s' reported as invalid: %s", password, err.Error())
+		}
+	}
+	if err := CheckPasswordValidation("Short1!", policy); err == nil {
+		t.Errorf("Password shorter than policy minimum reported as valid")
+	}
+}
+
+func TestGetPasswordPolicy(t *testing.T) {
+	forEachMockFlavor(t, func(t *testing.T, m *mockRedfishServer) {
+		api := m.connect()
+
+		policy, err := GetPasswordPolicy(api)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+		if policy != defaultPasswordPolicy {
+			t.Errorf("Unexpected password policy %v", policy)
+		}
+
+		m.update(ACCOUNT_SERVICE_ENDPOINT, map[string]interface{}{
+			"MinPasswordLength": 8,
+			"MaxPasswordLength": 32,
+			"Oem":               map[string]interface{}{m.oemKey: map[string]interface{}{"PasswordComplexityEnabled": false}},
+		})
+		if policy, err = GetPasswordPolicy(api); err != nil {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+		if policy.MinLength != 8 || policy.MaxLength != 32 || policy.ComplexityEnabled {
+			t.Errorf("Live password policy not respected, got %v", policy)
+		}
+
+		// Rules not reported by iRMC fall back to defaults
+		m.update(ACCOUNT_SERVICE_ENDPOINT, map[string]interface{}{"MinPasswordLength": nil, "Oem": nil})
+		if policy, _ = GetPasswordPolicy(api); policy.MinLength != minPasswordLength || !policy.ComplexityEnabled {
+			t.Errorf("Expected default rules, got %v", policy)
+		}
+	})
+}
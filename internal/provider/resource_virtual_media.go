@@ -46,8 +46,6 @@ const (
 	IMAGE_TYPE_IMG
 )
 
-const VMEDIA_ENDPOINT = "/redfish/v1/Managers/iRMC/VirtualMedia/"
-
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &VirtualMediaResource{}
 var _ resource.ResourceWithImportState = &VirtualMediaResource{}
@@ -134,7 +132,7 @@ func (r *VirtualMediaResource) Create(ctx context.Context, req resource.CreateRe
 	}
 
 	// Provide synchronization
-	var endpoint = plan.RedfishServer[0].Endpoint.ValueString()
+	var endpoint = GetServerEndpoint(r.p, plan.RedfishServer)
 	var resource_name = "resource-virtual_media"
 	mutexPool.Lock(ctx, endpoint, resource_name)
 	defer mutexPool.Unlock(ctx, endpoint, resource_name)
@@ -293,7 +291,10 @@ func (r *VirtualMediaResource) Update(ctx context.Context, req resource.UpdateRe
 			return
 		}
 
-		time.Sleep(2 * time.Second)
+		if err := sleepWithContext(ctx, 2*time.Second); err != nil {
+			resp.Diagnostics.AddError("Waiting for media ejection has been cancelled: ", err.Error())
+			return
+		}
 	}
 
 	// Construct request to insert media
@@ -371,14 +372,7 @@ func (r *VirtualMediaResource) ImportState(ctx context.Context, req resource.Imp
 		return
 	}
 
-	server := models.RedfishServer{
-		User:        types.StringValue(config.Username),
-		Password:    types.StringValue(config.Password),
-		Endpoint:    types.StringValue(config.Endpoint),
-		SslInsecure: types.BoolValue(config.SslInsecure),
-	}
-
-	creds := []models.RedfishServer{server}
+	creds := importServerBlock(config.ServerConfig)
 
 	// Get SUT virtual media environment
 	var env virtualMediaEnvironment
@@ -419,12 +413,9 @@ type virtualMediaEnvironment struct {
 }
 
 func (r *VirtualMediaResource) updateVirtualMediaState(response *redfish.VirtualMedia, plan models.VirtualMediaResourceModel) models.VirtualMediaResourceModel {
-	var new_id strings.Builder
-	new_id.WriteString(VMEDIA_ENDPOINT)
-	new_id.WriteString(response.ID)
-
+	// Virtual media belongs to selected manager, so its location is used as identifier
 	return models.VirtualMediaResourceModel{
-		Id:                   types.StringValue(new_id.String()),
+		Id:                   types.StringValue(response.ODataID),
 		Image:                types.StringValue(response.Image),
 		Inserted:             types.BoolValue(response.Inserted),
 		TransferProtocolType: types.StringValue(string(response.TransferProtocolType)),
@@ -435,7 +426,7 @@ func (r *VirtualMediaResource) updateVirtualMediaState(response *redfish.Virtual
 func (r *VirtualMediaResource) GetVirtualMediaEnvironment(rserver *[]models.RedfishServer) (virtualMediaEnvironment, diag.Diagnostics) {
 	var env virtualMediaEnvironment
 	var d diag.Diagnostics
-	var manager []*redfish.Manager
+	var manager *redfish.Manager
 
 	api, err := ConnectTargetSystem(r.p, rserver)
 	if err != nil {
@@ -445,13 +436,13 @@ func (r *VirtualMediaResource) GetVirtualMediaEnvironment(rserver *[]models.Redf
 
 	env.client = api
 
-	manager, err = api.Service.Managers()
+	manager, err = GetManagerResource(api.Service)
 	if err != nil {
 		d.AddError("Error when accessing Managers resource", err.Error())
 		return env, d
 	}
 
-	vmediaCollection, err := manager[0].VirtualMedia()
+	vmediaCollection, err := manager.VirtualMedia()
 	if err != nil {
 		d.AddError("Could not retrieve vmedia collection from redfish API", err.Error())
 		return env, d
@@ -476,7 +467,7 @@ func GetVirtualMedia(vmediaID string, vms []*redfish.VirtualMedia) (*redfish.Vir
 
 // WaitForMediaSuccessfullyMounted checks requested endpoint of given service
 // until the endpoint will returned Inserted as true or counter will reach limit.
-func WaitForMediaSuccessfullyMounted(service *gofish.Service, endpoint string) (*redfish.VirtualMedia, error) {
+func WaitForMediaSuccessfullyMounted(ctx context.Context, service *gofish.Service, endpoint string) (*redfish.VirtualMedia, error) {
 	cnt := 20 // number of tries every second
 	virtualMedia, err := redfish.GetVirtualMedia(service.GetClient(), endpoint)
 	for cnt > 0 {
@@ -488,7 +479,9 @@ func WaitForMediaSuccessfullyMounted(service *gofish.Service, endpoint string) (
 			break
 		}
 
-		time.Sleep(1 * time.Second)
+		if err := sleepWithContext(ctx, time.Second); err != nil {
+			return nil, fmt.Errorf("waiting for media %s to be mounted has been cancelled: %w", endpoint, err)
+		}
 		cnt--
 
 		virtualMedia, err = redfish.GetVirtualMedia(service.GetClient(), endpoint)
@@ -512,7 +505,7 @@ func InsertMedia(ctx context.Context, id string, collection []*redfish.VirtualMe
 		return nil, fmt.Errorf("could not mount vmedia %s: %w", id, err)
 	}
 
-	virtualMedia, err = WaitForMediaSuccessfullyMounted(service, virtualMedia.ODataID)
+	virtualMedia, err = WaitForMediaSuccessfullyMounted(ctx, service, virtualMedia.ODataID)
 	if err != nil {
 		return nil, fmt.Errorf("reading status of selected virtual media finished with error: %w", err)
 	}
@@ -18,6 +18,7 @@ limitations under the License.
 package provider
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"regexp"
@@ -25,6 +26,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stmcginnis/gofish/redfish"
 )
 
 const (
@@ -191,3 +193,43 @@ func testAccRedfishResourceVirtualMediaConfig(testingInfo TestingServerCredentia
 		transfer_protocol_type,
 	)
 }
+
+func TestInsertMedia(t *testing.T) {
+	forEachMockFlavor(t, func(t *testing.T, m *mockRedfishServer) {
+		r := VirtualMediaResource{p: &IrmcProvider{}}
+		rserver := m.redfishServer()
+
+		env, diags := r.GetVirtualMediaEnvironment(&rserver)
+		if diags.HasError() {
+			t.Fatalf("Unexpected error: %v", diags)
+		}
+		defer env.client.Logout()
+
+		if len(env.collection) != 2 {
+			t.Fatalf("Expected 2 virtual media slots, got %d", len(env.collection))
+		}
+
+		config := redfish.VirtualMediaConfig{
+			Image:                "http://10.0.0.1/image.iso",
+			Inserted:             true,
+			TransferProtocolType: redfish.HTTPTransferProtocolType,
+		}
+
+		vmedia, err := InsertMedia(context.Background(), "0", env.collection, config, env.client.Service)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+
+		if !vmedia.Inserted || vmedia.Image != config.Image {
+			t.Errorf("Media has not been inserted: %v", vmedia)
+		}
+
+		if _, err := GetVirtualMedia("5", env.collection); err == nil {
+			t.Errorf("Expected error for not existing virtual media")
+		}
+
+		if _, err := InsertMedia(context.Background(), "5", env.collection, config, env.client.Service); err == nil {
+			t.Errorf("Expected error for not existing virtual media")
+		}
+	})
+}
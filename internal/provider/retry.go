@@ -0,0 +1,210 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"slices"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+const (
+	DEFAULT_RETRY_MAX_ATTEMPTS = 3
+	DEFAULT_RETRY_MIN_BACKOFF  = 1
+	DEFAULT_RETRY_MAX_BACKOFF  = 30
+	RECONNECT_TIMEOUT          = 10 * time.Minute
+)
+
+var defaultRetryOnStatus = []int64{
+	http.StatusTooManyRequests,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// RetryPolicy describes how requests failed because of transient errors are repeated.
+type RetryPolicy struct {
+	MaxAttempts   int64
+	MinBackoff    time.Duration
+	MaxBackoff    time.Duration
+	RetryOnStatus []int64
+}
+
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:   DEFAULT_RETRY_MAX_ATTEMPTS,
+		MinBackoff:    DEFAULT_RETRY_MIN_BACKOFF * time.Second,
+		MaxBackoff:    DEFAULT_RETRY_MAX_BACKOFF * time.Second,
+		RetryOnStatus: defaultRetryOnStatus,
+	}
+}
+
+// retryPolicy returns policy configured for provider or default one if provider
+// has not been configured (e.g.: in unit tests).
+func (p *IrmcProvider) retryPolicy() RetryPolicy {
+	if p == nil || p.Retry.MaxAttempts == 0 {
+		return DefaultRetryPolicy()
+	}
+	return p.Retry
+}
+
+// backoff returns time to wait after given failed attempt. Time doubles with every
+// attempt starting from MinBackoff, but never exceeds MaxBackoff.
+func (rp RetryPolicy) backoff(attempt int) time.Duration {
+	wait := rp.MinBackoff
+	for i := 1; i < attempt && wait < rp.MaxBackoff; i++ {
+		wait *= 2
+	}
+
+	if wait > rp.MaxBackoff {
+		return rp.MaxBackoff
+	}
+	return wait
+}
+
+// shouldRetry checks whether result of request points to transient problem.
+func (rp RetryPolicy) shouldRetry(res *http.Response, err error) bool {
+	if err != nil {
+		return isTransientError(err)
+	}
+	return slices.Contains(rp.RetryOnStatus, int64(res.StatusCode))
+}
+
+// isTransientError checks whether error is caused by connection problem which might
+// disappear after a while (e.g.: connection reset by busy or restarting iRMC).
+func isTransientError(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, syscall.ECONNABORTED) || errors.Is(err, syscall.EPIPE) {
+		return true
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+// retryAfter returns time requested by server in Retry-After header, which
+// might be defined either as number of seconds or as HTTP date.
+func retryAfter(res *http.Response) (time.Duration, bool) {
+	value := res.Header.Get("Retry-After")
+	if len(value) == 0 {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		wait := time.Until(date)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+
+	return 0, false
+}
+
+// retryTransport repeats requests failed because of transient errors according to policy.
+// If timeout is set, it's applied to every attempt separately (except of event stream).
+type retryTransport struct {
+	base    http.RoundTripper
+	policy  RetryPolicy
+	timeout time.Duration
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	for attempt := 1; ; attempt++ {
+		out := req
+		if attempt > 1 {
+			out = req.Clone(ctx)
+			if req.GetBody != nil {
+				body, err := req.GetBody()
+				if err != nil {
+					return nil, err
+				}
+				out.Body = body
+			}
+		}
+
+		res, err := t.roundTrip(out)
+
+		// Request can be repeated only if its body can be read again
+		repeatable := req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+		if int64(attempt) >= t.policy.MaxAttempts || !repeatable || !t.policy.shouldRetry(res, err) {
+			return res, err
+		}
+
+		wait := t.policy.backoff(attempt)
+		if res != nil {
+			if requested, ok := retryAfter(res); ok {
+				wait = min(requested, t.policy.MaxBackoff)
+			}
+			CloseResource(res.Body)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(wait):
+		}
+	}
+}
+
+// roundTrip sends single attempt of request respecting timeout of the transport. Event stream
+// is expected to stay open for whole operation, so timeout is not applied to it.
+func (t *retryTransport) roundTrip(req *http.Request) (*http.Response, error) {
+	if t.timeout <= 0 || req.Header.Get("Accept") == EVENT_STREAM_CONTENT_TYPE {
+		return t.base.RoundTrip(req)
+	}
+
+	ctx, cancel := context.WithTimeout(req.Context(), t.timeout)
+	res, err := t.base.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	res.Body = &cancelOnClose{ReadCloser: res.Body, cancel: cancel}
+	return res, nil
+}
+
+// cancelOnClose releases context of request once its response has been read.
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (c *cancelOnClose) Close() error {
+	err := c.ReadCloser.Close()
+	c.cancel()
+	return err
+}
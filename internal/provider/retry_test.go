@@ -0,0 +1,180 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+func retryTestProvider() *IrmcProvider {
+	return &IrmcProvider{
+		Retry: RetryPolicy{
+			MaxAttempts:   3,
+			MinBackoff:    10 * time.Millisecond,
+			MaxBackoff:    50 * time.Millisecond,
+			RetryOnStatus: defaultRetryOnStatus,
+		},
+	}
+}
+
+func TestRetryTransientErrors(t *testing.T) {
+	forEachMockFlavor(t, func(t *testing.T, m *mockRedfishServer) {
+		p := retryTestProvider()
+		rserver := m.redfishServer()
+		api, err := ConnectTargetSystem(p, &rserver)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+
+		t.Run("Status", func(t *testing.T) {
+			m.failNext(http.MethodGet, "/redfish/v1/Systems/0",
+				mockResponse{Status: http.StatusServiceUnavailable},
+				mockResponse{Status: http.StatusTooManyRequests, Headers: map[string]string{"Retry-After": "120"}})
+
+			start := time.Now()
+			if _, err := GetSystemResource(api.Service); err != nil {
+				t.Fatalf("Unexpected error: %s", err.Error())
+			}
+
+			// Retry-After longer than max_backoff must be limited
+			if elapsed := time.Since(start); elapsed > 10*time.Second {
+				t.Errorf("Request took %s, expected backoff limited by max_backoff", elapsed)
+			}
+		})
+
+		t.Run("Exhausted", func(t *testing.T) {
+			before := len(m.requestsTo(http.MethodGet, mockSystemEndpoint))
+			m.failNext(http.MethodGet, "/redfish/v1/Systems/0",
+				mockResponse{Status: http.StatusServiceUnavailable},
+				mockResponse{Status: http.StatusServiceUnavailable},
+				mockResponse{Status: http.StatusServiceUnavailable})
+
+			if _, err := GetSystemResource(api.Service); err == nil {
+				t.Errorf("Expected error after all attempts failed")
+			}
+
+			if count := len(m.requestsTo(http.MethodGet, mockSystemEndpoint)) - before; count != 3 {
+				t.Errorf("Got %d attempts, expected 3", count)
+			}
+		})
+
+		t.Run("NotRetriedStatus", func(t *testing.T) {
+			before := len(m.requestsTo(http.MethodGet, mockSystemEndpoint))
+			m.failNext(http.MethodGet, "/redfish/v1/Systems/0", mockResponse{Status: http.StatusInternalServerError})
+
+			if _, err := GetSystemResource(api.Service); err == nil {
+				t.Errorf("Expected error for not retried status")
+			}
+
+			if count := len(m.requestsTo(http.MethodGet, mockSystemEndpoint)) - before; count != 1 {
+				t.Errorf("Got %d attempts, expected 1", count)
+			}
+		})
+
+		t.Run("ConnectionDroppedDuringTaskPoll", func(t *testing.T) {
+			location := m.addTask(nil, redfish.RunningTaskState, redfish.CompletedTaskState)
+			m.failNext(http.MethodGet, location, mockResponse{Status: mockDropConnection})
+
+			ok, err := WaitForRedfishTaskEnd(context.Background(), api.Service, location, 30)
+			if err != nil || !ok {
+				t.Errorf("Expected task finished successfully, got %t, %v", ok, err)
+			}
+		})
+
+		t.Run("PatchBodyRepeated", func(t *testing.T) {
+			path := "/redfish/v1/Systems/0/Oem/" + m.oemKey + "/BootConfig"
+			m.failNext(http.MethodPatch, path, mockResponse{Status: http.StatusServiceUnavailable})
+
+			res, err := api.Patch(path, map[string]interface{}{"BootDevice": "Pxe"})
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err.Error())
+			}
+			CloseResource(res.Body)
+
+			if device := m.get(path)["BootDevice"]; device != "Pxe" {
+				t.Errorf("Got BootDevice %v, expected Pxe", device)
+			}
+		})
+	})
+}
+
+func TestRetryBackoff(t *testing.T) {
+	policy := RetryPolicy{MinBackoff: time.Second, MaxBackoff: 10 * time.Second}
+	expected := []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second, 10 * time.Second, 10 * time.Second}
+	for i, want := range expected {
+		if got := policy.backoff(i + 1); got != want {
+			t.Errorf("Attempt %d: got backoff %s, expected %s", i+1, got, want)
+		}
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	res := &http.Response{Header: http.Header{}}
+	if _, ok := retryAfter(res); ok {
+		t.Errorf("Expected no value without Retry-After header")
+	}
+
+	res.Header.Set("Retry-After", "5")
+	if wait, ok := retryAfter(res); !ok || wait != 5*time.Second {
+		t.Errorf("Got %s, expected 5s", wait)
+	}
+
+	res.Header.Set("Retry-After", time.Now().Add(-time.Minute).UTC().Format(http.TimeFormat))
+	if wait, ok := retryAfter(res); !ok || wait != 0 {
+		t.Errorf("Got %s, expected 0 for date in the past", wait)
+	}
+
+	res.Header.Set("Retry-After", "soon")
+	if _, ok := retryAfter(res); ok {
+		t.Errorf("Expected invalid Retry-After value to be ignored")
+	}
+}
+
+func TestRetryPolicyFromModel(t *testing.T) {
+	policy, err := retryPolicyFromModel(nil)
+	if err != nil || policy.MaxAttempts != DEFAULT_RETRY_MAX_ATTEMPTS || len(policy.RetryOnStatus) != len(defaultRetryOnStatus) {
+		t.Errorf("Expected default policy, got %+v, %v", policy, err)
+	}
+
+	policy, err = retryPolicyFromModel([]RetryPolicyModel{{
+		MaxAttempts:   types.Int64Value(5),
+		MaxBackoff:    types.Int64Value(2),
+		RetryOnStatus: []types.Int64{types.Int64Value(503)},
+	}})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+
+	if policy.MaxAttempts != 5 || policy.MinBackoff != time.Second || policy.MaxBackoff != 2*time.Second || len(policy.RetryOnStatus) != 1 {
+		t.Errorf("Got unexpected policy %+v", policy)
+	}
+
+	_, err = retryPolicyFromModel([]RetryPolicyModel{{
+		MinBackoff: types.Int64Value(10),
+		MaxBackoff: types.Int64Value(5),
+	}})
+	if err == nil {
+		t.Errorf("Expected error for min_backoff greater than max_backoff")
+	}
+}
@@ -0,0 +1,289 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	AUTH_METHOD_BASIC      = "basic"
+	AUTH_METHOD_SESSION    = "session"
+	SESSIONS_ENDPOINT      = "/redfish/v1/SessionService/Sessions"
+	HTTP_HEADER_AUTH_TOKEN = "X-Auth-Token"
+)
+
+// redfishSession keeps Redfish session created for specific endpoint and user,
+// so it can be shared by all resources managing the same system.
+type redfishSession struct {
+	lock     sync.Mutex
+	endpoint string
+	username string
+	password string
+	client   *http.Client
+	location string
+	token    string
+}
+
+// sessionKey identifies session in the pool. Settings used to verify the system are part of it,
+// so credentials are never sent over connection which would not be trusted by the resource.
+type sessionKey struct {
+	endpoint               string
+	username               string
+	sslInsecure            bool
+	caCertificate          string
+	tlsServerName          string
+	certificateFingerprint string
+}
+
+// Sessions must be created once per endpoint and user during provider run, so
+// similarly to SyncPool there is a container keeping them.
+type SessionPool struct {
+	lock sync.Mutex
+	pool map[sessionKey]*redfishSession
+}
+
+var sessionPool = InitSessionPoolInstance()
+
+func InitSessionPoolInstance() *SessionPool {
+	return &SessionPool{
+		pool: make(map[sessionKey]*redfishSession),
+	}
+}
+
+// getSession returns session registered for endpoint, user and TLS settings defined in config. If session
+// is not known yet, it's registered but not created until first request will require it. Session created
+// with different password is deleted and replaced.
+func (sp *SessionPool) getSession(config ServerConfig, client *http.Client) *redfishSession {
+	session, replaced := sp.registerSession(config, client)
+	if replaced != nil {
+		// Session is deleted without lock of the pool, so unreachable system does not block other endpoints.
+		// Session which cannot be deleted (e.g.: password has been changed on the system) expires on its own.
+		_ = replaced.close()
+	}
+	return session
+}
+
+// registerSession returns session registered for config together with session of different password
+// replaced by it, if any.
+func (sp *SessionPool) registerSession(config ServerConfig, client *http.Client) (*redfishSession, *redfishSession) {
+	sp.lock.Lock()
+	defer sp.lock.Unlock()
+
+	key := sessionKey{
+		endpoint:               config.Endpoint,
+		username:               config.Username,
+		sslInsecure:            config.SslInsecure,
+		caCertificate:          config.CaCertificate,
+		tlsServerName:          config.TlsServerName,
+		certificateFingerprint: config.CertificateFingerprint,
+	}
+	session, ok := sp.pool[key]
+	if ok && session.password == config.Password {
+		return session, nil
+	}
+
+	replaced := session
+	session = &redfishSession{
+		endpoint: strings.TrimSuffix(config.Endpoint, "/"),
+		username: config.Username,
+		password: config.Password,
+		client:   client,
+	}
+	sp.pool[key] = session
+	return session, replaced
+}
+
+// CloseAll deletes all sessions created by the pool on their systems.
+func (sp *SessionPool) CloseAll(ctx context.Context) {
+	sp.lock.Lock()
+	defer sp.lock.Unlock()
+
+	for key, session := range sp.pool {
+		if err := session.close(); err != nil {
+			tflog.Warn(ctx, fmt.Sprintf("Session for endpoint '%s' could not be deleted: %s", session.endpoint, err.Error()))
+		}
+		delete(sp.pool, key)
+	}
+}
+
+// CloseSessions deletes Redfish sessions created during provider run. It's supposed
+// to be called once provider server stops.
+func CloseSessions(ctx context.Context) {
+	sessionPool.CloseAll(ctx)
+}
+
+// getToken returns token of the session, creating the session if it does not exist yet.
+func (s *redfishSession) getToken() (string, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if len(s.token) == 0 {
+		if err := s.create(); err != nil {
+			return "", err
+		}
+	}
+	return s.token, nil
+}
+
+// renew creates new session if token used by caller is still the current one. Otherwise
+// session has been already renewed by other request and its token is returned.
+func (s *redfishSession) renew(usedToken string) (string, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.token == usedToken {
+		s.token = ""
+		s.location = ""
+		if err := s.create(); err != nil {
+			return "", err
+		}
+	}
+	return s.token, nil
+}
+
+// create posts new session to SessionService and remembers its token and location.
+func (s *redfishSession) create() error {
+	payload, err := json.Marshal(map[string]string{
+		"UserName": s.username,
+		"Password": s.password,
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, s.endpoint+SESSIONS_ENDPOINT, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("could not create Redfish session: %w", err)
+	}
+
+	defer CloseResource(res.Body)
+
+	if res.StatusCode != http.StatusCreated && res.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(res.Body)
+		return fmt.Errorf("could not create Redfish session, status code %d: %s", res.StatusCode, string(body))
+	}
+
+	token := res.Header.Get(HTTP_HEADER_AUTH_TOKEN)
+	if len(token) == 0 {
+		return fmt.Errorf("session has been created, but %s header is missing", HTTP_HEADER_AUTH_TOKEN)
+	}
+
+	s.token = token
+	s.location = res.Header.Get(HTTP_HEADER_LOCATION)
+	if parsed, err := url.Parse(s.location); err == nil && parsed.IsAbs() {
+		s.location = parsed.Path
+	}
+
+	return nil
+}
+
+// close deletes session on the system if it has been created.
+func (s *redfishSession) close() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if len(s.token) == 0 || len(s.location) == 0 {
+		return nil
+	}
+
+	req, err := http.NewRequest(http.MethodDelete, s.endpoint+s.location, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set(HTTP_HEADER_AUTH_TOKEN, s.token)
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+
+	defer CloseResource(res.Body)
+
+	s.token = ""
+	s.location = ""
+
+	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusNoContent && res.StatusCode != http.StatusNotFound {
+		return fmt.Errorf("unexpected status code %d", res.StatusCode)
+	}
+
+	return nil
+}
+
+// sessionTransport adds token of shared session to every request. If system responds
+// with 401 (e.g.: session has expired), new session is created and request is repeated once.
+type sessionTransport struct {
+	base    http.RoundTripper
+	session *redfishSession
+}
+
+func (t *sessionTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	token, err := t.session.getToken()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := t.base.RoundTrip(withAuthToken(req, token))
+	if err != nil || res.StatusCode != http.StatusUnauthorized {
+		return res, err
+	}
+
+	// Request can be repeated only if its body can be read again
+	if req.Body != nil && req.GetBody == nil {
+		return res, nil
+	}
+	CloseResource(res.Body)
+
+	token, err = t.session.renew(token)
+	if err != nil {
+		return nil, err
+	}
+
+	retry := withAuthToken(req, token)
+	if req.GetBody != nil {
+		if retry.Body, err = req.GetBody(); err != nil {
+			return nil, err
+		}
+	}
+
+	return t.base.RoundTrip(retry)
+}
+
+func withAuthToken(req *http.Request, token string) *http.Request {
+	out := req.Clone(req.Context())
+	out.Header.Del("Authorization")
+	out.Header.Set(HTTP_HEADER_AUTH_TOKEN, token)
+	return out
+}
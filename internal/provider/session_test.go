@@ -0,0 +1,204 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"terraform-provider-irmc-redfish/internal/models"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func sessionRedfishServer(m *mockRedfishServer) []models.RedfishServer {
+	rserver := m.redfishServer()
+	rserver[0].AuthMethod = types.StringValue(AUTH_METHOD_SESSION)
+	return rserver
+}
+
+func TestSessionAuthentication(t *testing.T) {
+	m := newMockRedfishServer(t, FSAS)
+	t.Cleanup(func() { sessionPool.CloseAll(context.Background()) })
+
+	for i := 0; i < 3; i++ {
+		rserver := sessionRedfishServer(m)
+		api, err := ConnectTargetSystem(&IrmcProvider{}, &rserver)
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err.Error())
+		}
+
+		if _, err := GetSystemResource(api.Service); err != nil {
+			t.Fatalf("Unexpected error while reading system: %s", err.Error())
+		}
+
+		// Logout of single client must not remove shared session
+		api.Logout()
+	}
+
+	if logins := len(m.requestsTo(http.MethodPost, SESSIONS_ENDPOINT)); logins != 1 {
+		t.Errorf("Got %d session logins, expected 1", logins)
+	}
+
+	for _, req := range m.requestsTo(http.MethodGet, mockSystemEndpoint) {
+		if req.Header.Get(HTTP_HEADER_AUTH_TOKEN) == "" || req.Header.Get("Authorization") != "" {
+			t.Errorf("Request has not been authenticated with session token")
+		}
+	}
+
+	sessionPool.CloseAll(context.Background())
+	if count := m.sessionCount(); count != 0 {
+		t.Errorf("Got %d active sessions after close, expected 0", count)
+	}
+}
+
+func TestSessionReauthentication(t *testing.T) {
+	m := newMockRedfishServer(t, TS_FUJITSU)
+	t.Cleanup(func() { sessionPool.CloseAll(context.Background()) })
+
+	rserver := sessionRedfishServer(m)
+	api, err := ConnectTargetSystem(&IrmcProvider{}, &rserver)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+
+	if _, err := GetSystemResource(api.Service); err != nil {
+		t.Fatalf("Unexpected error while reading system: %s", err.Error())
+	}
+
+	m.expireSessions()
+
+	// PATCH with body must be repeated after new session has been created
+	path := "/redfish/v1/Systems/0/Oem/" + TS_FUJITSU + "/BootConfig"
+	res, err := api.Patch(path, map[string]interface{}{"BootDevice": "Pxe"})
+	if err != nil {
+		t.Fatalf("Unexpected error after session expiration: %s", err.Error())
+	}
+	CloseResource(res.Body)
+
+	if device := m.get(path)["BootDevice"]; device != "Pxe" {
+		t.Errorf("Got BootDevice %v, expected Pxe", device)
+	}
+
+	if logins := len(m.requestsTo(http.MethodPost, SESSIONS_ENDPOINT)); logins != 2 {
+		t.Errorf("Got %d session logins, expected 2", logins)
+	}
+}
+
+func TestSessionWrongCredentials(t *testing.T) {
+	m := newMockRedfishServer(t, FSAS)
+	t.Cleanup(func() { sessionPool.CloseAll(context.Background()) })
+
+	rserver := sessionRedfishServer(m)
+	rserver[0].Password = types.StringValue("wrong")
+	if _, err := ConnectTargetSystem(&IrmcProvider{}, &rserver); err == nil {
+		t.Errorf("Expected error for wrong credentials")
+	}
+}
+
+func TestSessionPasswordChange(t *testing.T) {
+	m := newMockRedfishServer(t, FSAS)
+	t.Cleanup(func() { sessionPool.CloseAll(context.Background()) })
+
+	rserver := sessionRedfishServer(m)
+	api, err := ConnectTargetSystem(&IrmcProvider{}, &rserver)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err.Error())
+	}
+
+	if _, err := GetSystemResource(api.Service); err != nil {
+		t.Fatalf("Unexpected error while reading system: %s", err.Error())
+	}
+
+	if count := m.sessionCount(); count != 1 {
+		t.Fatalf("Got %d active sessions, expected 1", count)
+	}
+
+	// Session of previous password is deleted once it's replaced
+	rserver[0].Password = types.StringValue("changed")
+	if _, err := ConnectTargetSystem(&IrmcProvider{}, &rserver); err == nil {
+		t.Errorf("Expected error for wrong credentials")
+	}
+
+	if count := m.sessionCount(); count != 0 {
+		t.Errorf("Got %d active sessions after password change, expected 0", count)
+	}
+}
+
+func TestSessionPoolTlsSettings(t *testing.T) {
+	pool := InitSessionPoolInstance()
+	config := ServerConfig{Endpoint: "https://192.0.2.1", Username: mockUsername, Password: mockPassword}
+
+	session := pool.getSession(config, http.DefaultClient)
+	if pool.getSession(config, http.DefaultClient) != session {
+		t.Errorf("Expected session to be shared for the same settings")
+	}
+
+	variants := []func(c *ServerConfig){
+		func(c *ServerConfig) { c.SslInsecure = true },
+		func(c *ServerConfig) { c.CaCertificate = "ca.pem" },
+		func(c *ServerConfig) { c.TlsServerName = "irmc.example.com" },
+		func(c *ServerConfig) { c.CertificateFingerprint = "00:11:22" },
+	}
+
+	for i, variant := range variants {
+		other := config
+		variant(&other)
+		if pool.getSession(other, http.DefaultClient) == session {
+			t.Errorf("Variant %d: session must not be shared between different TLS settings", i)
+		}
+	}
+}
+
+func TestSessionPoolReplaceWithoutLock(t *testing.T) {
+	release := make(chan struct{})
+	deleting := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(deleting)
+		<-release
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+	defer close(release)
+
+	pool := InitSessionPoolInstance()
+	config := ServerConfig{Endpoint: server.URL, Username: mockUsername, Password: mockPassword}
+	session := pool.getSession(config, server.Client())
+	session.token = "token"
+	session.location = SESSIONS_ENDPOINT + "/1"
+
+	// Deletion of replaced session hangs on the system
+	config.Password = "changed"
+	go pool.getSession(config, server.Client())
+	<-deleting
+
+	done := make(chan struct{})
+	go func() {
+		pool.getSession(ServerConfig{Endpoint: "https://192.0.2.1", Username: mockUsername}, http.DefaultClient)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Errorf("Session of other endpoint is blocked by deletion of replaced session")
+	}
+}
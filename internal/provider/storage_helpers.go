@@ -81,43 +81,27 @@ type storageControllerOem struct {
 	*/
 }
 
-type StorageControllerFujitsuOem struct {
-	OemFujitsu *storageControllerOem `json:"ts_fujitsu,omitempty"`
-	OemFsas    *storageControllerOem `json:"Fsas,omitempty"`
-}
-
 type StorageController_Fujitsu struct {
-	Oem StorageControllerFujitsuOem
+	Oem OemObject[storageControllerOem]
 }
 
 type Storage_Fujitsu struct {
 	StorageControllers []StorageController_Fujitsu
 }
 
-func getOemStorage(oem StorageControllerFujitsuOem) storageControllerOem {
-	if oem.OemFujitsu != nil {
-		return *oem.OemFujitsu
+func getOemStorage(oem OemObject[storageControllerOem]) storageControllerOem {
+	if properties := oem.Get(); properties != nil {
+		return *properties
 	}
 
-	return *oem.OemFsas
+	return storageControllerOem{}
 }
 
-func convertPlanToPayload(isFsas bool, plan models.StorageResourceModel) (any, bool) {
-	var storageController StorageController_Fujitsu
+func convertPlanToPayload(vendor *OemVendor, plan models.StorageResourceModel) (any, bool) {
 	anyValueIntoPlan := false
 
-	var oemObject storageControllerOem
-	var oem *storageControllerOem
-
-	if isFsas {
-		storageController.Oem.OemFsas = &oemObject
-		storageController.Oem.OemFujitsu = nil
-		oem = storageController.Oem.OemFsas
-	} else {
-		storageController.Oem.OemFujitsu = &oemObject
-		storageController.Oem.OemFsas = nil
-		oem = storageController.Oem.OemFujitsu
-	}
+	oem := &storageControllerOem{}
+	storageController := StorageController_Fujitsu{Oem: NewOemObject(vendor, oem)}
 
 	if !plan.BGIRate.IsNull() && !plan.BGIRate.IsUnknown() {
 		(*oem).BGIRate = new(int64)
@@ -548,13 +532,13 @@ func checkIfPlannedStorageChangesSuccessfullyApplied(ctx context.Context, servic
 }
 
 func waitUntilStorageChangesApplied(ctx context.Context, service *gofish.Service, task_location string,
-	plan models.StorageResourceModel, startTime int64, is_fsas bool, timeout int64) (diags diag.Diagnostics) {
+	plan models.StorageResourceModel, startTime int64, vendor *OemVendor, timeout int64) (diags diag.Diagnostics) {
 
 	if len(task_location) != 0 {
 		_, err := WaitForRedfishTaskEnd(ctx, service, task_location, timeout)
 		if err != nil {
 			diags.AddError("Task for storage controller modification reported error", err.Error())
-			logs, internal_diags := FetchRedfishTaskLog(service, task_location, is_fsas)
+			logs, internal_diags := FetchRedfishTaskLog(service, task_location, vendor)
 			if logs == nil {
 				diags = append(diags, internal_diags...)
 			} else {
@@ -575,7 +559,10 @@ func waitUntilStorageChangesApplied(ctx context.Context, service *gofish.Service
 			return diags
 		}
 
-		time.Sleep(5 * time.Second)
+		if err := sleepWithContext(ctx, 5*time.Second); err != nil {
+			diags.AddError("Waiting for storage controller change has been cancelled", err.Error())
+			return diags
+		}
 	}
 }
 
@@ -590,13 +577,13 @@ func applyStorageControllerProperties(ctx context.Context, api *gofish.APIClient
 		"serial": plan.StorageControllerSN.ValueString(),
 	})
 
-	isFsas, err := IsFsasCheck(ctx, api)
+	vendor, err := GetOemVendor(ctx, api)
 	if err != nil {
 		diags.AddError("Server vendor verification failed", err.Error())
 		return diags
 	}
 
-	payload, anyValue := convertPlanToPayload(isFsas, *plan)
+	payload, anyValue := convertPlanToPayload(vendor, *plan)
 
 	if !anyValue {
 		diags.AddError("Payload created out of defined plan will be empty.",
@@ -617,7 +604,7 @@ func applyStorageControllerProperties(ctx context.Context, api *gofish.APIClient
 		return diags
 	}
 
-	diags = waitUntilStorageChangesApplied(ctx, api.Service, taskLocation, *plan, startTime, isFsas, timeout)
+	diags = waitUntilStorageChangesApplied(ctx, api.Service, taskLocation, *plan, startTime, vendor, timeout)
 	plan.Id = types.StringValue(storage.ODataID)
 	return diags
 }
@@ -64,13 +64,8 @@ type volumeOem struct {
 	DriveCacheMode string `json:"DriveCacheMode,omitempty"`
 }
 
-type volumeOemObject struct {
-	OemFsas    *volumeOem `json:"Fsas,omitempty"`
-	OemFujitsu *volumeOem `json:"ts_fujitsu,omitempty"`
-}
-
 type volumeObject struct {
-	Oem volumeOemObject `json:"Oem"`
+	Oem OemObject[volumeOem] `json:"Oem"`
 }
 
 type physical_disk_group struct {
@@ -118,7 +113,7 @@ func getVolumesCollectionUrl(service *gofish.Service, serial string) (url string
 // what target controller reports as supported. If validation has been successful,
 // function returns slice of physical_disk_group.
 func validateRequestAgainstStorageControllerCapabilities(ctx context.Context, service *gofish.Service,
-	storage_id string, is_fsas bool, plan models.StorageVolumeResourceModel) ([]physical_disk_group, error) {
+	storage_id string, vendor *OemVendor, plan models.StorageVolumeResourceModel) ([]physical_disk_group, error) {
 	physical_disk_groups := []physical_disk_group{}
 
 	storage, err := getSystemStorageFromSerialNumber(service, storage_id)
@@ -132,12 +127,7 @@ func validateRequestAgainstStorageControllerCapabilities(ctx context.Context, se
 	}
 
 	// Obtain RAIDCapabilities for particular storage controller
-	raidc_endpoint := storage.ODataID
-	if is_fsas {
-		raidc_endpoint = raidc_endpoint + STORAGE_RAIDCAPABILITIES_FSAS_SUFFIX
-	} else {
-		raidc_endpoint = raidc_endpoint + STORAGE_RAIDCAPABILITIES_SUFFIX
-	}
+	raidc_endpoint := vendor.OemPath(storage.ODataID, STORAGE_RAIDCAPABILITIES_OEM_PATH)
 
 	var capabilities raidCapabilitiesConfig
 	capabilities, err = getSystemStorageOemRaidCapabilitiesResource(service, raidc_endpoint)
@@ -263,43 +253,17 @@ func verifyRequestedDisks(ctx context.Context, plan models.StorageVolumeResource
 					"Drive location": drive.Location[0].Info,
 				})
 
-				drive_s := strings.NewReader(drive.Location[0].Info)
-				var (
-					system     int
-					controller int
-					enclosure  int
-					slot       int
-				)
-
-				// Differentiate between drives in enclosure and directly attached
-				var err error
-				enclosure_attached := false
-				if drive.Location[0].InfoFormat == "[ System_Id : Controller_Id : Enclosure_Id : Slot_Id ]" {
-					_, err = fmt.Fscanf(drive_s, "[ %d : %d : %d : %d ]",
-						&system, &controller, &enclosure, &slot)
-					enclosure_attached = true
-				} else {
-					_, err = fmt.Fscanf(drive_s, "[ %d : %d : %d ]", &system, &controller, &slot)
-				}
-
+				slot, err := getDriveSlot(drive)
 				if err != nil {
 					tflog.Warn(ctx, "Scanning disk location failed", map[string]interface{}{
-						"drive": drive_s,
+						"drive": drive.Location[0].Info,
 					})
 				}
 
-				if enclosure_attached {
-					if fmt.Sprintf("%d-%d", enclosure, slot) == disk {
-						disk_found = true
-						drives_media_type = drive.MediaType
-						break
-					}
-				} else {
-					if strconv.Itoa(slot) == disk {
-						disk_found = true
-						drives_media_type = drive.MediaType
-						break
-					}
+				if slot == disk {
+					disk_found = true
+					drives_media_type = drive.MediaType
+					break
 				}
 			}
 
@@ -320,6 +284,32 @@ func verifyRequestedDisks(ctx context.Context, plan models.StorageVolumeResource
 	return physical_disks, drives_media_type, nil
 }
 
+// getDriveSlot returns slot location of drive in format accepted by physical_drives of storage volume,
+// which is "<enclosure>-<slot>" for drives attached through enclosure and "<slot>" for directly attached ones.
+func getDriveSlot(drive *redfish.Drive) (string, error) {
+	if len(drive.Location) == 0 {
+		return "", fmt.Errorf("drive '%s' does not report its location", drive.ODataID)
+	}
+
+	drive_s := strings.NewReader(drive.Location[0].Info)
+	var (
+		system     int
+		controller int
+		enclosure  int
+		slot       int
+	)
+
+	// Differentiate between drives in enclosure and directly attached
+	if drive.Location[0].InfoFormat == "[ System_Id : Controller_Id : Enclosure_Id : Slot_Id ]" {
+		_, err := fmt.Fscanf(drive_s, "[ %d : %d : %d : %d ]",
+			&system, &controller, &enclosure, &slot)
+		return fmt.Sprintf("%d-%d", enclosure, slot), err
+	}
+
+	_, err := fmt.Fscanf(drive_s, "[ %d : %d : %d ]", &system, &controller, &slot)
+	return strconv.Itoa(slot), err
+}
+
 // getNewVolumeConfigFromPlan based on plan and already converted list of disks in physical_disks
 // returns map containing whole request as map.
 func getNewVolumeConfigFromPlan(plan models.StorageVolumeResourceModel,
@@ -406,7 +396,7 @@ func getRecentlyCreatedVolumeId(ids_after, ids_before []string) string {
 // requestVolumeCreationAndSuperviseTheProcess sends creation request and waits until created task
 // will finish.
 func requestVolumeCreationAndSuperviseTheProcess(ctx context.Context, service *gofish.Service,
-	volumes_collection_endpoint string, new_volume_payload map[string]interface{}, is_fsas bool, timeout int64) (diags diag.Diagnostics) {
+	volumes_collection_endpoint string, new_volume_payload map[string]interface{}, vendor *OemVendor, timeout int64) (diags diag.Diagnostics) {
 	res, err := service.GetClient().Post(volumes_collection_endpoint, new_volume_payload)
 	if err != nil {
 		diags.AddError("Error while requesting POST on volume collection", err.Error())
@@ -420,7 +410,7 @@ func requestVolumeCreationAndSuperviseTheProcess(ctx context.Context, service *g
 		_, err := WaitForRedfishTaskEnd(ctx, service, task_location, timeout)
 		if err != nil {
 			diags.AddError("Task for volume creation reported error", err.Error())
-			logs, internal_diags := FetchRedfishTaskLog(service, task_location, is_fsas)
+			logs, internal_diags := FetchRedfishTaskLog(service, task_location, vendor)
 			if logs == nil {
 				diags = append(diags, internal_diags...)
 			} else {
@@ -465,13 +455,13 @@ func requestAndSuperviseVolumeCreationProcess(ctx context.Context, api *gofish.A
 
 	storage_id := plan.StorageControllerSN.ValueString()
 
-	is_fsas, err := IsFsasCheck(ctx, api)
+	vendor, err := GetOemVendor(ctx, api)
 	if err != nil {
 		diags.AddError("Vendor detection failed", err.Error())
 		return diags
 	}
 
-	physical_disk_groups, err := validateRequestAgainstStorageControllerCapabilities(ctx, api.Service, storage_id, is_fsas, plan)
+	physical_disk_groups, err := validateRequestAgainstStorageControllerCapabilities(ctx, api.Service, storage_id, vendor, plan)
 	if err != nil {
 		diags.AddError("Error during request validation", err.Error())
 		return diags
@@ -491,12 +481,12 @@ func requestAndSuperviseVolumeCreationProcess(ctx context.Context, api *gofish.A
 	})
 
 	return requestVolumeCreationAndSuperviseTheProcess(ctx, api.Service, volumes_collection_endpoint,
-		new_volume_payload, is_fsas, plan.JobTimeout.ValueInt64())
+		new_volume_payload, vendor, plan.JobTimeout.ValueInt64())
 }
 
 // deleteStorageVolume tries to destroy volume_endpoint in service.
 func deleteStorageVolume(ctx context.Context, service *gofish.Service,
-	volume_endpoint string, is_fsas bool, timeout int64) diag.Diagnostics {
+	volume_endpoint string, vendor *OemVendor, timeout int64) diag.Diagnostics {
 
 	var diags diag.Diagnostics
 
@@ -513,7 +503,7 @@ func deleteStorageVolume(ctx context.Context, service *gofish.Service,
 		_, err := WaitForRedfishTaskEnd(ctx, service, task_location, timeout)
 		if err != nil {
 			diags.AddError("Task for volume deletion reported error", err.Error())
-			logs, internal_diags := FetchRedfishTaskLog(service, task_location, is_fsas)
+			logs, internal_diags := FetchRedfishTaskLog(service, task_location, vendor)
 			if logs == nil {
 				diags = append(diags, internal_diags...)
 			} else {
@@ -574,34 +564,28 @@ func readStorageVolumeToState(volume *redfish.Volume, storage_serial string,
 	// Theoretically volume can be migrated to different RAID type
 	state.RaidType = types.StringValue(string(volume.RAIDType))
 
-	var volumeOem volumeOemObject
-	err := json.Unmarshal(volume.OEM, &volumeOem)
+	var oem OemObject[volumeOem]
+	err := json.Unmarshal(volume.OEM, &oem)
 	if err != nil {
 		diags.AddError("Could not unmarshal volume resource OEM object", err.Error())
 		return diags
 	}
 
+	properties := oem.Get()
+	if properties == nil {
+		diags.AddError("Could not read volume resource OEM object", "Volume does not report OEM properties of any known vendor")
+		return diags
+	}
+
 	if state.ReadMode != nil {
-		if volumeOem.OemFsas != nil {
-			state.ReadMode.Actual = types.StringValue(volumeOem.OemFsas.ReadMode)
-		} else {
-			state.ReadMode.Actual = types.StringValue(volumeOem.OemFujitsu.ReadMode)
-		}
+		state.ReadMode.Actual = types.StringValue(properties.ReadMode)
 	}
 
 	if state.WriteMode != nil {
-		if volumeOem.OemFsas != nil {
-			state.WriteMode.Actual = types.StringValue(volumeOem.OemFsas.WriteMode)
-		} else {
-			state.WriteMode.Actual = types.StringValue(volumeOem.OemFujitsu.WriteMode)
-		}
+		state.WriteMode.Actual = types.StringValue(properties.WriteMode)
 	}
 
-	if volumeOem.OemFsas != nil {
-		state.DriveCacheMode = types.StringValue(volumeOem.OemFsas.DriveCacheMode)
-	} else {
-		state.DriveCacheMode = types.StringValue(volumeOem.OemFujitsu.DriveCacheMode)
-	}
+	state.DriveCacheMode = types.StringValue(properties.DriveCacheMode)
 
 	return diags
 }
@@ -634,12 +618,17 @@ func compareVolumePropertiesWithPlan(ctx context.Context, service *gofish.Servic
 			return false, err
 		}
 
-		var volumeOem volumeOemObject
-		err = json.Unmarshal(volume.OEM, &volumeOem)
+		var oem OemObject[volumeOem]
+		err = json.Unmarshal(volume.OEM, &oem)
 		if err != nil {
 			return false, err
 		}
 
+		var driveCacheMode string
+		if properties := oem.Get(); properties != nil {
+			driveCacheMode = properties.DriveCacheMode
+		}
+
 		if verifyVolumeName {
 			if volume.Name == plan.VolumeName.ValueString() {
 				nameVerified = true
@@ -647,13 +636,6 @@ func compareVolumePropertiesWithPlan(ctx context.Context, service *gofish.Servic
 		}
 
 		if verifyDriveCacheMode {
-			var driveCacheMode string
-			if volumeOem.OemFujitsu != nil {
-				driveCacheMode = volumeOem.OemFujitsu.DriveCacheMode
-			} else {
-				driveCacheMode = volumeOem.OemFsas.DriveCacheMode
-			}
-
 			if driveCacheMode == plan.DriveCacheMode.ValueString() {
 				driveCacheVerified = true
 			}
@@ -663,13 +645,6 @@ func compareVolumePropertiesWithPlan(ctx context.Context, service *gofish.Servic
 			return true, nil
 		}
 
-		var driveCacheMode string
-		if volumeOem.OemFujitsu != nil {
-			driveCacheMode = volumeOem.OemFujitsu.DriveCacheMode
-		} else {
-			driveCacheMode = volumeOem.OemFsas.DriveCacheMode
-		}
-
 		tflog.Info(ctx, "compareVolumePropertiesWithPlan: compare plan with current volume",
 			map[string]interface{}{
 				"volume name (current)":      volume.Name,
@@ -682,7 +657,9 @@ func compareVolumePropertiesWithPlan(ctx context.Context, service *gofish.Servic
 			return false, fmt.Errorf("timeout of %d s has been reached", timeout_s)
 		}
 
-		time.Sleep(2 * time.Second)
+		if err := sleepWithContext(ctx, 2*time.Second); err != nil {
+			return false, fmt.Errorf("waiting for volume %s change has been cancelled: %w", volume_id, err)
+		}
 	}
 }
 
@@ -693,7 +670,9 @@ func waitUntilStorageVolumeChangesApplied(ctx context.Context, service *gofish.S
 		return WaitForRedfishTaskEnd(ctx, service, taskLocation, timeout)
 	}
 
-	time.Sleep(5 * time.Second)
+	if err := sleepWithContext(ctx, 5*time.Second); err != nil {
+		return false, fmt.Errorf("waiting for volume %s change has been cancelled: %w", volume_endpoint, err)
+	}
 
 	// since no task is created, logic needs to wait with timeout for resource update
 	return compareVolumePropertiesWithPlan(ctx, service, volume_endpoint, plan, timeout-5)
@@ -752,31 +731,17 @@ func patchVolumeEndpoint(ctx context.Context, service *gofish.Service, endpoint
 // updateStorageVolume applies change on volume properties and verifies if planned
 // changes are reflected by Redfish volume endpoint.
 func requestVolumeModificationAndSuperviseTheProcess(ctx context.Context, service *gofish.Service, state models.StorageVolumeResourceModel,
-	plan models.StorageVolumeResourceModel, is_fsas bool) (diags diag.Diagnostics) {
+	plan models.StorageVolumeResourceModel, vendor *OemVendor) (diags diag.Diagnostics) {
 
-	var payload volumeObject
 	var oem volumeOem
-
-	if is_fsas {
-		payload.Oem.OemFsas = &oem
-	} else {
-		payload.Oem.OemFujitsu = &oem
-	}
+	payload := volumeObject{Oem: NewOemObject(vendor, &oem)}
 
 	if !plan.DriveCacheMode.IsUnknown() {
-		if payload.Oem.OemFsas != nil {
-			payload.Oem.OemFsas.DriveCacheMode = plan.DriveCacheMode.ValueString()
-		} else {
-			payload.Oem.OemFujitsu.DriveCacheMode = plan.DriveCacheMode.ValueString()
-		}
+		oem.DriveCacheMode = plan.DriveCacheMode.ValueString()
 	}
 
 	if !plan.VolumeName.IsUnknown() {
-		if payload.Oem.OemFsas != nil {
-			payload.Oem.OemFsas.Name = plan.VolumeName.ValueString()
-		} else {
-			payload.Oem.OemFujitsu.Name = plan.VolumeName.ValueString()
-		}
+		oem.Name = plan.VolumeName.ValueString()
 	}
 
 	volume_endpoint := state.Id.ValueString()
@@ -899,13 +864,13 @@ func createStorageVolume(ctx context.Context, api *gofish.APIClient, plan models
 }
 
 func updateStorageVolume(ctx context.Context, api *gofish.APIClient, plan models.StorageVolumeResourceModel, state *models.StorageVolumeResourceModel) (removeResource bool, diags diag.Diagnostics) {
-	is_fsas, err := IsFsasCheck(ctx, api)
+	vendor, err := GetOemVendor(ctx, api)
 	if err != nil {
 		diags.AddError("Vendor detection failed", err.Error())
 		return false, diags
 	}
 
-	diags = requestVolumeModificationAndSuperviseTheProcess(ctx, api.Service, *state, plan, is_fsas)
+	diags = requestVolumeModificationAndSuperviseTheProcess(ctx, api.Service, *state, plan, vendor)
 	if diags.HasError() {
 		return false, diags
 	}
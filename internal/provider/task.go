@@ -31,6 +31,8 @@ import (
 	"github.com/stmcginnis/gofish/redfish"
 )
 
+const TASK_POLL_INTERVAL = 5 * time.Second
+
 // IsTaskFinished returns information whether task state
 // has been mapped to task finished state and the information
 // is returned as boolean.
@@ -61,13 +63,8 @@ func IsTaskFinishedSuccessfully(state redfish.TaskState) bool {
 // FetchRedfishTaskLog tries to fetch logs of task pointed by location
 // from system accessed by service. If logs content could not be accessed
 // diags is filled with reason.
-func FetchRedfishTaskLog(service *gofish.Service, location string, is_fsas bool) (logs []byte, diags diag.Diagnostics) {
-	task_log_endpoint := location
-	if is_fsas {
-		task_log_endpoint += "/Oem/Fsas/Logs"
-	} else {
-		task_log_endpoint += "/Oem/ts_fujitsu/Logs"
-	}
+func FetchRedfishTaskLog(service *gofish.Service, location string, vendor *OemVendor) (logs []byte, diags diag.Diagnostics) {
+	task_log_endpoint := vendor.OemPath(location, "Logs")
 
 	res, err := service.GetClient().Get(task_log_endpoint)
 	if err != nil {
@@ -91,16 +88,31 @@ func FetchRedfishTaskLog(service *gofish.Service, location string, is_fsas bool)
 	}
 }
 
+// taskWaitCancelledError describes interrupted waiting for task, which is left running on the system.
+func taskWaitCancelledError(location string, err error) error {
+	return fmt.Errorf("waiting for task %s has been cancelled, task has been left running on the system: %w", location, err)
+}
+
 // WaitForRedfishTaskEnd checks in loop until task pointed by location on service
 // will report finished state or operation will timeout (maximum time pointed by timeout_s).
 // If task has been finished with success, status is returned as true. If loop has timed or
 // information about task could not be retrieved, status will be returned as false with error
-// pointing to reason.
+// pointing to reason. If event stream is enabled, task is checked again as soon as the system
+// notifies about change of its state instead of every TASK_POLL_INTERVAL.
 func WaitForRedfishTaskEnd(ctx context.Context, service *gofish.Service, location string, timeout_s int64) (bool, error) {
+	service, release := withContext(ctx, service)
+	defer release()
+
+	events := openEventStream(ctx, service)
+	defer events.Close()
+
 	start_time := time.Now().Unix()
 	for {
 		task, err := redfish.GetTask(service.GetClient(), location)
 		if err != nil {
+			if ctx.Err() != nil {
+				return false, taskWaitCancelledError(location, ctx.Err())
+			}
 			return false, fmt.Errorf("error during task %s retrieval %s", location, err.Error())
 		}
 
@@ -109,14 +121,19 @@ func WaitForRedfishTaskEnd(ctx context.Context, service *gofish.Service, locatio
 			"state":    task.TaskState,
 		})
 
+		tflog.Info(ctx, "Task progress", map[string]interface{}{
+			"location": location,
+			"percent":  task.PercentComplete,
+		})
+
 		if IsTaskFinished(task.TaskState) {
 			if IsTaskFinishedSuccessfully(task.TaskState) {
 				return true, nil
 			}
 
 			return false, fmt.Errorf("task finished with TaskState %s", task.TaskState)
-		} else {
-			time.Sleep(5 * time.Second)
+		} else if err := events.wait(ctx, TASK_POLL_INTERVAL, taskEventFilter(ctx, location)); err != nil {
+			return false, taskWaitCancelledError(location, err)
 		}
 
 		if time.Now().Unix()-start_time > timeout_s {
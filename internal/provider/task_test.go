@@ -0,0 +1,113 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stmcginnis/gofish/redfish"
+)
+
+func TestWaitForRedfishTaskEnd(t *testing.T) {
+	forEachMockFlavor(t, func(t *testing.T, m *mockRedfishServer) {
+		api := m.connect()
+
+		t.Run("Completed", func(t *testing.T) {
+			location := m.addTask(nil, redfish.CompletedTaskState)
+			ok, err := WaitForRedfishTaskEnd(context.Background(), api.Service, location, 10)
+			if err != nil || !ok {
+				t.Errorf("Expected task finished successfully, got %t, %v", ok, err)
+			}
+		})
+
+		t.Run("Exception", func(t *testing.T) {
+			location := m.addTask(nil, redfish.ExceptionTaskState)
+			ok, err := WaitForRedfishTaskEnd(context.Background(), api.Service, location, 10)
+			if err == nil || ok {
+				t.Errorf("Expected task finished with error, got %t, %v", ok, err)
+			}
+		})
+
+		t.Run("NotExisting", func(t *testing.T) {
+			_, err := WaitForRedfishTaskEnd(context.Background(), api.Service, "/redfish/v1/TaskService/Tasks/999", 10)
+			if err == nil {
+				t.Errorf("Expected error for not existing task")
+			}
+		})
+
+		t.Run("Cancelled", func(t *testing.T) {
+			location := m.addTask(nil, redfish.RunningTaskState)
+			ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+			defer cancel()
+
+			start := time.Now()
+			_, err := WaitForRedfishTaskEnd(ctx, api.Service, location, 60)
+			if err == nil || !strings.Contains(err.Error(), location) || !errors.Is(err, context.DeadlineExceeded) {
+				t.Errorf("Expected cancellation error naming task %s, got %v", location, err)
+			}
+
+			if elapsed := time.Since(start); elapsed > 2*time.Second {
+				t.Errorf("Waiting has not been interrupted, took %s", elapsed)
+			}
+		})
+	})
+}
+
+func TestFetchRedfishTaskLog(t *testing.T) {
+	forEachMockFlavor(t, func(t *testing.T, m *mockRedfishServer) {
+		api := m.connect()
+		location := m.addTask([]string{"Operation finished"})
+
+		logs, diags := FetchRedfishTaskLog(api.Service, location, m.vendor())
+		if diags.HasError() {
+			t.Fatalf("Unexpected error: %v", diags)
+		}
+
+		if !strings.Contains(string(logs), "Operation finished") {
+			t.Errorf("Task log does not contain expected message: %s", string(logs))
+		}
+
+		// Logs must be read from OEM endpoint of the flavor
+		otherVendor := oemVendorFsas
+		if m.vendor() == oemVendorFsas {
+			otherVendor = oemVendorFujitsu
+		}
+		_, diags = FetchRedfishTaskLog(api.Service, location, otherVendor)
+		if !diags.HasError() {
+			t.Errorf("Expected error while reading logs from OEM endpoint of other flavor")
+		}
+	})
+}
+
+func TestIsTaskFinished(t *testing.T) {
+	if IsTaskFinished(redfish.RunningTaskState) {
+		t.Errorf("Running task reported as finished")
+	}
+
+	if !IsTaskFinished(redfish.KilledTaskState) || IsTaskFinishedSuccessfully(redfish.KilledTaskState) {
+		t.Errorf("Killed task must be reported as finished without success")
+	}
+
+	if !IsTaskFinishedSuccessfully(redfish.CompletedTaskState) {
+		t.Errorf("Completed task must be reported as finished successfully")
+	}
+}
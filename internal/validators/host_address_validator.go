@@ -0,0 +1,56 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validators
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var hostNameRegex = regexp.MustCompile(`^([a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?\.)*[a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?$`)
+
+// HostAddressValidator checks that value is IPv4/IPv6 address or DNS host name.
+type HostAddressValidator struct{}
+
+func (v HostAddressValidator) Description(ctx context.Context) string {
+	return "Ensures a value is IPv4 address, IPv6 address or host name."
+}
+
+func (v HostAddressValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v HostAddressValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if net.ParseIP(value) == nil && (len(value) > 253 || !hostNameRegex.MatchString(value)) {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid host address",
+			fmt.Sprintf("'%s' is neither IP address nor valid host name.", value))
+	}
+}
+
+func IsHostAddress() validator.String {
+	return HostAddressValidator{}
+}
@@ -0,0 +1,97 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validators
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+const (
+	IPv4 = 4
+	IPv6 = 6
+)
+
+// IPAddressValidator checks that value is IP address of requested version.
+type IPAddressValidator struct {
+	Version int
+}
+
+func (v IPAddressValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("Ensures a value is IPv%d address.", v.Version)
+}
+
+func (v IPAddressValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v IPAddressValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	ip := net.ParseIP(value)
+	if ip == nil || (v.Version == IPv4) != (ip.To4() != nil) {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid IP address",
+			fmt.Sprintf("'%s' is not valid IPv%d address.", value, v.Version))
+	}
+}
+
+// SubnetMaskValidator checks that value is IPv4 subnet mask (e.g. 255.255.255.0).
+type SubnetMaskValidator struct{}
+
+func (v SubnetMaskValidator) Description(ctx context.Context) string {
+	return "Ensures a value is IPv4 subnet mask."
+}
+
+func (v SubnetMaskValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v SubnetMaskValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	ip := net.ParseIP(value).To4()
+	if ip == nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid subnet mask", fmt.Sprintf("'%s' is not valid IPv4 subnet mask.", value))
+		return
+	}
+
+	if ones, bits := net.IPMask(ip).Size(); ones == 0 && bits == 0 {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid subnet mask", fmt.Sprintf("'%s' is not valid IPv4 subnet mask.", value))
+	}
+}
+
+func IsIPv4Address() validator.String {
+	return IPAddressValidator{Version: IPv4}
+}
+
+func IsIPv6Address() validator.String {
+	return IPAddressValidator{Version: IPv6}
+}
+
+func IsSubnetMask() validator.String {
+	return SubnetMaskValidator{}
+}
@@ -0,0 +1,56 @@
+/*
+Copyright (c) 2025 Fsas Technologies Inc., or its subsidiaries. All Rights Reserved.
+
+Licensed under the Mozilla Public License Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://mozilla.org/MPL/2.0/
+
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validators
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	// Time zone database is embedded, so time zones are validated independently of host system.
+	_ "time/tzdata"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// TimeZoneValidator checks that value is name of time zone from IANA time zone database (e.g. Europe/Berlin).
+type TimeZoneValidator struct{}
+
+func (v TimeZoneValidator) Description(ctx context.Context) string {
+	return "Ensures a value is name of time zone from IANA time zone database."
+}
+
+func (v TimeZoneValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v TimeZoneValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if _, err := time.LoadLocation(value); err != nil || len(value) == 0 || value == "Local" {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid time zone",
+			fmt.Sprintf("'%s' is not known time zone name (e.g. UTC or Europe/Berlin).", value))
+	}
+}
+
+func IsTimeZone() validator.String {
+	return TimeZoneValidator{}
+}
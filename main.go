@@ -61,6 +61,9 @@ func main() {
 
 	err := providerserver.Serve(context.Background(), provider.New(version), opts)
 
+	// Redfish sessions shared by resources are deleted once Terraform stops the provider
+	provider.CloseSessions(context.Background())
+
 	if err != nil {
 		log.Fatal(err.Error())
 	}